@@ -6,6 +6,7 @@ require (
 	github.com/ilyakaznacheev/cleanenv v1.5.0
 	github.com/segmentio/kafka-go v0.4.48
 	github.com/sendgrid/sendgrid-go v3.16.1+incompatible
+	shared v0.0.0-00010101000000-000000000000
 )
 
 require (
@@ -17,3 +18,5 @@ require (
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	olympos.io/encoding/edn v0.0.0-20201019073823-d3554ca0b0a3 // indirect
 )
+
+replace shared => ../shared
@@ -33,8 +33,13 @@ func (c *Consumer) Start() {
 	c.cancel = cancel
 
 	listeners := map[string]HandlerFunc{
-		"orders.confirmed": c.handleOrderConfirmed,
-		"users.registered": c.handleUserRegistered,
+		"orders.confirmed":           c.handleOrderConfirmed,
+		"users.registered":           c.handleUserRegistered,
+		"cart.abandoned":             c.handleCartAbandoned,
+		"orders.cancelled":           c.handleOrderCancelled,
+		"shipments.created":          c.handleShipmentCreated,
+		"shipments.delivered":        c.handleShipmentDelivered,
+		"subscriptions.order_failed": c.handleSubscriptionOrderFailed,
 	}
 
 	for topic, handler := range listeners {
@@ -95,6 +100,42 @@ func (c *Consumer) handleOrderConfirmed(ctx context.Context, m *kafka.Message) e
 	return err
 }
 
+func (c *Consumer) handleOrderCancelled(ctx context.Context, m *kafka.Message) error {
+	var event service.OrderCreatedEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.SendOrderCancellationEmail(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleShipmentCreated(ctx context.Context, m *kafka.Message) error {
+	var event service.ShipmentEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.SendShipmentEmail(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleShipmentDelivered(ctx context.Context, m *kafka.Message) error {
+	var event service.ShipmentEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.SendDeliveryEmail(ctx, event.Data)
+
+	return err
+}
+
 func (c *Consumer) handleUserRegistered(ctx context.Context, m *kafka.Message) error {
 	var event service.UserRegisteredEvent
 	err := json.Unmarshal(m.Value, &event)
@@ -106,3 +147,27 @@ func (c *Consumer) handleUserRegistered(ctx context.Context, m *kafka.Message) e
 
 	return err
 }
+
+func (c *Consumer) handleCartAbandoned(ctx context.Context, m *kafka.Message) error {
+	var event service.CartEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.SendAbandonedCartEmail(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleSubscriptionOrderFailed(ctx context.Context, m *kafka.Message) error {
+	var event service.SubscriptionEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.SendSubscriptionOrderFailedEmail(ctx, event.Data)
+
+	return err
+}
@@ -3,11 +3,13 @@ package service
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"github.com/sendgrid/sendgrid-go"
 	"github.com/sendgrid/sendgrid-go/helpers/mail"
 	"html/template"
 	"log"
+	"shared/money"
 	"time"
 )
 
@@ -29,18 +31,34 @@ type OrderData struct {
 	PaymentIntentID   *string          `json:"payment_intent_id"`
 	UserID            int64            `json:"user_id"`
 	Items             []*OrderItemData `json:"items"`
-	Amount            float64          `json:"amount"`
+	Amount            money.Money      `json:"amount"`
+	Subtotal          money.Money      `json:"subtotal"`
+	Discount          money.Money      `json:"discount"`
+	Shipping          money.Money      `json:"shipping"`
+	Tax               money.Money      `json:"tax"`
+	Currency          string           `json:"currency"`
 	ShippingAddress   string           `json:"shipping_address"`
+	ShippingMethod    string           `json:"shipping_method"`
 	EstimatedDelivery time.Time        `json:"estimated_delivery"`
+	// CancellationReason is only set on orders.cancelled events.
+	CancellationReason string `json:"cancellation_reason,omitempty"`
+	// Invoice is only set on orders.confirmed events, and not always on those.
+	Invoice *InvoiceData `json:"invoice,omitempty"`
+}
+
+type InvoiceData struct {
+	Number   string `json:"number"`
+	FileName string `json:"file_name"`
+	PDF      []byte `json:"pdf"`
 }
 
 type OrderItemData struct {
 	Quantity       int32        `json:"quantity"`
-	Price          float64      `json:"price"`
+	Price          money.Money  `json:"price"`
 	Sku            string       `json:"sku"`
 	Name           string       `json:"name"`
 	ImageURL       template.URL `json:"image_url"`
-	ItemTotalPrice float64      `json:"item_total_price"`
+	ItemTotalPrice money.Money  `json:"item_total_price"`
 }
 
 type UserRegisteredEvent struct {
@@ -59,8 +77,104 @@ type UserData struct {
 	LoginURL  string `json:"login_url"`
 }
 
+type CartEvent struct {
+	EventID   string    `json:"event_id"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	Version   string    `json:"version"`
+	Data      CartData  `json:"data"`
+}
+
+type CartData struct {
+	CartID            string          `json:"cart_id"`
+	UserID            int64           `json:"user_id"`
+	CustomerFirstName string          `json:"customer_first_name"`
+	CustomerLastName  string          `json:"customer_last_name"`
+	CustomerEmail     string          `json:"customer_email"`
+	Items             []*CartItemData `json:"items"`
+	TotalPrice        money.Money     `json:"total_price"`
+	CartURL           string          `json:"cart_url"`
+	LastActivity      time.Time       `json:"last_activity"`
+}
+
+type CartItemData struct {
+	Quantity       int32        `json:"quantity"`
+	Price          money.Money  `json:"price"`
+	Sku            string       `json:"sku"`
+	Name           string       `json:"name"`
+	ImageURL       template.URL `json:"image_url"`
+	ItemTotalPrice money.Money  `json:"item_total_price"`
+}
+
+type ShipmentEvent struct {
+	EventID   string       `json:"event_id"`
+	EventType string       `json:"event_type"`
+	Timestamp time.Time    `json:"timestamp"`
+	Version   string       `json:"version"`
+	Data      ShipmentData `json:"data"`
+}
+
+type ShipmentData struct {
+	ShipmentID        int64               `json:"shipment_id"`
+	OrderID           int64               `json:"order_id"`
+	UserID            int64               `json:"user_id"`
+	CustomerFirstName string              `json:"customer_first_name"`
+	CustomerLastName  string              `json:"customer_last_name"`
+	CustomerEmail     string              `json:"customer_email"`
+	Carrier           string              `json:"carrier"`
+	TrackingNumber    string              `json:"tracking_number"`
+	Status            string              `json:"status"`
+	OrderStatus       string              `json:"order_status"`
+	Items             []*ShipmentItemData `json:"items"`
+	ShippingAddress   string              `json:"shipping_address"`
+	EstimatedDelivery time.Time           `json:"estimated_delivery"`
+	ShippedAt         time.Time           `json:"shipped_at"`
+	DeliveredAt       *time.Time          `json:"delivered_at"`
+}
+
+type ShipmentItemData struct {
+	Sku      string       `json:"sku"`
+	Name     string       `json:"name"`
+	ImageURL template.URL `json:"image_url"`
+	Quantity int64        `json:"quantity"`
+}
+
+type SubscriptionEvent struct {
+	EventID   string           `json:"event_id"`
+	EventType string           `json:"event_type"`
+	Timestamp time.Time        `json:"timestamp"`
+	Version   string           `json:"version"`
+	Data      SubscriptionData `json:"data"`
+}
+
+// SubscriptionData is a subscription order that failed. RetryAt is nil once the delivery
+// was skipped.
+type SubscriptionData struct {
+	SubscriptionID    int64                   `json:"subscription_id"`
+	UserID            int64                   `json:"user_id"`
+	CustomerFirstName string                  `json:"customer_first_name"`
+	CustomerLastName  string                  `json:"customer_last_name"`
+	CustomerEmail     string                  `json:"customer_email"`
+	Items             []*SubscriptionItemData `json:"items"`
+	Reason            string                  `json:"reason"`
+	Attempt           int32                   `json:"attempt"`
+	RetryAt           *time.Time              `json:"retry_at"`
+	NextOrderAt       time.Time               `json:"next_order_at"`
+}
+
+type SubscriptionItemData struct {
+	Sku      string `json:"sku"`
+	Name     string `json:"name"`
+	Quantity int32  `json:"quantity"`
+}
+
 const orderConfirmedTemplate = "order-confirmed.page.gohtml"
 const userRegisteredTemplate = "user-registered.page.gohtml"
+const abandonedCartTemplate = "abandoned-cart.page.gohtml"
+const orderCancelledTemplate = "order-cancelled.page.gohtml"
+const orderShippedTemplate = "order-shipped.page.gohtml"
+const shipmentDeliveredTemplate = "shipment-delivered.page.gohtml"
+const subscriptionOrderFailedTemplate = "subscription-order-failed.page.gohtml"
 
 type Service struct {
 	sendGridClient *sendgrid.Client
@@ -98,6 +212,136 @@ func (s *Service) SendOrderConfirmationEmail(ctx context.Context, eventData Orde
 
 	m := mail.NewSingleEmail(from, subject, to, plainTextContent, htmlContent)
 
+	if eventData.Invoice != nil {
+		attachment := mail.NewAttachment()
+		attachment.SetContent(base64.StdEncoding.EncodeToString(eventData.Invoice.PDF))
+		attachment.SetType("application/pdf")
+		attachment.SetFilename(eventData.Invoice.FileName)
+		attachment.SetDisposition("attachment")
+		m.AddAttachment(attachment)
+	}
+
+	response, err := s.sendGridClient.SendWithContext(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Email sent, status code %d", response.StatusCode)
+
+	return nil
+}
+
+func (s *Service) SendOrderCancellationEmail(ctx context.Context, eventData OrderData) error {
+	ts, ok := s.templateCache[orderCancelledTemplate]
+	if !ok {
+		return fmt.Errorf("the template %s does not exist", orderCancelledTemplate)
+	}
+	var renderedHTML bytes.Buffer
+	if err := ts.Execute(&renderedHTML, eventData); err != nil {
+		return err
+	}
+
+	// SendGrid setup
+	from := mail.NewEmail("MyEcom", "contact@my-ecom-project.dynv6.net")
+	subject := fmt.Sprintf("Your Order Has Been Cancelled - #%d", eventData.OrderID)
+	name := fmt.Sprintf("%s %s", eventData.CustomerFirstName, eventData.CustomerLastName)
+	to := mail.NewEmail(name, eventData.CustomerEmail)
+	plainTextContent := fmt.Sprintf("Your order #%d has been cancelled", eventData.OrderID)
+	htmlContent := renderedHTML.String()
+
+	m := mail.NewSingleEmail(from, subject, to, plainTextContent, htmlContent)
+
+	response, err := s.sendGridClient.SendWithContext(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Email sent, status code %d", response.StatusCode)
+
+	return nil
+}
+
+func (s *Service) SendShipmentEmail(ctx context.Context, eventData ShipmentData) error {
+	ts, ok := s.templateCache[orderShippedTemplate]
+	if !ok {
+		return fmt.Errorf("the template %s does not exist", orderShippedTemplate)
+	}
+	var renderedHTML bytes.Buffer
+	if err := ts.Execute(&renderedHTML, eventData); err != nil {
+		return err
+	}
+
+	// SendGrid setup
+	from := mail.NewEmail("MyEcom", "contact@my-ecom-project.dynv6.net")
+	subject := fmt.Sprintf("Your Order Has Shipped - #%d", eventData.OrderID)
+	name := fmt.Sprintf("%s %s", eventData.CustomerFirstName, eventData.CustomerLastName)
+	to := mail.NewEmail(name, eventData.CustomerEmail)
+	plainTextContent := fmt.Sprintf("Your order #%d has shipped with %s, tracking number %s",
+		eventData.OrderID, eventData.Carrier, eventData.TrackingNumber)
+	htmlContent := renderedHTML.String()
+
+	m := mail.NewSingleEmail(from, subject, to, plainTextContent, htmlContent)
+
+	response, err := s.sendGridClient.SendWithContext(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Email sent, status code %d", response.StatusCode)
+
+	return nil
+}
+
+func (s *Service) SendDeliveryEmail(ctx context.Context, eventData ShipmentData) error {
+	ts, ok := s.templateCache[shipmentDeliveredTemplate]
+	if !ok {
+		return fmt.Errorf("the template %s does not exist", shipmentDeliveredTemplate)
+	}
+	var renderedHTML bytes.Buffer
+	if err := ts.Execute(&renderedHTML, eventData); err != nil {
+		return err
+	}
+
+	// SendGrid setup
+	from := mail.NewEmail("MyEcom", "contact@my-ecom-project.dynv6.net")
+	subject := fmt.Sprintf("Your Package Was Delivered - #%d", eventData.OrderID)
+	name := fmt.Sprintf("%s %s", eventData.CustomerFirstName, eventData.CustomerLastName)
+	to := mail.NewEmail(name, eventData.CustomerEmail)
+	plainTextContent := fmt.Sprintf("Your package from order #%d was delivered", eventData.OrderID)
+	htmlContent := renderedHTML.String()
+
+	m := mail.NewSingleEmail(from, subject, to, plainTextContent, htmlContent)
+
+	response, err := s.sendGridClient.SendWithContext(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Email sent, status code %d", response.StatusCode)
+
+	return nil
+}
+
+func (s *Service) SendSubscriptionOrderFailedEmail(ctx context.Context, eventData SubscriptionData) error {
+	ts, ok := s.templateCache[subscriptionOrderFailedTemplate]
+	if !ok {
+		return fmt.Errorf("the template %s does not exist", subscriptionOrderFailedTemplate)
+	}
+	var renderedHTML bytes.Buffer
+	if err := ts.Execute(&renderedHTML, eventData); err != nil {
+		return err
+	}
+
+	// SendGrid setup
+	from := mail.NewEmail("MyEcom", "contact@my-ecom-project.dynv6.net")
+	subject := fmt.Sprintf("Your Subscription Order Failed - #%d", eventData.SubscriptionID)
+	name := fmt.Sprintf("%s %s", eventData.CustomerFirstName, eventData.CustomerLastName)
+	to := mail.NewEmail(name, eventData.CustomerEmail)
+	plainTextContent := fmt.Sprintf("We couldn't place the order of your subscription #%d", eventData.SubscriptionID)
+	htmlContent := renderedHTML.String()
+
+	m := mail.NewSingleEmail(from, subject, to, plainTextContent, htmlContent)
+
 	response, err := s.sendGridClient.SendWithContext(ctx, m)
 	if err != nil {
 		return err
@@ -137,3 +381,33 @@ func (s *Service) SendWelcomeEmail(ctx context.Context, eventData UserData) erro
 
 	return nil
 }
+
+func (s *Service) SendAbandonedCartEmail(ctx context.Context, eventData CartData) error {
+	ts, ok := s.templateCache[abandonedCartTemplate]
+	if !ok {
+		return fmt.Errorf("the template %s does not exist", abandonedCartTemplate)
+	}
+	var renderedHTML bytes.Buffer
+	if err := ts.Execute(&renderedHTML, eventData); err != nil {
+		return err
+	}
+
+	// SendGrid setup
+	from := mail.NewEmail("MyEcom", "contact@my-ecom-project.dynv6.net")
+	subject := "You left items in your cart"
+	name := fmt.Sprintf("%s %s", eventData.CustomerFirstName, eventData.CustomerLastName)
+	to := mail.NewEmail(name, eventData.CustomerEmail)
+	plainTextContent := fmt.Sprintf("Your cart is waiting for you: %s", eventData.CartURL)
+	htmlContent := renderedHTML.String()
+
+	m := mail.NewSingleEmail(from, subject, to, plainTextContent, htmlContent)
+
+	response, err := s.sendGridClient.SendWithContext(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Email sent, status code %d", response.StatusCode)
+
+	return nil
+}
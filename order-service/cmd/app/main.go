@@ -18,8 +18,11 @@ import (
 	"google.golang.org/grpc/status"
 	"log"
 	"net"
+	"order-service/internal/checkout"
 	"order-service/internal/config"
 	"order-service/internal/consumer"
+	"order-service/internal/fulfillment"
+	"order-service/internal/invoice"
 	"order-service/internal/repository"
 	"order-service/internal/server"
 	"order-service/internal/service"
@@ -27,6 +30,8 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"shared/blob"
+	"shared/idempotency"
 	"strings"
 	"syscall"
 	"time"
@@ -36,6 +41,20 @@ const (
 	secret = "my-secret-key"
 )
 
+// optionalAuthMethods also serve callers without a bearer token, e.g. the cart service
+// pricing a guest cart.
+var optionalAuthMethods = map[string]bool{
+	pb.OrderService_GetCoupon_FullMethodName: true,
+}
+
+// staffOnlyMethods require a token with the staff role, as does all of AdminOrderService.
+var staffOnlyMethods = map[string]bool{
+	pb.OrderService_CreateCoupon_FullMethodName:   true,
+	pb.OrderService_ReviewReturn_FullMethodName:   true,
+	pb.OrderService_ReceiveReturn_FullMethodName:  true,
+	pb.OrderService_CreateShipment_FullMethodName: true,
+}
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatal(err)
@@ -84,9 +103,74 @@ func run() error {
 	defer userConn.Close()
 	userClient := pb.NewUserServiceClient(userConn)
 
+	// gRPC productClient to Product Catalog Service, which prices subscription orders
+	productAddr := fmt.Sprintf("%s:%s", cfg.ProductClient.Host, cfg.ProductClient.Port)
+	productConn, err := grpc.NewClient(productAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer productConn.Close()
+	productClient := pb.NewProductCatalogServiceClient(productConn)
+
 	// Repository
 	repo := repository.New(conn)
 
+	// Shipping rates and tax rules
+	rateTable, err := checkout.NewStaticRateTable(cfg.Checkout.ShippingRatesFile)
+	if err != nil {
+		return err
+	}
+
+	taxRules, err := checkout.NewTaxRules(cfg.Checkout.TaxRulesFile)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Checkout.SessionTTL <= 0 || cfg.Checkout.ExpiryInterval <= 0 {
+		return errors.New("CHECKOUT_SESSION_TTL and CHECKOUT_EXPIRY_INTERVAL must be positive durations")
+	}
+
+	// Carriers
+	if cfg.Fulfillment.TrackingInterval <= 0 {
+		return errors.New("TRACKING_SYNC_INTERVAL must be a positive duration")
+	}
+
+	var carrierList []fulfillment.Carrier
+	for _, name := range cfg.Fulfillment.Carriers {
+		switch strings.TrimSpace(name) {
+		case "fake":
+			carrierList = append(carrierList, fulfillment.NewFakeCarrier(cfg.Fulfillment.FakeCarrierStep))
+		default:
+			return fmt.Errorf("unknown carrier %q in FULFILLMENT_CARRIERS", name)
+		}
+	}
+
+	// Invoices
+	invoiceStore, err := blob.NewLocalStore(cfg.Invoice.StorageDir, "")
+	if err != nil {
+		return err
+	}
+
+	invoiceRenderer, err := invoice.NewRenderer(invoice.Seller{
+		Name:    cfg.Invoice.SellerName,
+		Address: cfg.Invoice.SellerAddress,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Subscriptions
+	if cfg.Subscription.SchedulerInterval <= 0 || cfg.Subscription.RetryDelay <= 0 {
+		return errors.New("SUBSCRIPTION_SCHEDULER_INTERVAL and SUBSCRIPTION_RETRY_DELAY must be positive durations")
+	}
+	if cfg.Subscription.MaxAttempts < 1 {
+		return errors.New("SUBSCRIPTION_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if cfg.Idempotency.KeyTTL <= 0 {
+		return errors.New("IDEMPOTENCY_KEY_TTL must be a positive duration")
+	}
+
 	// Kafka writer
 	kafkaAddr := fmt.Sprintf("%s:%s", cfg.Kafka.Host, cfg.Kafka.Port)
 	orderCreatedWriter := &kafka.Writer{
@@ -103,15 +187,77 @@ func run() error {
 		AllowAutoTopicCreation: true,
 	}
 	defer orderCreatedWriter.Close()
+	checkoutExpiredWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "checkout.expired",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer checkoutExpiredWriter.Close()
+	orderCancelledWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "orders.cancelled",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer orderCancelledWriter.Close()
+	returnsWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer returnsWriter.Close()
+	shipmentsWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer shipmentsWriter.Close()
+	subscriptionFailedWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "subscriptions.order_failed",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer subscriptionFailedWriter.Close()
 
 	// Service
-	svc := service.New(repo, cartClient, userClient, orderCreatedWriter, orderConfirmedWriter)
+	svc := service.New(repo, checkout.NewCalculator(rateTable, taxRules), cfg.Checkout.SessionTTL, cartClient, userClient,
+		productClient, orderCreatedWriter, orderConfirmedWriter, checkoutExpiredWriter, orderCancelledWriter,
+		returnsWriter, fulfillment.NewCarriers(carrierList...), shipmentsWriter,
+		invoiceRenderer, invoiceStore,
+		service.SubscriptionPolicy{
+			RetryDelay:  cfg.Subscription.RetryDelay,
+			MaxAttempts: cfg.Subscription.MaxAttempts,
+		},
+		subscriptionFailedWriter,
+	)
 
-	// gRPC server with authentication interceptor
+	// Checkout session expiry
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go svc.RunSessionExpiry(backgroundCtx, cfg.Checkout.ExpiryInterval)
+
+	// Shipment tracking
+	go svc.RunTrackingSync(backgroundCtx, cfg.Fulfillment.TrackingInterval)
+
+	// Subscription orders
+	go svc.RunSubscriptions(backgroundCtx, cfg.Subscription.SchedulerInterval)
+
+	// gRPC server with authentication and idempotency interceptors
+	idempotent := idempotency.New(repo, cfg.Idempotency.KeyTTL,
+		pb.OrderService_CreateOrder_FullMethodName,
+		pb.OrderService_CancelOrder_FullMethodName,
+		pb.OrderService_RequestReturn_FullMethodName,
+		pb.OrderService_CreateShipment_FullMethodName,
+		pb.OrderService_Reorder_FullMethodName,
+		pb.OrderService_CreateSubscription_FullMethodName,
+	)
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(AuthInterceptor),
+		grpc.ChainUnaryInterceptor(AuthInterceptor, idempotent.Unary),
 	)
 	pb.RegisterOrderServiceServer(s, server.NewOrderServer(svc))
+	pb.RegisterAdminOrderServiceServer(s, server.NewAdminServer(svc))
 
 	// Kafka consumer
 	cons := consumer.New(svc, cfg)
@@ -136,6 +282,7 @@ func run() error {
 
 	s.GracefulStop()
 	cons.Stop()
+	stopBackground()
 
 	log.Println("Application stopped")
 
@@ -179,7 +326,7 @@ func setupDatabase(addr, path string) (*sql.DB, error) {
 	return conn, nil
 }
 
-func AuthInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	mt, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
@@ -187,6 +334,9 @@ func AuthInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, hand
 
 	bearedToken := mt["authorization"]
 	if len(bearedToken) == 0 {
+		if optionalAuthMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
 		return nil, status.Error(codes.Unauthenticated, "bearer token not found")
 	}
 
@@ -213,7 +363,16 @@ func AuthInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, hand
 
 	userIDInt := int(userIDFloat)
 
+	// Tokens issued before roles existed have no role claim and belong to customers.
+	role, _ := claims["role"].(string)
+	staffOnly := staffOnlyMethods[info.FullMethod] ||
+		strings.HasPrefix(info.FullMethod, "/"+pb.AdminOrderService_ServiceDesc.ServiceName+"/")
+	if staffOnly && role != "staff" {
+		return nil, status.Error(codes.PermissionDenied, "staff role required")
+	}
+
 	ctx = context.WithValue(ctx, "user-id", userIDInt)
+	ctx = context.WithValue(ctx, "role", role)
 
 	return handler(ctx, req)
 }
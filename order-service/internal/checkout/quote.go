@@ -0,0 +1,109 @@
+package checkout
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/model"
+	"shared/money"
+)
+
+var (
+	ErrNoShippingMethods         = errors.New("no shipping methods available for this address")
+	ErrShippingMethodUnavailable = errors.New("shipping method is not available for this address")
+)
+
+// Cart is the part of a shopping cart a quote is computed from. Subtotal is before the
+// discount, and FreeShipping is set by a free-shipping coupon.
+type Cart struct {
+	Subtotal     money.Money
+	Discount     money.Money
+	Items        int64
+	FreeShipping bool
+}
+
+// Quote breaks down what a checkout costs. Every amount is in the cart currency and
+// Total = Subtotal - Discount + Shipping + Tax.
+type Quote struct {
+	Subtotal     money.Money
+	Discount     money.Money
+	Options      []ShippingRate
+	Method       ShippingRate
+	Shipping     money.Money
+	Tax          money.Money
+	Total        money.Money
+	FreeShipping bool
+}
+
+type Calculator struct {
+	rates RateTable
+	taxes *TaxRules
+}
+
+func NewCalculator(rates RateTable, taxes *TaxRules) *Calculator {
+	return &Calculator{
+		rates: rates,
+		taxes: taxes,
+	}
+}
+
+// Quote prices a cart shipped to address by method, or by the cheapest method available
+// when method is empty.
+func (c *Calculator) Quote(ctx context.Context, address model.Address, method string, cart Cart) (*Quote, error) {
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+
+	options, err := c.rates.Rates(ctx, address, Parcel{Items: cart.Items, Subtotal: cart.Subtotal})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(options) == 0 {
+		return nil, ErrNoShippingMethods
+	}
+
+	selected, ok := selectMethod(options, method)
+	if !ok {
+		return nil, ErrShippingMethodUnavailable
+	}
+
+	currency := cart.Subtotal.Currency
+	shipping := selected.Amount
+	if cart.FreeShipping {
+		shipping = money.New(0, currency)
+	}
+
+	taxable := money.New(cart.Subtotal.Amount-cart.Discount.Amount, currency)
+	tax := c.taxes.Tax(address, taxable, shipping)
+
+	return &Quote{
+		Subtotal:     cart.Subtotal,
+		Discount:     money.New(cart.Discount.Amount, currency),
+		Options:      options,
+		Method:       selected,
+		Shipping:     shipping,
+		Tax:          tax,
+		Total:        money.New(taxable.Amount+shipping.Amount+tax.Amount, currency),
+		FreeShipping: cart.FreeShipping,
+	}, nil
+}
+
+func selectMethod(options []ShippingRate, method string) (ShippingRate, bool) {
+	if method == "" {
+		cheapest := options[0]
+		for _, option := range options[1:] {
+			if option.Amount.Amount < cheapest.Amount.Amount {
+				cheapest = option
+			}
+		}
+		return cheapest, true
+	}
+
+	for _, option := range options {
+		if option.Method == method {
+			return option, true
+		}
+	}
+
+	return ShippingRate{}, false
+}
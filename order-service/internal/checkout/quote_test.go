@@ -0,0 +1,133 @@
+package checkout
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/model"
+	"shared/money"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	rates, err := NewStaticRateTable(writeFile(t, testShippingRates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taxes, err := NewTaxRules(writeFile(t, testTaxRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator := NewCalculator(rates, taxes)
+
+	california := model.Address{FullName: "Ada Lovelace", Line1: "1 Main St", City: "Los Angeles", Region: "CA", PostalCode: "90001", Country: "US"}
+	newYork := model.Address{FullName: "Ada Lovelace", Line1: "1 Main St", City: "New York", Region: "NY", PostalCode: "10001", Country: "US"}
+
+	cart := Cart{Subtotal: money.New(5000, "USD"), Discount: money.New(1000, "USD"), Items: 2}
+
+	tests := []struct {
+		name       string
+		address    model.Address
+		method     string
+		cart       Cart
+		wantMethod string
+		shipping   int64
+		tax        int64
+		total      int64
+		wantErr    error
+	}{
+		{
+			name:       "cheapest method by default",
+			address:    california,
+			cart:       cart,
+			wantMethod: "standard",
+			shipping:   599,
+			tax:        290,
+			total:      4889,
+		},
+		{
+			name:       "chosen method",
+			address:    california,
+			method:     "express",
+			cart:       cart,
+			wantMethod: "express",
+			shipping:   1699,
+			tax:        290,
+			total:      5989,
+		},
+		{
+			name:       "free shipping coupon",
+			address:    california,
+			method:     "express",
+			cart:       Cart{Subtotal: cart.Subtotal, Discount: cart.Discount, Items: cart.Items, FreeShipping: true},
+			wantMethod: "express",
+			shipping:   0,
+			tax:        290,
+			total:      4290,
+		},
+		{
+			name:       "shipping taxed",
+			address:    newYork,
+			cart:       cart,
+			wantMethod: "standard",
+			shipping:   599,
+			tax:        184,
+			total:      4783,
+		},
+		{
+			name:       "without discount",
+			address:    california,
+			cart:       Cart{Subtotal: money.New(5000, "USD"), Items: 2},
+			wantMethod: "standard",
+			shipping:   599,
+			tax:        363,
+			total:      5962,
+		},
+		{
+			name:    "method not offered",
+			address: california,
+			method:  "nova-poshta",
+			cart:    cart,
+			wantErr: ErrShippingMethodUnavailable,
+		},
+		{
+			name:    "no methods for the currency",
+			address: california,
+			cart:    Cart{Subtotal: money.New(5000, "UAH"), Items: 2},
+			wantErr: ErrNoShippingMethods,
+		},
+		{
+			name:    "invalid address",
+			address: model.Address{Country: "US"},
+			cart:    cart,
+			wantErr: model.ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote, err := calculator.Quote(context.Background(), tt.address, tt.method, tt.cart)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Quote() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if quote.Method.Method != tt.wantMethod {
+				t.Errorf("Quote() method = %s, want %s", quote.Method.Method, tt.wantMethod)
+			}
+			if quote.Shipping != money.New(tt.shipping, "USD") {
+				t.Errorf("Quote() shipping = %+v, want %d USD", quote.Shipping, tt.shipping)
+			}
+			if quote.Tax != money.New(tt.tax, "USD") {
+				t.Errorf("Quote() tax = %+v, want %d USD", quote.Tax, tt.tax)
+			}
+			if quote.Total != money.New(tt.total, "USD") {
+				t.Errorf("Quote() total = %+v, want %d USD", quote.Total, tt.total)
+			}
+			if quote.Total.Amount != quote.Subtotal.Amount-quote.Discount.Amount+quote.Shipping.Amount+quote.Tax.Amount {
+				t.Errorf("Quote() total %+v isn't subtotal - discount + shipping + tax", quote.Total)
+			}
+		})
+	}
+}
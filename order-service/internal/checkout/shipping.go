@@ -0,0 +1,153 @@
+package checkout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"order-service/internal/model"
+	"os"
+	"shared/money"
+	"slices"
+)
+
+// Parcel is what a shipping rate is computed for.
+type Parcel struct {
+	Items    int64
+	Subtotal money.Money
+}
+
+// ShippingRate is a shipping method offered for a parcel, priced in the parcel's currency.
+type ShippingRate struct {
+	Method  string
+	Name    string
+	Amount  money.Money
+	MinDays int32
+	MaxDays int32
+}
+
+// RateTable offers the shipping methods available for a parcel sent to an address. A
+// method that can't ship the parcel, for example to that country or in that currency, is
+// left out rather than reported as an error.
+type RateTable interface {
+	Rates(ctx context.Context, address model.Address, parcel Parcel) ([]ShippingRate, error)
+}
+
+// StaticRateTable serves rates from a JSON file such as
+//
+//	{"methods": [{"code": "standard", "name": "Standard", "countries": ["US"], "min_days": 3, "max_days": 5,
+//	  "prices": {"USD": {"base": "4.99", "per_item": "0.50", "free_over": "75.00"}}}]}
+//
+// A method without countries ships everywhere. Each method is priced per currency as a
+// base fee plus a fee per item, waived when the subtotal reaches free_over.
+type StaticRateTable struct {
+	methods []staticMethod
+}
+
+type staticMethod struct {
+	code      string
+	name      string
+	countries []string
+	minDays   int32
+	maxDays   int32
+	prices    map[string]staticPrice
+}
+
+type staticPrice struct {
+	base     money.Money
+	perItem  money.Money
+	freeOver money.Money
+}
+
+type rateFile struct {
+	Methods []struct {
+		Code      string   `json:"code"`
+		Name      string   `json:"name"`
+		Countries []string `json:"countries"`
+		MinDays   int32    `json:"min_days"`
+		MaxDays   int32    `json:"max_days"`
+		Prices    map[string]struct {
+			Base     string `json:"base"`
+			PerItem  string `json:"per_item"`
+			FreeOver string `json:"free_over"`
+		} `json:"prices"`
+	} `json:"methods"`
+}
+
+func NewStaticRateTable(path string) (*StaticRateTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file rateFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	methods := make([]staticMethod, 0, len(file.Methods))
+	for _, m := range file.Methods {
+		if m.Code == "" {
+			return nil, fmt.Errorf("shipping method without a code in %s", path)
+		}
+
+		method := staticMethod{
+			code:      m.Code,
+			name:      m.Name,
+			countries: m.Countries,
+			minDays:   m.MinDays,
+			maxDays:   m.MaxDays,
+			prices:    make(map[string]staticPrice, len(m.Prices)),
+		}
+
+		for currency, p := range m.Prices {
+			var price staticPrice
+			for _, field := range []struct {
+				value string
+				dst   *money.Money
+			}{{p.Base, &price.base}, {p.PerItem, &price.perItem}, {p.FreeOver, &price.freeOver}} {
+				if field.value == "" {
+					*field.dst = money.New(0, currency)
+					continue
+				}
+				*field.dst, err = money.Parse(field.value, currency)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s price %q for shipping method %s: %w", currency, field.value, m.Code, err)
+				}
+			}
+			method.prices[currency] = price
+		}
+
+		methods = append(methods, method)
+	}
+
+	return &StaticRateTable{methods: methods}, nil
+}
+
+func (t *StaticRateTable) Rates(_ context.Context, address model.Address, parcel Parcel) ([]ShippingRate, error) {
+	rates := make([]ShippingRate, 0, len(t.methods))
+	for _, method := range t.methods {
+		if len(method.countries) > 0 && !slices.Contains(method.countries, address.Country) {
+			continue
+		}
+
+		price, ok := method.prices[parcel.Subtotal.Currency]
+		if !ok {
+			continue
+		}
+
+		amount := money.New(0, parcel.Subtotal.Currency)
+		if price.freeOver.Amount == 0 || parcel.Subtotal.Amount < price.freeOver.Amount {
+			amount.Amount = price.base.Amount + price.perItem.Amount*parcel.Items
+		}
+
+		rates = append(rates, ShippingRate{
+			Method:  method.code,
+			Name:    method.name,
+			Amount:  amount,
+			MinDays: method.minDays,
+			MaxDays: method.maxDays,
+		})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,113 @@
+package checkout
+
+import (
+	"context"
+	"order-service/internal/model"
+	"shared/money"
+	"testing"
+)
+
+const testShippingRates = `{"methods": [
+	{"code": "standard", "name": "Standard", "countries": ["US"], "min_days": 3, "max_days": 5,
+	 "prices": {"USD": {"base": "4.99", "per_item": "0.50", "free_over": "75.00"}}},
+	{"code": "express", "name": "Express", "countries": ["US"], "min_days": 1, "max_days": 2,
+	 "prices": {"USD": {"base": "14.99", "per_item": "1.00"}}},
+	{"code": "international", "name": "International", "min_days": 7, "max_days": 21,
+	 "prices": {"USD": {"base": "24.99", "per_item": "2.00"}, "EUR": {"base": "22.90"}}}
+]}`
+
+func TestRates(t *testing.T) {
+	table, err := NewStaticRateTable(writeFile(t, testShippingRates))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		country  string
+		items    int64
+		subtotal money.Money
+		want     map[string]int64
+	}{
+		{
+			name:     "domestic",
+			country:  "US",
+			items:    3,
+			subtotal: money.New(5000, "USD"),
+			want:     map[string]int64{"standard": 649, "express": 1799, "international": 3099},
+		},
+		{
+			name:     "free over threshold",
+			country:  "US",
+			items:    3,
+			subtotal: money.New(7500, "USD"),
+			want:     map[string]int64{"standard": 0, "express": 1799, "international": 3099},
+		},
+		{
+			name:     "just under threshold",
+			country:  "US",
+			items:    1,
+			subtotal: money.New(7499, "USD"),
+			want:     map[string]int64{"standard": 549, "express": 1599, "international": 2699},
+		},
+		{
+			name:     "only methods shipping to the country",
+			country:  "UA",
+			items:    2,
+			subtotal: money.New(5000, "USD"),
+			want:     map[string]int64{"international": 2899},
+		},
+		{
+			name:     "only methods priced in the currency",
+			country:  "US",
+			items:    2,
+			subtotal: money.New(5000, "EUR"),
+			want:     map[string]int64{"international": 2290},
+		},
+		{
+			name:     "no method priced in the currency",
+			country:  "US",
+			items:    2,
+			subtotal: money.New(5000, "UAH"),
+			want:     map[string]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rates, err := table.Rates(context.Background(), model.Address{Country: tt.country}, Parcel{Items: tt.items, Subtotal: tt.subtotal})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(rates) != len(tt.want) {
+				t.Fatalf("Rates() = %+v, want %d methods", rates, len(tt.want))
+			}
+			for _, rate := range rates {
+				want, ok := tt.want[rate.Method]
+				if !ok || rate.Amount != money.New(want, tt.subtotal.Currency) {
+					t.Errorf("Rates() %s = %+v, want %d %s", rate.Method, rate.Amount, want, tt.subtotal.Currency)
+				}
+			}
+		})
+	}
+}
+
+func TestNewStaticRateTableInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		rates string
+	}{
+		{name: "method without code", rates: `{"methods": [{"name": "Standard", "prices": {"USD": {"base": "4.99"}}}]}`},
+		{name: "invalid price", rates: `{"methods": [{"code": "standard", "prices": {"USD": {"base": "4,99"}}}]}`},
+		{name: "not json", rates: `methods`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewStaticRateTable(writeFile(t, tt.rates)); err == nil {
+				t.Error("NewStaticRateTable() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package checkout
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"order-service/internal/model"
+	"os"
+	"shared/money"
+	"strings"
+)
+
+// TaxRules holds the sales tax rates per region, read from a JSON file such as
+//
+//	{"rules": [{"country": "US", "region": "CA", "rate": "0.0725"},
+//	  {"country": "DE", "rate": "0.19", "shipping_taxable": true}]}
+//
+// A rule with a region takes precedence over the rule for its whole country. Addresses no
+// rule matches are not taxed.
+type TaxRules struct {
+	rules map[string]taxRule
+}
+
+type taxRule struct {
+	rate            *big.Rat
+	shippingTaxable bool
+}
+
+type taxFile struct {
+	Rules []struct {
+		Country         string `json:"country"`
+		Region          string `json:"region"`
+		Rate            string `json:"rate"`
+		ShippingTaxable bool   `json:"shipping_taxable"`
+	} `json:"rules"`
+}
+
+func NewTaxRules(path string) (*TaxRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file taxFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]taxRule, len(file.Rules))
+	for _, r := range file.Rules {
+		rate, ok := new(big.Rat).SetString(r.Rate)
+		if !ok || rate.Sign() < 0 || rate.Cmp(big.NewRat(1, 1)) >= 0 {
+			return nil, fmt.Errorf("invalid tax rate %q for %s", r.Rate, regionKey(r.Country, r.Region))
+		}
+
+		key := regionKey(strings.ToUpper(r.Country), strings.ToUpper(r.Region))
+		if _, exists := rules[key]; exists {
+			return nil, fmt.Errorf("duplicate tax rule for %s", key)
+		}
+
+		rules[key] = taxRule{
+			rate:            rate,
+			shippingTaxable: r.ShippingTaxable,
+		}
+	}
+
+	return &TaxRules{rules: rules}, nil
+}
+
+// Tax returns the tax due on goods worth taxable, after discounts, and on shipping where
+// the region taxes it. The result is rounded half away from zero to the minor unit.
+func (t *TaxRules) Tax(address model.Address, taxable, shipping money.Money) money.Money {
+	rule, ok := t.rules[regionKey(address.Country, address.Region)]
+	if !ok {
+		rule, ok = t.rules[regionKey(address.Country, "")]
+	}
+	if !ok {
+		return money.New(0, taxable.Currency)
+	}
+
+	base := taxable.Amount
+	if rule.shippingTaxable {
+		base += shipping.Amount
+	}
+
+	return money.New(roundRat(new(big.Rat).Mul(big.NewRat(base, 1), rule.rate)), taxable.Currency)
+}
+
+func regionKey(country, region string) string {
+	if region == "" {
+		return country
+	}
+
+	return country + "-" + region
+}
+
+func roundRat(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	den := r.Denom()
+
+	// round(|n| / d) = floor((2|n| + d) / 2d)
+	q := new(big.Int).Add(new(big.Int).Lsh(num, 1), den)
+	q.Quo(q, new(big.Int).Lsh(den, 1))
+
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	return q.Int64()
+}
@@ -0,0 +1,85 @@
+package checkout
+
+import (
+	"order-service/internal/model"
+	"os"
+	"path/filepath"
+	"shared/money"
+	"testing"
+)
+
+const testTaxRules = `{"rules": [
+	{"country": "US", "rate": "0.05"},
+	{"country": "US", "region": "CA", "rate": "0.0725"},
+	{"country": "US", "region": "NY", "rate": "0.04", "shipping_taxable": true},
+	{"country": "DE", "rate": "0.19", "shipping_taxable": true}
+]}`
+
+// writeFile stores content in a file of its own for the test and returns its path.
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestTax(t *testing.T) {
+	rules, err := NewTaxRules(writeFile(t, testTaxRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		country  string
+		region   string
+		taxable  int64
+		shipping int64
+		want     int64
+	}{
+		{name: "region rule", country: "US", region: "CA", taxable: 1000, shipping: 500, want: 73},
+		{name: "region rule rounds down", country: "US", region: "CA", taxable: 1013, want: 73},
+		{name: "negative rounds away from zero", country: "US", region: "CA", taxable: -1000, want: -73},
+		{name: "taxed shipping", country: "US", region: "NY", taxable: 1000, shipping: 500, want: 60},
+		{name: "country rule for other regions", country: "US", region: "TX", taxable: 1000, shipping: 500, want: 50},
+		{name: "country rule without region", country: "DE", taxable: 999, want: 190},
+		{name: "country rule for a region", country: "DE", region: "BY", taxable: 1000, shipping: 100, want: 209},
+		{name: "no rule", country: "FR", taxable: 1000, shipping: 500, want: 0},
+		{name: "nothing taxable", country: "US", region: "CA", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address := model.Address{Country: tt.country, Region: tt.region}
+			got := rules.Tax(address, money.New(tt.taxable, "USD"), money.New(tt.shipping, "USD"))
+			if got != money.New(tt.want, "USD") {
+				t.Errorf("Tax() = %+v, want %d USD", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTaxRulesInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules string
+	}{
+		{name: "rate of one", rules: `{"rules": [{"country": "US", "rate": "1"}]}`},
+		{name: "negative rate", rules: `{"rules": [{"country": "US", "rate": "-0.1"}]}`},
+		{name: "not a number", rules: `{"rules": [{"country": "US", "rate": "seven"}]}`},
+		{name: "duplicate", rules: `{"rules": [{"country": "us", "rate": "0.1"}, {"country": "US", "rate": "0.2"}]}`},
+		{name: "not json", rules: `rules`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTaxRules(writeFile(t, tt.rules)); err == nil {
+				t.Error("NewTaxRules() error = nil, want an error")
+			}
+		})
+	}
+}
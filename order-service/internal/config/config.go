@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type Config struct {
@@ -24,6 +25,34 @@ type Config struct {
 		Host string `env:"USER_HOST" envDefault:"user-service"`
 		Port string `env:"USER_PORT" envDefault:"8080"`
 	}
+	ProductClient struct {
+		Host string `env:"PRODUCT_CATALOG_HOST" envDefault:"product-catalog-service"`
+		Port string `env:"PRODUCT_CATALOG_PORT" envDefault:"8080"`
+	}
+	Checkout struct {
+		ShippingRatesFile string        `env:"SHIPPING_RATES_FILE" envDefault:"./shipping-rates.json"`
+		TaxRulesFile      string        `env:"TAX_RULES_FILE" envDefault:"./tax-rules.json"`
+		SessionTTL        time.Duration `env:"CHECKOUT_SESSION_TTL" envDefault:"30m"`
+		ExpiryInterval    time.Duration `env:"CHECKOUT_EXPIRY_INTERVAL" envDefault:"1m"`
+	}
+	Fulfillment struct {
+		Carriers         []string      `env:"FULFILLMENT_CARRIERS" env-separator:"," envDefault:"fake"`
+		TrackingInterval time.Duration `env:"TRACKING_SYNC_INTERVAL" envDefault:"5m"`
+		FakeCarrierStep  time.Duration `env:"FAKE_CARRIER_STEP" envDefault:"10m"`
+	}
+	Invoice struct {
+		StorageDir    string `env:"INVOICE_STORAGE_DIR" envDefault:"./invoices"`
+		SellerName    string `env:"INVOICE_SELLER_NAME" envDefault:"MyEcom"`
+		SellerAddress string `env:"INVOICE_SELLER_ADDRESS"`
+	}
+	Subscription struct {
+		SchedulerInterval time.Duration `env:"SUBSCRIPTION_SCHEDULER_INTERVAL" envDefault:"5m"`
+		RetryDelay        time.Duration `env:"SUBSCRIPTION_RETRY_DELAY" envDefault:"24h"`
+		MaxAttempts       int32         `env:"SUBSCRIPTION_MAX_ATTEMPTS" envDefault:"3"`
+	}
+	Idempotency struct {
+		KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	}
 	Kafka struct {
 		Host string `env:"KAFKA_HOST" envDefault:"kafka"`
 		Port string `env:"KAFKA_PORT" envDefault:"9092"`
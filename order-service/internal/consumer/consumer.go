@@ -33,8 +33,9 @@ func (c *Consumer) Start() {
 	c.cancel = cancel
 
 	listeners := map[string]HandlerFunc{
-		"stock.reserved": c.handleStockReserved,
-		"payment.failed": c.handlePaymentFailed,
+		"stock.reserved":   c.handleStockReserved,
+		"payment.failed":   c.handlePaymentFailed,
+		"returns.refunded": c.handleReturnRefunded,
 	}
 
 	for topic, handler := range listeners {
@@ -104,7 +105,19 @@ func (c *Consumer) handlePaymentFailed(ctx context.Context, m *kafka.Message) er
 		return err
 	}
 
-	err = c.service.ConfirmOrder(ctx, event.Data)
+	err = c.service.CompensateOrder(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleReturnRefunded(ctx context.Context, m *kafka.Message) error {
+	var event service.ReturnEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.MarkReturnRefunded(ctx, event.Data)
 
 	return err
 }
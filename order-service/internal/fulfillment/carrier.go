@@ -0,0 +1,49 @@
+package fulfillment
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/model"
+	"time"
+)
+
+var (
+	ErrUnknownCarrier        = errors.New("unknown carrier")
+	ErrUnknownTrackingNumber = errors.New("unknown tracking number")
+)
+
+// Label is what a carrier issues for a parcel it accepted.
+type Label struct {
+	TrackingNumber    string
+	EstimatedDelivery time.Time
+}
+
+// Carrier adapts a carrier's API. CreateShipment books a parcel with items of the order,
+// and Track returns every status update the carrier has reported for it so far, oldest
+// first; updates already seen are returned again.
+type Carrier interface {
+	Name() string
+	CreateShipment(ctx context.Context, order *model.Order, items []*model.ShipmentItem) (Label, error)
+	Track(ctx context.Context, trackingNumber string) ([]*model.TrackingEvent, error)
+}
+
+// Carriers holds the carriers shipments can be sent with, by name.
+type Carriers map[string]Carrier
+
+func NewCarriers(carriers ...Carrier) Carriers {
+	c := make(Carriers, len(carriers))
+	for _, carrier := range carriers {
+		c[carrier.Name()] = carrier
+	}
+
+	return c
+}
+
+func (c Carriers) Get(name string) (Carrier, error) {
+	carrier, ok := c[name]
+	if !ok {
+		return nil, ErrUnknownCarrier
+	}
+
+	return carrier, nil
+}
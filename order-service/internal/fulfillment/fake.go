@@ -0,0 +1,78 @@
+package fulfillment
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"order-service/internal/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const fakeTrackingPrefix = "FAKE-"
+
+var fakeProgress = []struct {
+	status      model.ShipmentStatus
+	description string
+}{
+	{model.ShipmentLabelCreated, "Shipping label created"},
+	{model.ShipmentInTransit, "Picked up by carrier"},
+	{model.ShipmentOutForDelivery, "Out for delivery"},
+	{model.ShipmentDelivered, "Delivered"},
+}
+
+// FakeCarrier ships nothing, for development and tests. Its tracking numbers encode when
+// the label was created, and a parcel moves to its next status every step after that
+// until it's delivered. It keeps no state, so tracking survives restarts.
+type FakeCarrier struct {
+	step time.Duration
+}
+
+func NewFakeCarrier(step time.Duration) *FakeCarrier {
+	return &FakeCarrier{step: step}
+}
+
+func (f *FakeCarrier) Name() string {
+	return "fake"
+}
+
+func (f *FakeCarrier) CreateShipment(_ context.Context, _ *model.Order, _ []*model.ShipmentItem) (Label, error) {
+	now := time.Now()
+
+	return Label{
+		TrackingNumber:    fmt.Sprintf("%s%d-%06d", fakeTrackingPrefix, now.UnixMilli(), rand.IntN(1_000_000)),
+		EstimatedDelivery: now.Add(time.Duration(len(fakeProgress)-1) * f.step),
+	}, nil
+}
+
+func (f *FakeCarrier) Track(_ context.Context, trackingNumber string) ([]*model.TrackingEvent, error) {
+	created, ok := strings.CutPrefix(trackingNumber, fakeTrackingPrefix)
+	if !ok {
+		return nil, ErrUnknownTrackingNumber
+	}
+	created, _, _ = strings.Cut(created, "-")
+
+	millis, err := strconv.ParseInt(created, 10, 64)
+	if err != nil {
+		return nil, ErrUnknownTrackingNumber
+	}
+	createdAt := time.UnixMilli(millis)
+
+	var events []*model.TrackingEvent
+	for i, progress := range fakeProgress {
+		occurredAt := createdAt.Add(time.Duration(i) * f.step)
+		if occurredAt.After(time.Now()) {
+			break
+		}
+
+		events = append(events, &model.TrackingEvent{
+			Status:      progress.status,
+			Description: progress.description,
+			Location:    "Fake Carrier Hub",
+			OccurredAt:  occurredAt,
+		})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,94 @@
+package fulfillment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"order-service/internal/model"
+	"testing"
+	"time"
+)
+
+func TestFakeCarrierTrack(t *testing.T) {
+	step := time.Hour
+	carrier := NewFakeCarrier(step)
+
+	tracking := func(ago time.Duration) string {
+		return fmt.Sprintf("%s%d-%06d", fakeTrackingPrefix, time.Now().Add(-ago).UnixMilli(), 42)
+	}
+
+	tests := []struct {
+		name           string
+		trackingNumber string
+		want           []model.ShipmentStatus
+		wantErr        error
+	}{
+		{name: "label just created", trackingNumber: tracking(0), want: []model.ShipmentStatus{model.ShipmentLabelCreated}},
+		{
+			name:           "in transit",
+			trackingNumber: tracking(step + step/2),
+			want:           []model.ShipmentStatus{model.ShipmentLabelCreated, model.ShipmentInTransit},
+		},
+		{
+			name:           "out for delivery",
+			trackingNumber: tracking(2*step + step/2),
+			want:           []model.ShipmentStatus{model.ShipmentLabelCreated, model.ShipmentInTransit, model.ShipmentOutForDelivery},
+		},
+		{
+			name:           "delivered",
+			trackingNumber: tracking(10 * step),
+			want: []model.ShipmentStatus{
+				model.ShipmentLabelCreated, model.ShipmentInTransit, model.ShipmentOutForDelivery, model.ShipmentDelivered,
+			},
+		},
+		{name: "created in the future", trackingNumber: tracking(-step)},
+		{name: "without sequence number", trackingNumber: fmt.Sprintf("%s%d", fakeTrackingPrefix, time.Now().UnixMilli()), want: []model.ShipmentStatus{model.ShipmentLabelCreated}},
+		{name: "another carrier's number", trackingNumber: "1Z999AA10123456784", wantErr: ErrUnknownTrackingNumber},
+		{name: "no timestamp", trackingNumber: "FAKE-yesterday-000042", wantErr: ErrUnknownTrackingNumber},
+		{name: "empty", trackingNumber: "", wantErr: ErrUnknownTrackingNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := carrier.Track(context.Background(), tt.trackingNumber)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Track() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(events) != len(tt.want) {
+				t.Fatalf("Track() returned %d events, want %d", len(events), len(tt.want))
+			}
+			for i, event := range events {
+				if event.Status != tt.want[i] {
+					t.Errorf("event %d status = %s, want %s", i, event.Status, tt.want[i])
+				}
+				if i > 0 && event.OccurredAt.Sub(events[i-1].OccurredAt) != step {
+					t.Errorf("event %d occurred %s after the previous one, want %s", i, event.OccurredAt.Sub(events[i-1].OccurredAt), step)
+				}
+			}
+		})
+	}
+}
+
+func TestFakeCarrierCreateShipment(t *testing.T) {
+	step := time.Hour
+	carrier := NewFakeCarrier(step)
+
+	before := time.Now()
+	label, err := carrier.CreateShipment(context.Background(), &model.Order{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := before.Add(3 * step); label.EstimatedDelivery.Before(want) || label.EstimatedDelivery.After(want.Add(time.Minute)) {
+		t.Errorf("EstimatedDelivery = %s, want about %s", label.EstimatedDelivery, want)
+	}
+
+	events, err := carrier.Track(context.Background(), label.TrackingNumber)
+	if err != nil {
+		t.Fatalf("Track(%q) error = %v", label.TrackingNumber, err)
+	}
+	if len(events) != 1 || events[0].Status != model.ShipmentLabelCreated {
+		t.Errorf("Track(%q) = %+v, want only the label", label.TrackingNumber, events)
+	}
+}
@@ -0,0 +1,155 @@
+package invoice
+
+import (
+	"bytes"
+	_ "embed"
+	"order-service/internal/model"
+	"strings"
+	"text/template"
+)
+
+const (
+	linesPerPage     = 24
+	descriptionWidth = 42
+)
+
+//go:embed templates/invoice.tmpl
+var invoiceTemplate string
+
+// Seller is the business that issues the invoices.
+type Seller struct {
+	Name    string
+	Address string
+}
+
+// Document is what an invoice is rendered from.
+type Document struct {
+	Invoice       *model.Invoice
+	Order         *model.Order
+	CustomerName  string
+	CustomerEmail string
+}
+
+// Renderer renders invoices to PDF from a template of PDF page content, executed once per
+// page.
+type Renderer struct {
+	seller Seller
+	tmpl   *template.Template
+}
+
+func NewRenderer(seller Seller) (*Renderer, error) {
+	tmpl, err := template.New("invoice").Funcs(template.FuncMap{
+		"text":  pdfText,
+		"below": func(top, i, step int) int { return top - i*step },
+	}).Parse(invoiceTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Renderer{seller: seller, tmpl: tmpl}, nil
+}
+
+// page is what the template renders: the invoice header, some of its lines, and the totals
+// on the last page.
+type page struct {
+	Document
+	Seller      Seller
+	PaymentNote string
+	ShipTo      []string
+	Lines       []line
+	Totals      []total
+	Number      int
+	Count       int
+}
+
+type line struct {
+	Description string
+	Sku         string
+	Quantity    int64
+	UnitPrice   string
+	Amount      string
+}
+
+type total struct {
+	Label  string
+	Amount string
+	Bold   bool
+}
+
+func (r *Renderer) Render(doc Document) ([]byte, error) {
+	order := doc.Order
+
+	lines := make([]line, len(order.Items))
+	for i, item := range order.Items {
+		description := item.Name
+		if description == "" {
+			description = item.Sku
+		}
+
+		lines[i] = line{
+			Description: truncate(description, descriptionWidth),
+			Sku:         item.Sku,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.Price.String(),
+			Amount:      item.Price.Mul(item.Quantity).String(),
+		}
+	}
+
+	totals := []total{{Label: "Subtotal", Amount: order.Subtotal.String()}}
+	if order.Discount.Amount != 0 {
+		totals = append(totals, total{Label: "Discount", Amount: "-" + order.Discount.String()})
+	}
+	totals = append(totals,
+		total{Label: "Shipping", Amount: order.Shipping.String()},
+		total{Label: "Tax", Amount: order.Tax.String()},
+		total{Label: "Total", Amount: order.TotalPrice.String(), Bold: true},
+	)
+
+	paymentNote := "Paid by card"
+	if order.PaymentMethod == "ON_DELIVERY" {
+		paymentNote = "Payment due on delivery"
+	}
+
+	shipTo := []string{order.ShippingAddress}
+	if a := order.Address; a != nil {
+		shipTo = []string{a.FullName, a.Line1}
+		if a.Line2 != "" {
+			shipTo = append(shipTo, a.Line2)
+		}
+		shipTo = append(shipTo, strings.Join(strings.Fields(a.City+" "+a.Region+" "+a.PostalCode), " "), a.Country)
+	}
+
+	count := max(1, (len(lines)+linesPerPage-1)/linesPerPage)
+	pages := make([][]byte, count)
+	for i := range pages {
+		p := page{
+			Document:    doc,
+			Seller:      r.seller,
+			PaymentNote: paymentNote,
+			ShipTo:      shipTo,
+			Lines:       lines[i*linesPerPage : min(len(lines), (i+1)*linesPerPage)],
+			Number:      i + 1,
+			Count:       count,
+		}
+		if i == count-1 {
+			p.Totals = totals
+		}
+
+		var content bytes.Buffer
+		if err := r.tmpl.Execute(&content, p); err != nil {
+			return nil, err
+		}
+		pages[i] = content.Bytes()
+	}
+
+	return writePDF(pages)
+}
+
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,23 @@
+package invoice
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{in: "Mug", width: 5, want: "Mug"},
+		{in: "Mugs!", width: 5, want: "Mugs!"},
+		{in: "Coffee mug", width: 5, want: "Coff…"},
+		{in: "Café crème", width: 6, want: "Café …"},
+		{in: "Київська кава", width: 6, want: "Київс…"},
+	}
+
+	for _, tt := range tests {
+		if got := truncate(tt.in, tt.width); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+	}
+}
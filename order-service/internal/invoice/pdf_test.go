@@ -0,0 +1,33 @@
+package invoice
+
+import "testing"
+
+func TestPDFText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "Ada Lovelace", want: "Ada Lovelace"},
+		{name: "empty", in: "", want: ""},
+		{name: "parentheses", in: "Mug (blue)", want: `Mug \(blue\)`},
+		{name: "unbalanced parenthesis", in: ") Tj ET", want: `\) Tj ET`},
+		{name: "backslash", in: `C:\temp`, want: `C:\\temp`},
+		{name: "control characters", in: "line\none\ttab\r", want: "line one tab "},
+		{name: "latin-1", in: "Café Müller", want: `Caf\351 M\374ller`},
+		{name: "non-breaking space", in: "10\u00a0kg", want: `10\240kg`},
+		{name: "euro sign", in: "€5", want: `\2005`},
+		{name: "dashes and quotes", in: "“A”—B’s", want: `\223A\224\227B\222s`},
+		{name: "delete", in: "a\x7fb", want: "a?b"},
+		{name: "c1 control", in: "a\u0085b", want: "a?b"},
+		{name: "outside winansi", in: "Київ 東京 🎁", want: "???? ?? ?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pdfText(tt.in); got != tt.want {
+				t.Errorf("pdfText(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
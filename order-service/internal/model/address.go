@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidAddress = errors.New("invalid address")
+
+// Address is a postal shipping address. Country is an ISO 3166-1 alpha-2 code and Region
+// the state or province code within it, where the country has them.
+type Address struct {
+	FullName   string `json:"full_name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
+
+// Normalize trims every field and upper-cases the country and region codes.
+func (a Address) Normalize() Address {
+	return Address{
+		FullName:   strings.TrimSpace(a.FullName),
+		Line1:      strings.TrimSpace(a.Line1),
+		Line2:      strings.TrimSpace(a.Line2),
+		City:       strings.TrimSpace(a.City),
+		Region:     strings.ToUpper(strings.TrimSpace(a.Region)),
+		PostalCode: strings.TrimSpace(a.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+	}
+}
+
+func (a Address) Validate() error {
+	switch {
+	case a.FullName == "":
+		return fmt.Errorf("%w: full name is required", ErrInvalidAddress)
+	case a.Line1 == "":
+		return fmt.Errorf("%w: address line is required", ErrInvalidAddress)
+	case a.City == "":
+		return fmt.Errorf("%w: city is required", ErrInvalidAddress)
+	case a.PostalCode == "":
+		return fmt.Errorf("%w: postal code is required", ErrInvalidAddress)
+	case len(a.Country) != 2:
+		return fmt.Errorf("%w: country must be a two-letter code", ErrInvalidAddress)
+	}
+
+	return nil
+}
+
+// String formats the address on one line, as it's shown in emails and order listings.
+func (a Address) String() string {
+	parts := []string{a.FullName, a.Line1, a.Line2, a.City, strings.TrimSpace(a.Region + " " + a.PostalCode), a.Country}
+
+	nonEmpty := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, ", ")
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"shared/money"
+	"time"
+)
+
+type CheckoutSessionStatus string
+
+const (
+	SessionOpen      CheckoutSessionStatus = "OPEN"
+	SessionCompleted CheckoutSessionStatus = "COMPLETED"
+	SessionExpired   CheckoutSessionStatus = "EXPIRED"
+)
+
+// CheckoutSession freezes a cart and its quote between paying and placing the order, so
+// both charge and record the same amounts whatever happens to the cart in between. A
+// session is completed by the one order created from it.
+type CheckoutSession struct {
+	ID                 string                `json:"id"`
+	UserID             int64                 `json:"user_id"`
+	Status             CheckoutSessionStatus `json:"status"`
+	Lines              []*CheckoutLine       `json:"lines"`
+	Address            Address               `json:"address"`
+	ShippingMethod     string                `json:"shipping_method"`
+	ShippingMethodName string                `json:"shipping_method_name"`
+	DeliveryDays       int32                 `json:"delivery_days"`
+	CouponCode         string                `json:"coupon_code"`
+	Subtotal           money.Money           `json:"subtotal"`
+	Discount           money.Money           `json:"discount"`
+	Shipping           money.Money           `json:"shipping"`
+	Tax                money.Money           `json:"tax"`
+	Total              money.Money           `json:"total"`
+	OrderID            *int64                `json:"order_id"`
+	CreatedAt          time.Time             `json:"created_at"`
+	ExpiresAt          time.Time             `json:"expires_at"`
+}
+
+// CheckoutLine is a cart line as it was when the session was opened, priced in the
+// session currency.
+type CheckoutLine struct {
+	Sku       string      `json:"sku"`
+	ProductID string      `json:"product_id"`
+	Name      string      `json:"name"`
+	ImageURL  string      `json:"image_url"`
+	Quantity  int32       `json:"quantity"`
+	Price     money.Money `json:"price"`
+}
+
+// Open reports whether an order can still be created from the session at t.
+func (s *CheckoutSession) Open(t time.Time) bool {
+	return s.Status == SessionOpen && t.Before(s.ExpiresAt)
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"shared/money"
+	"time"
+)
+
+type CouponType string
+
+const (
+	Percentage   CouponType = "PERCENTAGE"
+	FixedAmount  CouponType = "FIXED_AMOUNT"
+	BuyXGetY     CouponType = "BUY_X_GET_Y"
+	FreeShipping CouponType = "FREE_SHIPPING"
+)
+
+// Coupon is a promotion code. AmountOff and MinBasket share the coupon currency; the cart
+// converts them into its own currency when evaluating the discount. Limits of zero mean
+// unlimited.
+type Coupon struct {
+	Code          string      `json:"code"`
+	Type          CouponType  `json:"type"`
+	PercentOff    int32       `json:"percent_off"`
+	AmountOff     money.Money `json:"amount_off"`
+	MinBasket     money.Money `json:"min_basket"`
+	BuySku        string      `json:"buy_sku"`
+	BuyQuantity   int32       `json:"buy_quantity"`
+	GetQuantity   int32       `json:"get_quantity"`
+	UsageLimit    int32       `json:"usage_limit"`
+	PerUserLimit  int32       `json:"per_user_limit"`
+	TimesRedeemed int32       `json:"times_redeemed"`
+	StartsAt      *time.Time  `json:"starts_at"`
+	EndsAt        *time.Time  `json:"ends_at"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// Active reports whether the coupon's validity window contains t.
+func (c *Coupon) Active(t time.Time) bool {
+	return (c.StartsAt == nil || !t.Before(*c.StartsAt)) && (c.EndsAt == nil || t.Before(*c.EndsAt))
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// Invoice is the invoice issued for an order when it was confirmed. Numbers run without
+// gaps within a year, e.g. INV-2026-000042; the PDF is kept in the blob store under BlobKey.
+type Invoice struct {
+	ID       int64     `json:"id"`
+	OrderID  int64     `json:"order_id"`
+	Number   string    `json:"number"`
+	IssuedAt time.Time `json:"issued_at"`
+	BlobKey  string    `json:"blob_key"`
+}
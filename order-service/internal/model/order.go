@@ -1,32 +1,134 @@
 package model
 
 import (
+	"shared/money"
 	"time"
 )
 
 type Status string
 
 const (
-	Pending   Status = "PENDING"
-	Paid      Status = "PAID"
-	Confirmed Status = "CONFIRMED"
-	Cancelled Status = "CANCELLED"
+	Pending          Status = "PENDING"
+	Paid             Status = "PAID"
+	Confirmed        Status = "CONFIRMED"
+	Cancelled        Status = "CANCELLED"
+	PartiallyShipped Status = "PARTIALLY_SHIPPED"
+	Shipped          Status = "SHIPPED"
+	Delivered        Status = "DELIVERED"
 )
 
+// Cancellable reports whether the customer can still cancel an order in this status. A
+// pending order is still going through payment and stock reservation, so it can't be
+// cancelled until it settles.
+func (s Status) Cancellable() bool {
+	return s == Paid || s == Confirmed
+}
+
+// Shippable reports whether lines of an order in this status can still be shipped.
+func (s Status) Shippable() bool {
+	return s == Paid || s == Confirmed || s == PartiallyShipped
+}
+
+// AfterShipping derives the status of an order from its shipments, given how many units
+// were ordered and shipped and how many shipments are still on their way:
+// PARTIALLY_SHIPPED until every unit is shipped, then SHIPPED, and DELIVERED once every
+// shipment arrived. Orders that were cancelled, already delivered or not shipped yet keep
+// their status.
+func (s Status) AfterShipping(ordered, shipped, undelivered int64) Status {
+	if !s.Shippable() && s != Shipped {
+		return s
+	}
+
+	switch {
+	case shipped == 0:
+		return s
+	case shipped < ordered:
+		return PartiallyShipped
+	case undelivered > 0:
+		return Shipped
+	}
+
+	return Delivered
+}
+
+// Invoiceable reports whether an order in this status has been confirmed, and so has or
+// can get an invoice.
+func (s Status) Invoiceable() bool {
+	return s == Paid || s == Confirmed || s == PartiallyShipped || s == Shipped || s == Delivered
+}
+
+// Order amounts are all in the order currency. TotalPrice is the grand total charged:
+// Subtotal - Discount + Shipping + Tax. Address is nil for orders placed before addresses
+// were structured; ShippingAddress always holds the address as text.
 type Order struct {
 	ID              int64        `json:"id"`
 	UserID          int64        `json:"user_id"`
 	Status          Status       `json:"status"`
 	Items           []*OrderItem `json:"items"`
-	TotalPrice      float64      `json:"total_price"`
+	TotalPrice      money.Money  `json:"total_price"`
 	ShippingAddress string       `json:"shipping_address"`
 	CreatedAt       time.Time    `json:"created_at"`
+	CouponCode      string       `json:"coupon_code"`
+	Discount        money.Money  `json:"discount"`
+	Subtotal        money.Money  `json:"subtotal"`
+	Shipping        money.Money  `json:"shipping"`
+	Tax             money.Money  `json:"tax"`
+	ShippingMethod  string       `json:"shipping_method"`
+	Address         *Address     `json:"address"`
+	// CheckoutSessionID is the session the order was placed from, if any.
+	CheckoutSessionID  string `json:"checkout_session_id"`
+	CancellationReason string `json:"cancellation_reason"`
+	// PaymentMethod is empty for orders placed before it was recorded.
+	PaymentMethod string          `json:"payment_method"`
+	StatusHistory []*StatusChange `json:"status_history"`
+	// SubscriptionID is the subscription that placed the order, or 0.
+	SubscriptionID int64 `json:"subscription_id"`
 }
 
+// OrderItem is an order line. ProductID, Name and ImageURL are a snapshot of the product
+// when the order was placed, empty for older orders.
 type OrderItem struct {
-	ID       int64   `json:"id"`
-	OrderID  int64   `json:"order_id"`
-	Quantity int64   `json:"quantity"`
-	Price    float64 `json:"price"`
-	Sku      string  `json:"sku"`
+	ID        int64       `json:"id"`
+	OrderID   int64       `json:"order_id"`
+	Quantity  int64       `json:"quantity"`
+	Price     money.Money `json:"price"`
+	Sku       string      `json:"sku"`
+	ProductID string      `json:"product_id"`
+	Name      string      `json:"name"`
+	ImageURL  string      `json:"image_url"`
+}
+
+// StatusChange is an entry of an order's status history. ChangedBy is the staff member
+// who overrode the status, or 0 for every other change.
+type StatusChange struct {
+	Status    Status    `json:"status"`
+	Reason    string    `json:"reason"`
+	ChangedBy int64     `json:"changed_by"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// OrderNote is an internal note staff left on an order. Customers don't see notes.
+type OrderNote struct {
+	ID        int64     `json:"id"`
+	OrderID   int64     `json:"order_id"`
+	AuthorID  int64     `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrderFilter selects the orders to list. Zero fields match every order.
+type OrderFilter struct {
+	UserID   int64
+	Statuses []Status
+	// Orders placed at or after CreatedAfter and before CreatedBefore.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// Sku matches orders with a line of that SKU.
+	Sku string
+}
+
+// OrderCursor is the position of an order in a listing sorted by creation time.
+type OrderCursor struct {
+	CreatedAt time.Time
+	ID        int64
 }
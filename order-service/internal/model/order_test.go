@@ -0,0 +1,34 @@
+package model
+
+import "testing"
+
+func TestAfterShipping(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     Status
+		ordered     int64
+		shipped     int64
+		undelivered int64
+		want        Status
+	}{
+		{name: "nothing shipped yet", current: Confirmed, ordered: 5, want: Confirmed},
+		{name: "first parcel of several", current: Confirmed, ordered: 5, shipped: 2, undelivered: 1, want: PartiallyShipped},
+		{name: "paid order partly shipped", current: Paid, ordered: 5, shipped: 2, undelivered: 1, want: PartiallyShipped},
+		{name: "partial parcel delivered", current: PartiallyShipped, ordered: 5, shipped: 2, want: PartiallyShipped},
+		{name: "last parcel shipped", current: PartiallyShipped, ordered: 5, shipped: 5, undelivered: 2, want: Shipped},
+		{name: "shipped in one parcel", current: Confirmed, ordered: 5, shipped: 5, undelivered: 1, want: Shipped},
+		{name: "one of two parcels delivered", current: Shipped, ordered: 5, shipped: 5, undelivered: 1, want: Shipped},
+		{name: "every parcel delivered", current: Shipped, ordered: 5, shipped: 5, want: Delivered},
+		{name: "already delivered", current: Delivered, ordered: 5, shipped: 5, want: Delivered},
+		{name: "cancelled", current: Cancelled, ordered: 5, shipped: 2, undelivered: 1, want: Cancelled},
+		{name: "pending", current: Pending, ordered: 5, shipped: 5, want: Pending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.current.AfterShipping(tt.ordered, tt.shipped, tt.undelivered); got != tt.want {
+				t.Errorf("%s.AfterShipping(%d, %d, %d) = %s, want %s", tt.current, tt.ordered, tt.shipped, tt.undelivered, got, tt.want)
+			}
+		})
+	}
+}
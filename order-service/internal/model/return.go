@@ -0,0 +1,44 @@
+package model
+
+import (
+	"shared/money"
+	"time"
+)
+
+type ReturnStatus string
+
+const (
+	ReturnRequested ReturnStatus = "REQUESTED"
+	ReturnApproved  ReturnStatus = "APPROVED"
+	ReturnRejected  ReturnStatus = "REJECTED"
+	ReturnReceived  ReturnStatus = "RECEIVED"
+	ReturnRefunded  ReturnStatus = "REFUNDED"
+)
+
+// Return is a customer's request to send back lines of a delivered order. Staff approve or
+// reject it, then inspect what arrives; RefundAmount is set at inspection and paid back by
+// the payment service.
+type Return struct {
+	ID           int64         `json:"id"`
+	OrderID      int64         `json:"order_id"`
+	UserID       int64         `json:"user_id"`
+	Status       ReturnStatus  `json:"status"`
+	Items        []*ReturnItem `json:"items"`
+	StaffNote    string        `json:"staff_note"`
+	RefundAmount money.Money   `json:"refund_amount"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// ReturnItem is part of an order line being returned. AcceptedQuantity is nil until the
+// item is inspected, and Restock tells whether the accepted units can be sold again.
+type ReturnItem struct {
+	ID               int64       `json:"id"`
+	Sku              string      `json:"sku"`
+	Quantity         int64       `json:"quantity"`
+	UnitPrice        money.Money `json:"unit_price"`
+	Reason           string      `json:"reason"`
+	PhotoRefs        []string    `json:"photo_refs"`
+	AcceptedQuantity *int64      `json:"accepted_quantity"`
+	Restock          bool        `json:"restock"`
+}
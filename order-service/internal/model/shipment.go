@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+type ShipmentStatus string
+
+const (
+	ShipmentLabelCreated   ShipmentStatus = "LABEL_CREATED"
+	ShipmentInTransit      ShipmentStatus = "IN_TRANSIT"
+	ShipmentOutForDelivery ShipmentStatus = "OUT_FOR_DELIVERY"
+	ShipmentDelivered      ShipmentStatus = "DELIVERED"
+	ShipmentException      ShipmentStatus = "EXCEPTION"
+)
+
+// Shipment is a parcel handed to a carrier with some of an order's lines. An order can be
+// sent in several shipments; its status follows from what they cover and whether they
+// have been delivered.
+type Shipment struct {
+	ID                int64            `json:"id"`
+	OrderID           int64            `json:"order_id"`
+	Carrier           string           `json:"carrier"`
+	TrackingNumber    string           `json:"tracking_number"`
+	Status            ShipmentStatus   `json:"status"`
+	Items             []*ShipmentItem  `json:"items"`
+	Events            []*TrackingEvent `json:"events"`
+	EstimatedDelivery time.Time        `json:"estimated_delivery"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+	DeliveredAt       *time.Time       `json:"delivered_at"`
+}
+
+type ShipmentItem struct {
+	Sku      string `json:"sku"`
+	Quantity int64  `json:"quantity"`
+}
+
+// TrackingEvent is a status update reported by the carrier.
+type TrackingEvent struct {
+	Status      ShipmentStatus `json:"status"`
+	Description string         `json:"description"`
+	Location    string         `json:"location"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+}
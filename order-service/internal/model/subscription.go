@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+)
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionActive    SubscriptionStatus = "ACTIVE"
+	SubscriptionPaused    SubscriptionStatus = "PAUSED"
+	SubscriptionCancelled SubscriptionStatus = "CANCELLED"
+)
+
+// Subscription orders the same items every IntervalDays. NextOrderAt is when the order of
+// the next delivery is due. An order that couldn't be placed or paid for is retried at
+// RetryAt; PendingOrderID is the order placed for the delivery while it's still pending.
+type Subscription struct {
+	ID                    int64               `json:"id"`
+	UserID                int64               `json:"user_id"`
+	Status                SubscriptionStatus  `json:"status"`
+	Items                 []*SubscriptionItem `json:"items"`
+	IntervalDays          int32               `json:"interval_days"`
+	Address               Address             `json:"address"`
+	ShippingMethod        string              `json:"shipping_method"`
+	PaymentMethod         string              `json:"payment_method"`
+	StoredPaymentMethodID string              `json:"stored_payment_method_id"`
+	NextOrderAt           time.Time           `json:"next_order_at"`
+	RetryAt               *time.Time          `json:"retry_at"`
+	FailedAttempts        int32               `json:"failed_attempts"`
+	LastFailure           string              `json:"last_failure"`
+	PendingOrderID        *int64              `json:"pending_order_id"`
+	CreatedAt             time.Time           `json:"created_at"`
+	UpdatedAt             time.Time           `json:"updated_at"`
+	CancelledAt           *time.Time          `json:"cancelled_at"`
+}
+
+// SubscriptionItem is a SKU ordered on every delivery. Name is the product name when the
+// subscription was created.
+type SubscriptionItem struct {
+	Sku      string `json:"sku"`
+	Name     string `json:"name"`
+	Quantity int32  `json:"quantity"`
+}
+
+// Interval is the time between two deliveries.
+func (s *Subscription) Interval() time.Duration {
+	return time.Duration(s.IntervalDays) * 24 * time.Hour
+}
+
+// AdvanceDelivery moves NextOrderAt to the first delivery after now and clears the failures
+// of the delivery it moves on from.
+func (s *Subscription) AdvanceDelivery(now time.Time) {
+	s.NextOrderAt = s.NextOrderAt.Add(s.Interval())
+	for !s.NextOrderAt.After(now) {
+		s.NextOrderAt = s.NextOrderAt.Add(s.Interval())
+	}
+
+	s.RetryAt = nil
+	s.FailedAttempts = 0
+}
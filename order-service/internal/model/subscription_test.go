@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdvanceDelivery(t *testing.T) {
+	due := time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name         string
+		intervalDays int32
+		now          time.Time
+		want         time.Time
+	}{
+		{name: "ordered when due", intervalDays: 7, now: due, want: due.Add(7 * day)},
+		{name: "ordered late", intervalDays: 7, now: due.Add(3 * day), want: due.Add(7 * day)},
+		{name: "skipped early", intervalDays: 7, now: due.Add(-5 * day), want: due.Add(7 * day)},
+		{name: "next delivery due now", intervalDays: 7, now: due.Add(7 * day), want: due.Add(14 * day)},
+		{name: "several deliveries missed", intervalDays: 7, now: due.Add(20 * day), want: due.Add(21 * day)},
+		{name: "daily", intervalDays: 1, now: due.Add(36 * time.Hour), want: due.Add(2 * day)},
+		{name: "monthly", intervalDays: 30, now: due.Add(time.Minute), want: due.Add(30 * day)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryAt := tt.now.Add(time.Hour)
+			sub := &Subscription{
+				IntervalDays:   tt.intervalDays,
+				NextOrderAt:    due,
+				RetryAt:        &retryAt,
+				FailedAttempts: 2,
+			}
+
+			sub.AdvanceDelivery(tt.now)
+
+			if !sub.NextOrderAt.Equal(tt.want) {
+				t.Errorf("NextOrderAt = %s, want %s", sub.NextOrderAt, tt.want)
+			}
+			if sub.RetryAt != nil || sub.FailedAttempts != 0 {
+				t.Errorf("RetryAt = %v, FailedAttempts = %d, want the failures cleared", sub.RetryAt, sub.FailedAttempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"order-service/internal/model"
+	"time"
+)
+
+var ErrStatusUnchanged = errors.New("order already has that status")
+
+// OverrideOrderStatus sets the order status on behalf of a staff member, whatever it was,
+// and records the reason and who made the change in the history. An order cancelled this
+// way gets the reason as its cancellation reason and gives its coupon use back.
+func (r *Repository) OverrideOrderStatus(ctx context.Context, orderID int64, status model.Status, reason string, staffID int64, now time.Time) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var current model.Status
+	err = tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = $1 FOR UPDATE`, orderID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	if current == status {
+		return ErrStatusUnchanged
+	}
+
+	if status == model.Cancelled {
+		_, err = tx.ExecContext(ctx, `UPDATE orders SET status = $1, cancellation_reason = $2, cancelled_at = $3 WHERE id = $4`,
+			status, reason, now, orderID,
+		)
+		if err != nil {
+			return err
+		}
+		err = releaseCoupon(ctx, tx, orderID)
+	} else {
+		_, err = tx.ExecContext(ctx, `UPDATE orders SET status = $1 WHERE id = $2`, status, orderID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = recordStatusChange(ctx, tx, orderID, status, reason, staffID, now); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) AddOrderNote(ctx context.Context, note *model.OrderNote) error {
+	query := `INSERT INTO order_notes (order_id, author_id, body, created_at) VALUES ($1, $2, $3, $4) RETURNING id`
+
+	return r.conn.QueryRowContext(ctx, query, note.OrderID, note.AuthorID, note.Body, note.CreatedAt).Scan(&note.ID)
+}
+
+// GetOrderNotes returns the notes on an order, oldest first.
+func (r *Repository) GetOrderNotes(ctx context.Context, orderID int64) ([]*model.OrderNote, error) {
+	query := `SELECT id, order_id, author_id, body, created_at FROM order_notes WHERE order_id = $1 ORDER BY created_at, id`
+
+	rows, err := r.conn.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notes := []*model.OrderNote{}
+	for rows.Next() {
+		var note model.OrderNote
+		if err = rows.Scan(&note.ID, &note.OrderID, &note.AuthorID, &note.Body, &note.CreatedAt); err != nil {
+			return nil, err
+		}
+		notes = append(notes, &note)
+	}
+
+	return notes, rows.Err()
+}
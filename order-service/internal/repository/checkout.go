@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"order-service/internal/model"
+	"shared/money"
+	"time"
+)
+
+var (
+	ErrCheckoutSessionNotFound    = errors.New("checkout session not found")
+	ErrCheckoutSessionUnavailable = errors.New("checkout session is no longer open")
+)
+
+func (r *Repository) CreateCheckoutSession(ctx context.Context, session *model.CheckoutSession) error {
+	lines, err := json.Marshal(session.Lines)
+	if err != nil {
+		return err
+	}
+
+	address, err := json.Marshal(session.Address)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO checkout_sessions (id, user_id, status, lines, address, shipping_method, shipping_method_name,
+                               delivery_days, coupon_code, subtotal, discount, shipping, tax, total, currency,
+                               created_at, expires_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+
+	_, err = r.conn.ExecContext(ctx, query,
+		session.ID,
+		session.UserID,
+		session.Status,
+		lines,
+		address,
+		session.ShippingMethod,
+		session.ShippingMethodName,
+		session.DeliveryDays,
+		sql.NullString{String: session.CouponCode, Valid: session.CouponCode != ""},
+		session.Subtotal.Decimal(),
+		session.Discount.Decimal(),
+		session.Shipping.Decimal(),
+		session.Tax.Decimal(),
+		session.Total.Decimal(),
+		session.Total.Currency,
+		session.CreatedAt,
+		session.ExpiresAt,
+	)
+
+	return err
+}
+
+func (r *Repository) GetCheckoutSession(ctx context.Context, id string) (*model.CheckoutSession, error) {
+	query := `SELECT id, user_id, status, lines, address, shipping_method, shipping_method_name, delivery_days,
+       COALESCE(coupon_code, ''), subtotal, discount, shipping, tax, total, currency, order_id, created_at, expires_at
+	FROM checkout_sessions WHERE id = $1`
+
+	var session model.CheckoutSession
+	var lines, address []byte
+	var subtotal, discount, shipping, tax, total, currency string
+	var orderID sql.NullInt64
+	err := r.conn.QueryRowContext(ctx, query, id).Scan(
+		&session.ID, &session.UserID, &session.Status, &lines, &address, &session.ShippingMethod,
+		&session.ShippingMethodName, &session.DeliveryDays, &session.CouponCode, &subtotal, &discount, &shipping,
+		&tax, &total, &currency, &orderID, &session.CreatedAt, &session.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCheckoutSessionNotFound
+		}
+		return nil, err
+	}
+
+	if err = json.Unmarshal(lines, &session.Lines); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(address, &session.Address); err != nil {
+		return nil, err
+	}
+
+	for _, amount := range []struct {
+		value string
+		dst   *money.Money
+	}{
+		{subtotal, &session.Subtotal},
+		{discount, &session.Discount},
+		{shipping, &session.Shipping},
+		{tax, &session.Tax},
+		{total, &session.Total},
+	} {
+		if *amount.dst, err = money.Parse(amount.value, currency); err != nil {
+			return nil, err
+		}
+	}
+
+	if orderID.Valid {
+		session.OrderID = &orderID.Int64
+	}
+
+	return &session, nil
+}
+
+// ExpireCheckoutSessions closes the open sessions that expired before now and returns them.
+func (r *Repository) ExpireCheckoutSessions(ctx context.Context, now time.Time) ([]*model.CheckoutSession, error) {
+	query := `UPDATE checkout_sessions SET status = 'EXPIRED'
+	WHERE status = 'OPEN' AND expires_at <= $1
+	RETURNING id, user_id, expires_at`
+
+	rows, err := r.conn.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*model.CheckoutSession
+	for rows.Next() {
+		session := &model.CheckoutSession{Status: model.SessionExpired}
+		if err = rows.Scan(&session.ID, &session.UserID, &session.ExpiresAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+// completeCheckoutSession marks the session as used by the order. It runs inside the
+// order's transaction, so a session that expired or was used in the meantime fails the
+// order instead of being used twice.
+func completeCheckoutSession(ctx context.Context, tx *sql.Tx, order *model.Order, now time.Time) error {
+	query := `UPDATE checkout_sessions SET status = 'COMPLETED', order_id = $1
+	WHERE id = $2 AND user_id = $3 AND status = 'OPEN' AND expires_at > $4`
+
+	res, err := tx.ExecContext(ctx, query, order.ID, order.CheckoutSessionID, order.UserID, now)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrCheckoutSessionUnavailable
+	}
+
+	return nil
+}
+
+// GetOrderLines returns the lines frozen in the checkout session an order was placed from.
+// Orders placed without a session have none.
+func (r *Repository) GetOrderLines(ctx context.Context, orderID int64) ([]model.CheckoutLine, error) {
+	var raw []byte
+	err := r.conn.QueryRowContext(ctx, `SELECT lines FROM checkout_sessions WHERE order_id = $1`, orderID).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var lines []model.CheckoutLine
+	if err = json.Unmarshal(raw, &lines); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
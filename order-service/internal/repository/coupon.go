@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"order-service/internal/model"
+	"shared/money"
+	"time"
+)
+
+var (
+	ErrCouponNotFound    = errors.New("coupon not found")
+	ErrCouponExists      = errors.New("coupon already exists")
+	ErrCouponUnavailable = errors.New("coupon is no longer available")
+)
+
+func (r *Repository) CreateCoupon(ctx context.Context, coupon *model.Coupon) error {
+	query := `INSERT INTO coupons (code, type, percent_off, amount_off, min_basket, currency, buy_sku,
+                     buy_quantity, get_quantity, usage_limit, per_user_limit, starts_at, ends_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+              ON CONFLICT (code) DO NOTHING
+              RETURNING created_at`
+
+	err := r.conn.QueryRowContext(ctx, query,
+		coupon.Code,
+		coupon.Type,
+		coupon.PercentOff,
+		coupon.AmountOff.Decimal(),
+		coupon.MinBasket.Decimal(),
+		coupon.AmountOff.Currency,
+		sql.NullString{String: coupon.BuySku, Valid: coupon.BuySku != ""},
+		coupon.BuyQuantity,
+		coupon.GetQuantity,
+		coupon.UsageLimit,
+		coupon.PerUserLimit,
+		coupon.StartsAt,
+		coupon.EndsAt,
+	).Scan(&coupon.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrCouponExists
+	}
+
+	return err
+}
+
+func (r *Repository) GetCoupon(ctx context.Context, code string) (*model.Coupon, error) {
+	query := `SELECT code, type, percent_off, amount_off, min_basket, currency, buy_sku, buy_quantity,
+       get_quantity, usage_limit, per_user_limit, times_redeemed, starts_at, ends_at, created_at
+	FROM coupons WHERE code = $1`
+
+	var coupon model.Coupon
+	var amountOff, minBasket, currency string
+	var buySku sql.NullString
+	var startsAt, endsAt sql.NullTime
+	err := r.conn.QueryRowContext(ctx, query, code).Scan(
+		&coupon.Code, &coupon.Type, &coupon.PercentOff, &amountOff, &minBasket, &currency, &buySku,
+		&coupon.BuyQuantity, &coupon.GetQuantity, &coupon.UsageLimit, &coupon.PerUserLimit,
+		&coupon.TimesRedeemed, &startsAt, &endsAt, &coupon.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCouponNotFound
+		}
+		return nil, err
+	}
+
+	if coupon.AmountOff, err = money.Parse(amountOff, currency); err != nil {
+		return nil, err
+	}
+	if coupon.MinBasket, err = money.Parse(minBasket, currency); err != nil {
+		return nil, err
+	}
+
+	coupon.BuySku = buySku.String
+	if startsAt.Valid {
+		coupon.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		coupon.EndsAt = &endsAt.Time
+	}
+
+	return &coupon, nil
+}
+
+func (r *Repository) CountUserRedemptions(ctx context.Context, code string, userID int64) (int32, error) {
+	query := `SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_code = $1 AND user_id = $2`
+
+	var count int32
+	err := r.conn.QueryRowContext(ctx, query, code, userID).Scan(&count)
+
+	return count, err
+}
+
+// redeemCoupon records the use of a coupon by an order inside the order's transaction.
+// The coupon row stays locked until the transaction ends, so concurrent checkouts can't
+// both take the last use.
+func redeemCoupon(ctx context.Context, tx *sql.Tx, order *model.Order, now time.Time) error {
+	coupon := model.Coupon{Code: order.CouponCode}
+	var startsAt, endsAt sql.NullTime
+	err := tx.QueryRowContext(ctx,
+		`SELECT usage_limit, per_user_limit, times_redeemed, starts_at, ends_at FROM coupons WHERE code = $1 FOR UPDATE`,
+		order.CouponCode,
+	).Scan(&coupon.UsageLimit, &coupon.PerUserLimit, &coupon.TimesRedeemed, &startsAt, &endsAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCouponUnavailable
+		}
+		return err
+	}
+
+	if startsAt.Valid {
+		coupon.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		coupon.EndsAt = &endsAt.Time
+	}
+
+	if !coupon.Active(now) || (coupon.UsageLimit > 0 && coupon.TimesRedeemed >= coupon.UsageLimit) {
+		return ErrCouponUnavailable
+	}
+
+	if coupon.PerUserLimit > 0 {
+		var used int32
+		err = tx.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_code = $1 AND user_id = $2`,
+			order.CouponCode, order.UserID,
+		).Scan(&used)
+		if err != nil {
+			return err
+		}
+
+		if used >= coupon.PerUserLimit {
+			return ErrCouponUnavailable
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO coupon_redemptions (coupon_code, user_id, order_id, discount, currency, redeemed_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		order.CouponCode, order.UserID, order.ID, order.Discount.Decimal(), order.TotalPrice.Currency, now,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE coupons SET times_redeemed = times_redeemed + 1 WHERE code = $1`, order.CouponCode)
+
+	return err
+}
+
+// releaseCoupon gives back the coupon use of a cancelled order inside the transaction that
+// cancels it, so the use counts again towards neither the usage limit nor the user's.
+func releaseCoupon(ctx context.Context, tx *sql.Tx, orderID int64) error {
+	query := `WITH released AS (
+	    DELETE FROM coupon_redemptions WHERE order_id = $1 RETURNING coupon_code
+	)
+	UPDATE coupons c SET times_redeemed = c.times_redeemed - r.uses
+	FROM (SELECT coupon_code, COUNT(*) AS uses FROM released GROUP BY coupon_code) r
+	WHERE c.code = r.coupon_code`
+
+	_, err := tx.ExecContext(ctx, query, orderID)
+	return err
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"shared/idempotency"
+	"time"
+)
+
+func (r *Repository) ClaimIdempotencyKey(ctx context.Context, userID int64, method, key, fingerprint string, now, expiredBefore time.Time) (*idempotency.Record, error) {
+	// An expired key is taken over as if it were new.
+	query := `INSERT INTO idempotency_keys (user_id, method, key, fingerprint, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id, method, key) DO UPDATE
+	SET fingerprint = EXCLUDED.fingerprint, response = NULL, created_at = EXCLUDED.created_at
+	WHERE idempotency_keys.created_at < $6
+	RETURNING key`
+
+	var claimed string
+	err := r.conn.QueryRowContext(ctx, query, userID, method, key, fingerprint, now, expiredBefore).Scan(&claimed)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	query = `SELECT fingerprint, response FROM idempotency_keys WHERE user_id = $1 AND method = $2 AND key = $3`
+
+	var record idempotency.Record
+	err = r.conn.QueryRowContext(ctx, query, userID, method, key).Scan(&record.Fingerprint, &record.Response)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The first request failed and released the key in the meantime.
+			return &idempotency.Record{Fingerprint: fingerprint}, nil
+		}
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (r *Repository) CompleteIdempotencyKey(ctx context.Context, userID int64, method, key string, response []byte) error {
+	query := `UPDATE idempotency_keys SET response = $1 WHERE user_id = $2 AND method = $3 AND key = $4`
+	_, err := r.conn.ExecContext(ctx, query, response, userID, method, key)
+	return err
+}
+
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, userID int64, method, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE user_id = $1 AND method = $2 AND key = $3 AND response IS NULL`
+	_, err := r.conn.ExecContext(ctx, query, userID, method, key)
+	return err
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order-service/internal/model"
+	"time"
+)
+
+var ErrInvoiceNotFound = errors.New("invoice not found")
+
+// IssueInvoice gives the order an invoice with the next number of the year and stores it
+// with store before committing, so a number is only used up once its PDF is saved. If the
+// order already has an invoice, that one is returned instead, with false, and store isn't
+// called.
+func (r *Repository) IssueInvoice(ctx context.Context, orderID int64, issuedAt time.Time, store func(*model.Invoice) error) (*model.Invoice, bool, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	// Locking the order makes concurrent confirmations of it issue a single invoice.
+	err = tx.QueryRowContext(ctx, `SELECT id FROM orders WHERE id = $1 FOR UPDATE`, orderID).Scan(&orderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, ErrOrderNotFound
+		}
+		return nil, false, err
+	}
+
+	existing, err := scanInvoice(tx.QueryRowContext(ctx, `SELECT id, order_id, number, issued_at, blob_key FROM invoices WHERE order_id = $1`, orderID))
+	if err == nil {
+		return existing, false, nil
+	}
+	if !errors.Is(err, ErrInvoiceNotFound) {
+		return nil, false, err
+	}
+
+	// The counter row stays locked until the transaction ends, which keeps the numbers of
+	// the year without gaps.
+	year := issuedAt.UTC().Year()
+	var number int64
+	err = tx.QueryRowContext(ctx, `INSERT INTO invoice_counters (year, last_number) VALUES ($1, 1)
+	ON CONFLICT (year) DO UPDATE SET last_number = invoice_counters.last_number + 1
+	RETURNING last_number`, year).Scan(&number)
+	if err != nil {
+		return nil, false, err
+	}
+
+	invoice := &model.Invoice{
+		OrderID:  orderID,
+		Number:   fmt.Sprintf("INV-%d-%06d", year, number),
+		IssuedAt: issuedAt,
+	}
+	invoice.BlobKey = fmt.Sprintf("invoices/%d/%s.pdf", year, invoice.Number)
+
+	err = tx.QueryRowContext(ctx, `INSERT INTO invoices (order_id, number, issued_at, blob_key) VALUES ($1, $2, $3, $4) RETURNING id`,
+		invoice.OrderID, invoice.Number, invoice.IssuedAt, invoice.BlobKey,
+	).Scan(&invoice.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err = store(invoice); err != nil {
+		return nil, false, err
+	}
+
+	return invoice, true, tx.Commit()
+}
+
+func (r *Repository) GetInvoiceByOrderID(ctx context.Context, orderID int64) (*model.Invoice, error) {
+	return scanInvoice(r.conn.QueryRowContext(ctx, `SELECT id, order_id, number, issued_at, blob_key FROM invoices WHERE order_id = $1`, orderID))
+}
+
+func scanInvoice(row *sql.Row) (*model.Invoice, error) {
+	var invoice model.Invoice
+	err := row.Scan(&invoice.ID, &invoice.OrderID, &invoice.Number, &invoice.IssuedAt, &invoice.BlobKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	return &invoice, nil
+}
@@ -3,10 +3,18 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"order-service/internal/model"
+	"shared/money"
 	"strings"
+	"time"
+)
+
+var (
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrOrderNotCancellable = errors.New("order can no longer be cancelled")
 )
 
 type Repository struct {
@@ -24,32 +32,75 @@ func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) (int64
 	}
 	defer tx.Rollback()
 
-	orderQuery := `INSERT INTO orders (user_id, status, total_price, shipping_address, created_at)
-     VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	address, err := encodeAddress(order.Address)
+	if err != nil {
+		return 0, err
+	}
+
+	orderQuery := `INSERT INTO orders (user_id, status, total_price, currency, shipping_address, created_at, coupon_code, discount,
+                    subtotal, shipping, tax, shipping_method, address, checkout_session_id, payment_method, subscription_id)
+     VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING id`
 
 	err = tx.QueryRowContext(ctx, orderQuery,
 		order.UserID,
 		order.Status,
-		order.TotalPrice,
+		order.TotalPrice.Decimal(),
+		order.TotalPrice.Currency,
 		order.ShippingAddress,
 		order.CreatedAt,
+		sql.NullString{String: order.CouponCode, Valid: order.CouponCode != ""},
+		order.Discount.Decimal(),
+		order.Subtotal.Decimal(),
+		order.Shipping.Decimal(),
+		order.Tax.Decimal(),
+		sql.NullString{String: order.ShippingMethod, Valid: order.ShippingMethod != ""},
+		sql.NullString{String: string(address), Valid: address != nil},
+		sql.NullString{String: order.CheckoutSessionID, Valid: order.CheckoutSessionID != ""},
+		sql.NullString{String: order.PaymentMethod, Valid: order.PaymentMethod != ""},
+		sql.NullInt64{Int64: order.SubscriptionID, Valid: order.SubscriptionID != 0},
 	).Scan(&order.ID)
 	if err != nil {
 		return 0, err
 	}
 
+	if err = recordStatusChange(ctx, tx, order.ID, order.Status, "", 0, order.CreatedAt); err != nil {
+		return 0, err
+	}
+
+	if order.CheckoutSessionID != "" {
+		if err = completeCheckoutSession(ctx, tx, order, order.CreatedAt); err != nil {
+			return 0, err
+		}
+	}
+
+	if order.CouponCode != "" {
+		if err = redeemCoupon(ctx, tx, order, order.CreatedAt); err != nil {
+			return 0, err
+		}
+	}
+
+	if order.SubscriptionID != 0 {
+		if err = attachSubscriptionOrder(ctx, tx, order, order.CreatedAt); err != nil {
+			return 0, err
+		}
+	}
+
 	if len(order.Items) > 0 {
 		valueStrings := make([]string, 0, len(order.Items))
-		valueArgs := make([]interface{}, 0, len(order.Items)*4)
+		valueArgs := make([]interface{}, 0, len(order.Items)*7)
 		i := 1
 		for _, item := range order.Items {
 			valueStrings = append(valueStrings,
-				fmt.Sprintf("($%d, $%d, $%d, $%d)", i, i+1, i+2, i+3))
-			valueArgs = append(valueArgs, order.ID, item.Quantity, item.Price, item.Sku)
-			i += 4
+				fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)", i, i+1, i+2, i+3, i+4, i+5, i+6))
+			valueArgs = append(valueArgs, order.ID, item.Quantity, item.Price.Decimal(), item.Sku,
+				sql.NullString{String: item.ProductID, Valid: item.ProductID != ""},
+				sql.NullString{String: item.Name, Valid: item.Name != ""},
+				sql.NullString{String: item.ImageURL, Valid: item.ImageURL != ""},
+			)
+			i += 7
 		}
 
-		itemsQuery := fmt.Sprintf("INSERT INTO order_items (order_id, quantity, price, sku) VALUES %s",
+		itemsQuery := fmt.Sprintf("INSERT INTO order_items (order_id, quantity, price, sku, product_id, name, image_url) VALUES %s",
 			strings.Join(valueStrings, ","),
 		)
 
@@ -66,141 +117,327 @@ func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) (int64
 	return order.ID, nil
 }
 
+// orderColumns are the columns scanOrders reads, selected from orders o left joined with
+// order_items oi.
+const orderColumns = `o.id, o.user_id, o.status, o.total_price, o.currency, o.shipping_address, o.created_at,
+       COALESCE(o.coupon_code, ''), o.discount, o.subtotal, o.shipping, o.tax, COALESCE(o.shipping_method, ''), o.address,
+       COALESCE(o.cancellation_reason, ''), COALESCE(o.payment_method, ''), COALESCE(o.subscription_id, 0),
+       COALESCE((SELECT json_agg(json_build_object('status', h.status, 'reason', COALESCE(h.reason, ''),
+                                                   'changed_by', COALESCE(h.changed_by, 0), 'changed_at', h.changed_at)
+                                 ORDER BY h.changed_at, h.id)
+                 FROM order_status_history h WHERE h.order_id = o.id), '[]'),
+       oi.id, oi.quantity, oi.price, oi.sku, COALESCE(oi.product_id, ''), COALESCE(oi.name, ''), COALESCE(oi.image_url, '')`
+
 func (r *Repository) GetOrderByID(ctx context.Context, orderID int64) (*model.Order, error) {
-	query := `SELECT o.id, o.user_id, o.status, o.total_price, o.shipping_address, o.created_at,
-       oi.id, oi.quantity, oi.price, oi.sku
+	query := `SELECT ` + orderColumns + `
  FROM orders o
  LEFT JOIN order_items oi ON o.id = oi.order_id
- WHERE o.id = $1`
+ WHERE o.id = $1
+ ORDER BY oi.id`
 
-	rows, err := r.conn.QueryContext(ctx, query, orderID)
+	orders, err := r.queryOrders(ctx, query, orderID)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var order *model.Order
-	for rows.Next() {
-		var itemID sql.NullInt64
-		var itemQuantity sql.NullInt64
-		var itemPrice sql.NullFloat64
-		var itemSku sql.NullString
-
-		if order == nil {
-			order = &model.Order{}
-			err = rows.Scan(
-				&order.ID, &order.UserID, &order.Status, &order.TotalPrice, &order.ShippingAddress, &order.CreatedAt,
-				&itemID, &itemQuantity, &itemPrice, &itemSku,
-			)
-		} else {
-			var tempOrderID, tempUserID int64
-			var tempStatus model.Status
-			var tempTotalPrice float64
-			var tempShippingAddress string
-			var tempCreatedAt sql.NullTime
-			err = rows.Scan(
-				&tempOrderID, &tempUserID, &tempStatus, &tempTotalPrice, &tempShippingAddress, &tempCreatedAt,
-				&itemID, &itemQuantity, &itemPrice, &itemSku,
-			)
-		}
+	if len(orders) == 0 {
+		return nil, ErrOrderNotFound
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	return orders[0], nil
+}
 
-		if itemID.Valid {
-			item := &model.OrderItem{
-				ID:       itemID.Int64,
-				OrderID:  orderID,
-				Quantity: itemQuantity.Int64,
-				Price:    itemPrice.Float64,
-				Sku:      itemSku.String,
-			}
-			order.Items = append(order.Items, item)
+// ListOrders returns up to limit orders matching the filter, newest first unless
+// oldestFirst, starting after the cursor if there is one. It also returns how many orders
+// match the filter across all pages.
+func (r *Repository) ListOrders(ctx context.Context, filter model.OrderFilter, oldestFirst bool, after *model.OrderCursor, limit int) ([]*model.Order, int64, error) {
+	var conditions []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.UserID != 0 {
+		conditions = append(conditions, "o.user_id = "+arg(filter.UserID))
+	}
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = arg(status)
 		}
+		conditions = append(conditions, fmt.Sprintf("o.status IN (%s)", strings.Join(placeholders, ", ")))
+	}
+	if !filter.CreatedAfter.IsZero() {
+		conditions = append(conditions, "o.created_at >= "+arg(filter.CreatedAfter))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		conditions = append(conditions, "o.created_at < "+arg(filter.CreatedBefore))
+	}
+	if filter.Sku != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM order_items f WHERE f.order_id = o.id AND f.sku = "+arg(filter.Sku)+")")
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
+	where := "TRUE"
+	if len(conditions) > 0 {
+		where = strings.Join(conditions, " AND ")
 	}
 
-	if order == nil {
-		return nil, errors.New("order not found")
+	var total int64
+	err := r.conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM orders o WHERE `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return order, nil
-}
+	direction, comparison := "DESC", "<"
+	if oldestFirst {
+		direction, comparison = "ASC", ">"
+	}
 
-func (r *Repository) GetUserOrders(ctx context.Context, userID int64) ([]*model.Order, error) {
-	query := `SELECT o.id, o.user_id, o.status, o.total_price, o.shipping_address, o.created_at,
-       oi.id, oi.quantity, oi.price, oi.sku
-	FROM orders o
-	LEFT JOIN order_items oi ON o.id = oi.order_id 
-	WHERE o.user_id = $1`
+	pageWhere := where
+	if after != nil {
+		pageWhere += fmt.Sprintf(" AND (o.created_at, o.id) %s (%s, %s)", comparison, arg(after.CreatedAt), arg(after.ID))
+	}
+
+	query := fmt.Sprintf(`WITH page AS (
+		SELECT o.id FROM orders o WHERE %[1]s ORDER BY o.created_at %[2]s, o.id %[2]s LIMIT %[3]s
+	)
+	SELECT `+orderColumns+`
+	FROM page
+	JOIN orders o ON o.id = page.id
+	LEFT JOIN order_items oi ON o.id = oi.order_id
+	ORDER BY o.created_at %[2]s, o.id %[2]s, oi.id`, pageWhere, direction, arg(limit))
+
+	orders, err := r.queryOrders(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
 
-	rows, err := r.conn.QueryContext(ctx, query, userID)
+// queryOrders runs a query selecting orderColumns and returns the orders with their items
+// in the order the query returns them. Rows of the same order must be adjacent.
+func (r *Repository) queryOrders(ctx context.Context, query string, args ...any) ([]*model.Order, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	ordersMap := make(map[int64]*model.Order)
+	var orders []*model.Order
+	var order *model.Order
 	for rows.Next() {
-		var orderID int64
+		var current model.Order
 		var item model.OrderItem
 		var itemID sql.NullInt64
 		var itemQuantity sql.NullInt64
-		var itemPrice sql.NullFloat64
-		var itemSku sql.NullString
-
-		var tempOrder model.Order
+		var itemPrice sql.NullString
+		var totalPrice, currency, discount, subtotal, shipping, tax string
+		var address, history []byte
 
 		err = rows.Scan(
-			&orderID, &tempOrder.UserID, &tempOrder.Status, &tempOrder.TotalPrice, &tempOrder.ShippingAddress, &tempOrder.CreatedAt,
-			&itemID, &itemQuantity, &itemPrice, &itemSku,
+			&current.ID, &current.UserID, &current.Status, &totalPrice, &currency, &current.ShippingAddress, &current.CreatedAt,
+			&current.CouponCode, &discount, &subtotal, &shipping, &tax, &current.ShippingMethod, &address,
+			&current.CancellationReason, &current.PaymentMethod, &current.SubscriptionID, &history,
+			&itemID, &itemQuantity, &itemPrice, &item.Sku, &item.ProductID, &item.Name, &item.ImageURL,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		order, exists := ordersMap[orderID]
-		if !exists {
-			order = &model.Order{
-				ID:              orderID,
-				UserID:          tempOrder.UserID,
-				Status:          tempOrder.Status,
-				TotalPrice:      tempOrder.TotalPrice,
-				ShippingAddress: tempOrder.ShippingAddress,
-				CreatedAt:       tempOrder.CreatedAt,
-				Items:           []*model.OrderItem{},
+		if order == nil || order.ID != current.ID {
+			err = parseAmounts(&current, currency, totalPrice, discount, subtotal, shipping, tax)
+			if err != nil {
+				return nil, err
+			}
+
+			if current.Address, err = decodeAddress(address); err != nil {
+				return nil, err
+			}
+			if err = json.Unmarshal(history, &current.StatusHistory); err != nil {
+				return nil, err
 			}
-			ordersMap[orderID] = order
+
+			current.Items = []*model.OrderItem{}
+			order = &current
+			orders = append(orders, order)
 		}
 
 		if itemID.Valid {
 			item.ID = itemID.Int64
-			item.OrderID = orderID
+			item.OrderID = order.ID
 			item.Quantity = itemQuantity.Int64
-			item.Price = itemPrice.Float64
-			item.Sku = itemSku.String
+			item.Price, err = money.Parse(itemPrice.String, order.TotalPrice.Currency)
+			if err != nil {
+				return nil, err
+			}
 			order.Items = append(order.Items, &item)
 		}
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
+	return orders, rows.Err()
+}
+
+// UpdateOrderStatus moves the order to a status, recording the change in its history unless
+// it already had that status. A cancelled order gives its coupon use back.
+func (r *Repository) UpdateOrderStatus(ctx context.Context, orderID int64, status model.Status, now time.Time) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE orders SET status = $1 WHERE id = $2 AND status <> $1`, status, orderID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil || affected == 0 {
+		return err
+	}
+
+	if err = recordStatusChange(ctx, tx, orderID, status, "", 0, now); err != nil {
+		return err
+	}
+
+	if status == model.Cancelled {
+		if err = releaseCoupon(ctx, tx, orderID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// recordStatusChange adds an entry to the order's status history. changedBy is the staff
+// member who made the change, or 0.
+func recordStatusChange(ctx context.Context, tx *sql.Tx, orderID int64, status model.Status, reason string, changedBy int64, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO order_status_history (order_id, status, reason, changed_by, changed_at) VALUES ($1, $2, $3, $4, $5)`,
+		orderID, status, sql.NullString{String: reason, Valid: reason != ""}, sql.NullInt64{Int64: changedBy, Valid: changedBy != 0}, now,
+	)
+	return err
+}
+
+// CancelOrder cancels the user's order if its status still allows it. The status is read
+// and changed in one transaction so a concurrent update can't be overwritten. The order is
+// left with its orders.cancelled event pending until MarkCancellationEventSent; an order
+// cancelled earlier whose event is still pending is accepted again so the event can be resent.
+func (r *Repository) CancelOrder(ctx context.Context, orderID, userID int64, reason string, now time.Time) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var (
+		current      model.Status
+		eventPending bool
+	)
+	err = tx.QueryRowContext(ctx, `SELECT status, cancellation_event_pending FROM orders WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		orderID, userID,
+	).Scan(&current, &eventPending)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	if current == model.Cancelled && eventPending {
+		return nil
 	}
 
-	orders := make([]*model.Order, 0, len(ordersMap))
-	for _, order := range ordersMap {
-		orders = append(orders, order)
+	if !current.Cancellable() {
+		return ErrOrderNotCancellable
 	}
 
-	return orders, nil
+	query := `UPDATE orders SET status = $1, cancellation_reason = $2, cancelled_at = $3, cancellation_event_pending = TRUE WHERE id = $4`
+	_, err = tx.ExecContext(ctx, query,
+		model.Cancelled,
+		sql.NullString{String: reason, Valid: reason != ""},
+		now,
+		orderID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = recordStatusChange(ctx, tx, orderID, model.Cancelled, reason, 0, now); err != nil {
+		return err
+	}
+
+	if err = releaseCoupon(ctx, tx, orderID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r *Repository) UpdateOrderStatus(ctx context.Context, orderID int64, status model.Status) error {
-	query := `UPDATE orders SET status = $1 WHERE id = $2`
-	_, err := r.conn.ExecContext(ctx, query, status, orderID)
+// MarkCancellationEventSent records that the orders.cancelled event of an order went out, so
+// cancelling it again is refused rather than sending the event twice.
+func (r *Repository) MarkCancellationEventSent(ctx context.Context, orderID int64) error {
+	_, err := r.conn.ExecContext(ctx, `UPDATE orders SET cancellation_event_pending = FALSE WHERE id = $1`, orderID)
+
 	return err
 }
+
+func (r *Repository) HasDeliveredOrderWithSKU(ctx context.Context, userID int64, sku string) (bool, error) {
+	query := `SELECT EXISTS (
+		SELECT 1 FROM orders o
+		JOIN order_items oi ON o.id = oi.order_id
+		WHERE o.user_id = $1 AND o.status = 'DELIVERED' AND oi.sku = $2
+	)`
+
+	var exists bool
+	err := r.conn.QueryRowContext(ctx, query, userID, sku).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// parseAmounts sets the order amounts from their NUMERIC column values.
+func parseAmounts(order *model.Order, currency, total, discount, subtotal, shipping, tax string) error {
+	for _, amount := range []struct {
+		value string
+		dst   *money.Money
+	}{
+		{total, &order.TotalPrice},
+		{discount, &order.Discount},
+		{subtotal, &order.Subtotal},
+		{shipping, &order.Shipping},
+		{tax, &order.Tax},
+	} {
+		parsed, err := money.Parse(amount.value, currency)
+		if err != nil {
+			return err
+		}
+		*amount.dst = parsed
+	}
+
+	return nil
+}
+
+func encodeAddress(address *model.Address) ([]byte, error) {
+	if address == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(address)
+}
+
+func decodeAddress(raw []byte) (*model.Address, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var address model.Address
+	if err := json.Unmarshal(raw, &address); err != nil {
+		return nil, err
+	}
+
+	return &address, nil
+}
@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"order-service/internal/model"
+	"shared/money"
+	"time"
+)
+
+var (
+	ErrReturnNotFound         = errors.New("return not found")
+	ErrOrderNotReturnable     = errors.New("order can't be returned")
+	ErrReturnItemNotInOrder   = errors.New("item is not part of the order")
+	ErrReturnQuantityExceeded = errors.New("return quantity exceeds what can still be returned")
+	ErrReturnStatusChanged    = errors.New("return is no longer in the expected status")
+)
+
+// CreateReturn stores a return request for the user's delivered order. The order is locked
+// while the quantities are checked, so concurrent requests can't return a line twice.
+// Items are priced at what the order paid for them.
+func (r *Repository) CreateReturn(ctx context.Context, ret *model.Return) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var orderStatus model.Status
+	var currency string
+	err = tx.QueryRowContext(ctx, `SELECT status, currency FROM orders WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		ret.OrderID, ret.UserID,
+	).Scan(&orderStatus, &currency)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	if orderStatus != model.Delivered {
+		return ErrOrderNotReturnable
+	}
+
+	returnable, prices, err := returnableQuantities(ctx, tx, ret.OrderID, currency)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range ret.Items {
+		price, ok := prices[item.Sku]
+		if !ok {
+			return ErrReturnItemNotInOrder
+		}
+		if item.Quantity > returnable[item.Sku] {
+			return ErrReturnQuantityExceeded
+		}
+		item.UnitPrice = price
+	}
+
+	query := `INSERT INTO returns (order_id, user_id, status, currency, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $5) RETURNING id`
+
+	err = tx.QueryRowContext(ctx, query, ret.OrderID, ret.UserID, ret.Status, currency, ret.CreatedAt).Scan(&ret.ID)
+	if err != nil {
+		return err
+	}
+	ret.UpdatedAt = ret.CreatedAt
+	ret.RefundAmount = money.New(0, currency)
+
+	for _, item := range ret.Items {
+		photos, err := json.Marshal(item.PhotoRefs)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx,
+			`INSERT INTO return_items (return_id, sku, quantity, unit_price, reason, photo_refs)
+			VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+			ret.ID, item.Sku, item.Quantity, item.UnitPrice.Decimal(), item.Reason, photos,
+		).Scan(&item.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// returnableQuantities returns, per SKU of the order, the quantity not yet claimed by a
+// return that wasn't rejected, and the unit price paid.
+func returnableQuantities(ctx context.Context, tx *sql.Tx, orderID int64, currency string) (map[string]int64, map[string]money.Money, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT sku, quantity, price FROM order_items WHERE order_id = $1`, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	returnable := make(map[string]int64)
+	prices := make(map[string]money.Money)
+	for rows.Next() {
+		var sku, price string
+		var quantity int64
+		if err = rows.Scan(&sku, &quantity, &price); err != nil {
+			return nil, nil, err
+		}
+
+		if prices[sku], err = money.Parse(price, currency); err != nil {
+			return nil, nil, err
+		}
+		returnable[sku] += quantity
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err = tx.QueryContext(ctx, `SELECT ri.sku, SUM(ri.quantity)
+	FROM return_items ri
+	JOIN returns rt ON rt.id = ri.return_id
+	WHERE rt.order_id = $1 AND rt.status <> 'REJECTED'
+	GROUP BY ri.sku`, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sku string
+		var quantity int64
+		if err = rows.Scan(&sku, &quantity); err != nil {
+			return nil, nil, err
+		}
+		returnable[sku] -= quantity
+	}
+
+	return returnable, prices, rows.Err()
+}
+
+func (r *Repository) GetReturn(ctx context.Context, id int64) (*model.Return, error) {
+	returns, err := r.queryReturns(ctx, `WHERE rt.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(returns) == 0 {
+		return nil, ErrReturnNotFound
+	}
+
+	return returns[0], nil
+}
+
+func (r *Repository) GetOrderReturns(ctx context.Context, orderID int64) ([]*model.Return, error) {
+	return r.queryReturns(ctx, `WHERE rt.order_id = $1`, orderID)
+}
+
+// RefundedAmount is the total refunded, or being refunded, by the order's inspected returns.
+func (r *Repository) RefundedAmount(ctx context.Context, orderID int64, currency string) (money.Money, error) {
+	var total string
+	err := r.conn.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(refund_amount), 0) FROM returns WHERE order_id = $1 AND status IN ('RECEIVED', 'REFUNDED')`,
+		orderID,
+	).Scan(&total)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return money.Parse(total, currency)
+}
+
+// UpdateReturnStatus moves a return from one status to another, failing if it was moved by
+// someone else first.
+func (r *Repository) UpdateReturnStatus(ctx context.Context, id int64, from, to model.ReturnStatus, note string, now time.Time) error {
+	query := `UPDATE returns SET status = $1, staff_note = COALESCE($2, staff_note), updated_at = $3
+	WHERE id = $4 AND status = $5`
+
+	res, err := r.conn.ExecContext(ctx, query, to, sql.NullString{String: note, Valid: note != ""}, now, id, from)
+	if err != nil {
+		return err
+	}
+
+	return expectReturnUpdated(res)
+}
+
+// ReceiveReturn records the inspection of an approved return and the amount to refund.
+func (r *Repository) ReceiveReturn(ctx context.Context, ret *model.Return, now time.Time) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE returns SET status = $1, refund_amount = $2, staff_note = COALESCE($3, staff_note), updated_at = $4
+	WHERE id = $5 AND status = $6`
+
+	res, err := tx.ExecContext(ctx, query,
+		model.ReturnReceived,
+		ret.RefundAmount.Decimal(),
+		sql.NullString{String: ret.StaffNote, Valid: ret.StaffNote != ""},
+		now,
+		ret.ID,
+		model.ReturnApproved,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = expectReturnUpdated(res); err != nil {
+		return err
+	}
+
+	for _, item := range ret.Items {
+		_, err = tx.ExecContext(ctx, `UPDATE return_items SET accepted_quantity = $1, restock = $2 WHERE id = $3`,
+			item.AcceptedQuantity, item.Restock, item.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) queryReturns(ctx context.Context, where string, args ...any) ([]*model.Return, error) {
+	query := `SELECT rt.id, rt.order_id, rt.user_id, rt.status, COALESCE(rt.staff_note, ''),
+       COALESCE(rt.refund_amount, 0), rt.currency, rt.created_at, rt.updated_at,
+       ri.id, ri.sku, ri.quantity, ri.unit_price, ri.reason, ri.photo_refs, ri.accepted_quantity, ri.restock
+	FROM returns rt
+	JOIN return_items ri ON ri.return_id = rt.id
+	` + where + `
+	ORDER BY rt.created_at, rt.id, ri.id`
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var returns []*model.Return
+	var current *model.Return
+	for rows.Next() {
+		var ret model.Return
+		var item model.ReturnItem
+		var refund, currency, unitPrice string
+		var photos []byte
+		var accepted sql.NullInt64
+		err = rows.Scan(
+			&ret.ID, &ret.OrderID, &ret.UserID, &ret.Status, &ret.StaffNote,
+			&refund, &currency, &ret.CreatedAt, &ret.UpdatedAt,
+			&item.ID, &item.Sku, &item.Quantity, &unitPrice, &item.Reason, &photos, &accepted, &item.Restock,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if current == nil || current.ID != ret.ID {
+			if ret.RefundAmount, err = money.Parse(refund, currency); err != nil {
+				return nil, err
+			}
+			current = &ret
+			returns = append(returns, current)
+		}
+
+		if item.UnitPrice, err = money.Parse(unitPrice, currency); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(photos, &item.PhotoRefs); err != nil {
+			return nil, err
+		}
+		if accepted.Valid {
+			item.AcceptedQuantity = &accepted.Int64
+		}
+
+		current.Items = append(current.Items, &item)
+	}
+
+	return returns, rows.Err()
+}
+
+func expectReturnUpdated(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrReturnStatusChanged
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"order-service/internal/model"
+	"time"
+)
+
+var (
+	ErrShipmentNotFound         = errors.New("shipment not found")
+	ErrOrderNotShippable        = errors.New("order can't be shipped")
+	ErrShipmentItemNotInOrder   = errors.New("item is not part of the order")
+	ErrShipmentQuantityExceeded = errors.New("shipment quantity exceeds what is left to ship")
+)
+
+// CreateShipment stores a shipment booked for lines of the order and moves the order to the
+// status its shipments now give it, which it returns. The order is locked while the
+// quantities are checked, so concurrent shipments can't send a line twice.
+func (r *Repository) CreateShipment(ctx context.Context, shipment *model.Shipment) (model.Status, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var orderStatus model.Status
+	err = tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = $1 FOR UPDATE`, shipment.OrderID).Scan(&orderStatus)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrOrderNotFound
+		}
+		return "", err
+	}
+
+	if !orderStatus.Shippable() {
+		return "", ErrOrderNotShippable
+	}
+
+	unshipped, err := unshippedQuantities(ctx, tx, shipment.OrderID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, item := range shipment.Items {
+		left, ok := unshipped[item.Sku]
+		if !ok {
+			return "", ErrShipmentItemNotInOrder
+		}
+		if item.Quantity > left {
+			return "", ErrShipmentQuantityExceeded
+		}
+	}
+
+	query := `INSERT INTO shipments (order_id, carrier, tracking_number, status, estimated_delivery, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $6) RETURNING id`
+
+	err = tx.QueryRowContext(ctx, query,
+		shipment.OrderID,
+		shipment.Carrier,
+		shipment.TrackingNumber,
+		shipment.Status,
+		shipment.EstimatedDelivery,
+		shipment.CreatedAt,
+	).Scan(&shipment.ID)
+	if err != nil {
+		return "", err
+	}
+	shipment.UpdatedAt = shipment.CreatedAt
+
+	for _, item := range shipment.Items {
+		_, err = tx.ExecContext(ctx, `INSERT INTO shipment_items (shipment_id, sku, quantity) VALUES ($1, $2, $3)`,
+			shipment.ID, item.Sku, item.Quantity,
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	orderStatus, err = updateShippingStatus(ctx, tx, shipment.OrderID, shipment.CreatedAt)
+	if err != nil {
+		return "", err
+	}
+
+	return orderStatus, tx.Commit()
+}
+
+// unshippedQuantities returns, per SKU of the order, the quantity no shipment covers yet.
+func unshippedQuantities(ctx context.Context, tx *sql.Tx, orderID int64) (map[string]int64, error) {
+	query := `SELECT oi.sku, oi.quantity - COALESCE((
+		SELECT SUM(si.quantity)
+		FROM shipment_items si
+		JOIN shipments s ON s.id = si.shipment_id
+		WHERE s.order_id = oi.order_id AND si.sku = oi.sku
+	), 0)
+	FROM (SELECT order_id, sku, SUM(quantity) AS quantity FROM order_items WHERE order_id = $1 GROUP BY order_id, sku) oi`
+
+	rows, err := tx.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	unshipped := make(map[string]int64)
+	for rows.Next() {
+		var sku string
+		var quantity int64
+		if err = rows.Scan(&sku, &quantity); err != nil {
+			return nil, err
+		}
+		unshipped[sku] = quantity
+	}
+
+	return unshipped, rows.Err()
+}
+
+// updateShippingStatus moves the order to the status its shipments put it in, as decided by
+// Status.AfterShipping.
+func updateShippingStatus(ctx context.Context, tx *sql.Tx, orderID int64, now time.Time) (model.Status, error) {
+	var current model.Status
+	var ordered, shipped, undelivered int64
+	err := tx.QueryRowContext(ctx, `SELECT o.status,
+		(SELECT COALESCE(SUM(quantity), 0) FROM order_items WHERE order_id = o.id),
+		(SELECT COALESCE(SUM(si.quantity), 0) FROM shipment_items si JOIN shipments s ON s.id = si.shipment_id WHERE s.order_id = o.id),
+		(SELECT COUNT(*) FROM shipments WHERE order_id = o.id AND delivered_at IS NULL)
+	FROM orders o WHERE o.id = $1`, orderID).Scan(&current, &ordered, &shipped, &undelivered)
+	if err != nil {
+		return "", err
+	}
+
+	status := current.AfterShipping(ordered, shipped, undelivered)
+	if status != current {
+		_, err = tx.ExecContext(ctx, `UPDATE orders SET status = $1 WHERE id = $2`, status, orderID)
+		if err != nil {
+			return "", err
+		}
+
+		if err = recordStatusChange(ctx, tx, orderID, status, "", 0, now); err != nil {
+			return "", err
+		}
+	}
+
+	return status, nil
+}
+
+// RecordTracking stores the carrier's tracking events for a shipment and moves it to the
+// status of the latest one. It reports whether this delivered the shipment, and the order
+// status that follows.
+func (r *Repository) RecordTracking(ctx context.Context, shipmentID int64, events []*model.TrackingEvent, now time.Time) (bool, model.Status, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return false, "", err
+	}
+	defer tx.Rollback()
+
+	var orderID int64
+	var current model.ShipmentStatus
+	var orderStatus model.Status
+	err = tx.QueryRowContext(ctx, `SELECT s.order_id, s.status, o.status
+	FROM shipments s
+	JOIN orders o ON o.id = s.order_id
+	WHERE s.id = $1 AND s.delivered_at IS NULL
+	FOR UPDATE OF s`, shipmentID).Scan(&orderID, &current, &orderStatus)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Delivered meanwhile.
+			return false, "", nil
+		}
+		return false, "", err
+	}
+
+	if err = insertTrackingEvents(ctx, tx, shipmentID, events); err != nil {
+		return false, "", err
+	}
+
+	latest := current
+	err = tx.QueryRowContext(ctx, `SELECT status FROM shipment_events WHERE shipment_id = $1 ORDER BY occurred_at DESC, id DESC LIMIT 1`,
+		shipmentID,
+	).Scan(&latest)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, "", err
+	}
+
+	delivered := latest == model.ShipmentDelivered
+	query := `UPDATE shipments SET status = $1,
+		updated_at = CASE WHEN status <> $1 THEN $2 ELSE updated_at END,
+		delivered_at = CASE WHEN $3 THEN $2 END,
+		last_tracked_at = $2
+	WHERE id = $4`
+	if _, err = tx.ExecContext(ctx, query, latest, now, delivered, shipmentID); err != nil {
+		return false, "", err
+	}
+
+	if delivered {
+		if orderStatus, err = updateShippingStatus(ctx, tx, orderID, now); err != nil {
+			return false, "", err
+		}
+	}
+
+	return delivered, orderStatus, tx.Commit()
+}
+
+// MarkTracked records that the shipment was tracked at now without the carrier reporting
+// anything usable, so the sync moves on to other shipments.
+func (r *Repository) MarkTracked(ctx context.Context, shipmentID int64, now time.Time) error {
+	_, err := r.conn.ExecContext(ctx, `UPDATE shipments SET last_tracked_at = $1 WHERE id = $2`, now, shipmentID)
+	return err
+}
+
+// insertTrackingEvents stores the events not recorded for the shipment yet.
+func insertTrackingEvents(ctx context.Context, tx *sql.Tx, shipmentID int64, events []*model.TrackingEvent) error {
+	for _, event := range events {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO shipment_events (shipment_id, status, description, location, occurred_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (shipment_id, status, occurred_at) DO NOTHING`,
+			shipmentID, event.Status, event.Description, event.Location, event.OccurredAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) GetShipment(ctx context.Context, id int64) (*model.Shipment, error) {
+	shipments, err := r.queryShipments(ctx, `WHERE s.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shipments) == 0 {
+		return nil, ErrShipmentNotFound
+	}
+
+	return shipments[0], nil
+}
+
+func (r *Repository) GetOrderShipments(ctx context.Context, orderID int64) ([]*model.Shipment, error) {
+	return r.queryShipments(ctx, `WHERE s.order_id = $1 ORDER BY s.created_at, s.id`, orderID)
+}
+
+// GetShipmentsToTrack returns up to limit undelivered shipments last tracked before the
+// given time, least recently tracked first.
+func (r *Repository) GetShipmentsToTrack(ctx context.Context, trackedBefore time.Time, limit int) ([]*model.Shipment, error) {
+	return r.queryShipments(ctx,
+		`WHERE s.delivered_at IS NULL AND (s.last_tracked_at IS NULL OR s.last_tracked_at < $1)
+		ORDER BY s.last_tracked_at NULLS FIRST, s.id
+		LIMIT $2`,
+		trackedBefore, limit,
+	)
+}
+
+// queryShipments selects shipments with their items and tracking events, filtered, ordered
+// and limited by the clause appended to the query.
+func (r *Repository) queryShipments(ctx context.Context, clause string, args ...any) ([]*model.Shipment, error) {
+	query := `SELECT s.id, s.order_id, s.carrier, s.tracking_number, s.status, s.estimated_delivery,
+       s.created_at, s.updated_at, s.delivered_at,
+       COALESCE((SELECT json_agg(json_build_object('sku', si.sku, 'quantity', si.quantity) ORDER BY si.id)
+                 FROM shipment_items si WHERE si.shipment_id = s.id), '[]'),
+       COALESCE((SELECT json_agg(json_build_object('status', se.status, 'description', se.description,
+                                                   'location', se.location, 'occurred_at', se.occurred_at)
+                                 ORDER BY se.occurred_at, se.id)
+                 FROM shipment_events se WHERE se.shipment_id = s.id), '[]')
+	FROM shipments s
+	` + clause
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shipments []*model.Shipment
+	for rows.Next() {
+		var shipment model.Shipment
+		var deliveredAt sql.NullTime
+		var items, events []byte
+		err = rows.Scan(
+			&shipment.ID, &shipment.OrderID, &shipment.Carrier, &shipment.TrackingNumber, &shipment.Status,
+			&shipment.EstimatedDelivery, &shipment.CreatedAt, &shipment.UpdatedAt, &deliveredAt,
+			&items, &events,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if deliveredAt.Valid {
+			shipment.DeliveredAt = &deliveredAt.Time
+		}
+		if err = json.Unmarshal(items, &shipment.Items); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(events, &shipment.Events); err != nil {
+			return nil, err
+		}
+
+		shipments = append(shipments, &shipment)
+	}
+
+	return shipments, rows.Err()
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"order-service/internal/model"
+	"time"
+)
+
+var (
+	ErrSubscriptionNotFound    = errors.New("subscription not found")
+	ErrSubscriptionUnavailable = errors.New("subscription is no longer active or already has a pending order")
+)
+
+const subscriptionColumns = `id, user_id, status, items, interval_days, address, shipping_method, payment_method,
+       COALESCE(stored_payment_method_id, ''), next_order_at, retry_at, failed_attempts, COALESCE(last_failure, ''),
+       pending_order_id, created_at, updated_at, cancelled_at`
+
+func (r *Repository) CreateSubscription(ctx context.Context, sub *model.Subscription) error {
+	items, err := json.Marshal(sub.Items)
+	if err != nil {
+		return err
+	}
+
+	address, err := json.Marshal(sub.Address)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO subscriptions (user_id, status, items, interval_days, address, shipping_method, payment_method,
+                           stored_payment_method_id, next_order_at, created_at, updated_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10) RETURNING id`
+
+	err = r.conn.QueryRowContext(ctx, query,
+		sub.UserID,
+		sub.Status,
+		items,
+		sub.IntervalDays,
+		address,
+		sub.ShippingMethod,
+		sub.PaymentMethod,
+		sql.NullString{String: sub.StoredPaymentMethodID, Valid: sub.StoredPaymentMethodID != ""},
+		sub.NextOrderAt,
+		sub.CreatedAt,
+	).Scan(&sub.ID)
+	if err != nil {
+		return err
+	}
+	sub.UpdatedAt = sub.CreatedAt
+
+	return nil
+}
+
+func (r *Repository) GetSubscription(ctx context.Context, id int64) (*model.Subscription, error) {
+	rows, err := r.conn.QueryContext(ctx, `SELECT `+subscriptionColumns+` FROM subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subs) == 0 {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return subs[0], nil
+}
+
+// GetUserSubscriptions returns the user's subscriptions, oldest first.
+func (r *Repository) GetUserSubscriptions(ctx context.Context, userID int64) ([]*model.Subscription, error) {
+	rows, err := r.conn.QueryContext(ctx,
+		`SELECT `+subscriptionColumns+` FROM subscriptions WHERE user_id = $1 ORDER BY created_at, id`, userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanSubscriptions(rows)
+}
+
+// UpdateSubscription saves the changes update makes to the subscription. The subscription
+// is locked in between, so concurrent changes are applied one after the other; nothing is
+// saved if update fails.
+func (r *Repository) UpdateSubscription(ctx context.Context, id int64, now time.Time, update func(*model.Subscription) error) (*model.Subscription, error) {
+	return r.updateSubscription(ctx, `id = $1`, id, now, update)
+}
+
+// UpdatePendingSubscription is UpdateSubscription for the subscription waiting on the order.
+// It fails with ErrSubscriptionNotFound if none is, e.g. for an order placed at checkout.
+func (r *Repository) UpdatePendingSubscription(ctx context.Context, orderID int64, now time.Time, update func(*model.Subscription) error) (*model.Subscription, error) {
+	return r.updateSubscription(ctx, `pending_order_id = $1`, orderID, now, update)
+}
+
+func (r *Repository) updateSubscription(ctx context.Context, where string, arg any, now time.Time, update func(*model.Subscription) error) (*model.Subscription, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT `+subscriptionColumns+` FROM subscriptions WHERE `+where+` FOR UPDATE`, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subs) == 0 {
+		return nil, ErrSubscriptionNotFound
+	}
+	sub := subs[0]
+
+	if err = update(sub); err != nil {
+		return nil, err
+	}
+	sub.UpdatedAt = now
+
+	query := `UPDATE subscriptions SET status = $1, next_order_at = $2, retry_at = $3, failed_attempts = $4,
+                         last_failure = $5, pending_order_id = $6, updated_at = $7, cancelled_at = $8
+	WHERE id = $9`
+
+	var pendingOrderID sql.NullInt64
+	if sub.PendingOrderID != nil {
+		pendingOrderID = sql.NullInt64{Int64: *sub.PendingOrderID, Valid: true}
+	}
+
+	_, err = tx.ExecContext(ctx, query,
+		sub.Status,
+		sub.NextOrderAt,
+		sub.RetryAt,
+		sub.FailedAttempts,
+		sql.NullString{String: sub.LastFailure, Valid: sub.LastFailure != ""},
+		pendingOrderID,
+		sub.UpdatedAt,
+		sub.CancelledAt,
+		sub.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sub, tx.Commit()
+}
+
+// ClaimDueSubscriptions returns up to limit active subscriptions whose order, or its retry,
+// is due at now, and claims them until claimedUntil. Claimed subscriptions aren't returned
+// again before then, so an order is placed by a single scheduler; a claim ends early only
+// when the order is placed.
+func (r *Repository) ClaimDueSubscriptions(ctx context.Context, now, claimedUntil time.Time, limit int) ([]*model.Subscription, error) {
+	query := `UPDATE subscriptions SET claimed_until = $2
+	WHERE id IN (
+	    SELECT id FROM subscriptions
+	    WHERE status = 'ACTIVE' AND pending_order_id IS NULL AND COALESCE(retry_at, next_order_at) <= $1
+	      AND (claimed_until IS NULL OR claimed_until <= $1)
+	    ORDER BY COALESCE(retry_at, next_order_at), id
+	    LIMIT $3
+	    FOR UPDATE SKIP LOCKED
+	)
+	RETURNING ` + subscriptionColumns
+
+	rows, err := r.conn.QueryContext(ctx, query, now, claimedUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanSubscriptions(rows)
+}
+
+// attachSubscriptionOrder makes the order the pending order of the subscription that placed
+// it and ends the scheduler's claim. It runs inside the order's transaction, so an order
+// isn't placed for a subscription paused or cancelled in the meantime.
+func attachSubscriptionOrder(ctx context.Context, tx *sql.Tx, order *model.Order, now time.Time) error {
+	query := `UPDATE subscriptions SET pending_order_id = $1, claimed_until = NULL, updated_at = $2
+	WHERE id = $3 AND user_id = $4 AND status = 'ACTIVE' AND pending_order_id IS NULL`
+
+	res, err := tx.ExecContext(ctx, query, order.ID, now, order.SubscriptionID, order.UserID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrSubscriptionUnavailable
+	}
+
+	return nil
+}
+
+func scanSubscriptions(rows *sql.Rows) ([]*model.Subscription, error) {
+	defer rows.Close()
+
+	var subs []*model.Subscription
+	for rows.Next() {
+		var sub model.Subscription
+		var items, address []byte
+		var retryAt, cancelledAt sql.NullTime
+		var pendingOrderID sql.NullInt64
+		err := rows.Scan(
+			&sub.ID, &sub.UserID, &sub.Status, &items, &sub.IntervalDays, &address, &sub.ShippingMethod,
+			&sub.PaymentMethod, &sub.StoredPaymentMethodID, &sub.NextOrderAt, &retryAt, &sub.FailedAttempts,
+			&sub.LastFailure, &pendingOrderID, &sub.CreatedAt, &sub.UpdatedAt, &cancelledAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(items, &sub.Items); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(address, &sub.Address); err != nil {
+			return nil, err
+		}
+
+		if retryAt.Valid {
+			sub.RetryAt = &retryAt.Time
+		}
+		if pendingOrderID.Valid {
+			sub.PendingOrderID = &pendingOrderID.Int64
+		}
+		if cancelledAt.Valid {
+			sub.CancelledAt = &cancelledAt.Time
+		}
+
+		subs = append(subs, &sub)
+	}
+
+	return subs, rows.Err()
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"order-service/internal/model"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+)
+
+// AdminServer serves AdminOrderService. The auth interceptor only lets staff call it.
+type AdminServer struct {
+	pb.UnimplementedAdminOrderServiceServer
+	Service *service.Service
+}
+
+func NewAdminServer(s *service.Service) *AdminServer {
+	return &AdminServer{
+		Service: s,
+	}
+}
+
+// orderSearch is what SearchOrdersRequest and ExportOrdersRequest filter on.
+type orderSearch interface {
+	GetUserId() int64
+	GetStatuses() []pb.Status
+	GetCreatedAfter() *timestamppb.Timestamp
+	GetCreatedBefore() *timestamppb.Timestamp
+	GetSku() string
+}
+
+func (s *AdminServer) SearchOrders(ctx context.Context, r *pb.SearchOrdersRequest) (*pb.ListOrdersResponse, error) {
+	filter, err := searchFilter(r)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.Service.SearchOrders(ctx, filter, r.GetEmail(), r.GetSort() == pb.OrderSort_OLDEST_FIRST, r.GetPageSize(), r.GetPageToken())
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	ordersResponse := make([]*pb.Order, len(page.Orders))
+	for i, order := range page.Orders {
+		ordersResponse[i] = adminOrderToPB(order)
+	}
+
+	return &pb.ListOrdersResponse{
+		Orders:        ordersResponse,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *AdminServer) ExportOrders(ctx context.Context, r *pb.ExportOrdersRequest) (*httpbody.HttpBody, error) {
+	filter, err := searchFilter(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = s.Service.ExportOrders(ctx, filter, r.GetEmail(), r.GetSort() == pb.OrderSort_OLDEST_FIRST, &buf)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/csv",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+func (s *AdminServer) GetOrder(ctx context.Context, r *pb.AdminGetOrderRequest) (*pb.AdminOrder, error) {
+	order, err := s.Service.GetAdminOrder(ctx, r.GetId())
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return adminOrderDetailsToPB(order), nil
+}
+
+func (s *AdminServer) OverrideOrderStatus(ctx context.Context, r *pb.OverrideOrderStatusRequest) (*pb.AdminOrder, error) {
+	staffID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	name, ok := pb.Status_name[int32(r.GetStatus())]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown order status")
+	}
+
+	order, err := s.Service.OverrideOrderStatus(ctx, int64(staffID), r.GetId(), model.Status(name), r.GetReason())
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return adminOrderDetailsToPB(order), nil
+}
+
+func (s *AdminServer) AddOrderNote(ctx context.Context, r *pb.AddOrderNoteRequest) (*pb.OrderNote, error) {
+	staffID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	note, err := s.Service.AddOrderNote(ctx, int64(staffID), r.GetId(), r.GetBody())
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return noteToPB(note), nil
+}
+
+func searchFilter(r orderSearch) (model.OrderFilter, error) {
+	filter := model.OrderFilter{
+		UserID: r.GetUserId(),
+		Sku:    r.GetSku(),
+	}
+	for _, st := range r.GetStatuses() {
+		name, ok := pb.Status_name[int32(st)]
+		if !ok {
+			return model.OrderFilter{}, status.Error(codes.InvalidArgument, "unknown order status")
+		}
+		filter.Statuses = append(filter.Statuses, model.Status(name))
+	}
+	if r.GetCreatedAfter() != nil {
+		filter.CreatedAfter = r.GetCreatedAfter().AsTime()
+	}
+	if r.GetCreatedBefore() != nil {
+		filter.CreatedBefore = r.GetCreatedBefore().AsTime()
+	}
+
+	return filter, nil
+}
+
+func adminError(err error) error {
+	log.Println(err)
+	switch {
+	case errors.Is(err, service.ErrInvalidOrderQuery),
+		errors.Is(err, service.ErrInvalidStatusOverride),
+		errors.Is(err, service.ErrInvalidNote):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrStatusUnchanged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "error processing order")
+}
+
+// adminOrderToPB is orderToPB with who overrode the status, which customers don't see.
+func adminOrderToPB(order *model.Order) *pb.Order {
+	resp := orderToPB(order)
+	for i, change := range order.StatusHistory {
+		resp.StatusHistory[i].ChangedBy = change.ChangedBy
+	}
+
+	return resp
+}
+
+func adminOrderDetailsToPB(order *service.AdminOrder) *pb.AdminOrder {
+	notes := make([]*pb.OrderNote, len(order.Notes))
+	for i, note := range order.Notes {
+		notes[i] = noteToPB(note)
+	}
+
+	return &pb.AdminOrder{
+		Order: adminOrderToPB(order.Order),
+		Notes: notes,
+	}
+}
+
+func noteToPB(note *model.OrderNote) *pb.OrderNote {
+	return &pb.OrderNote{
+		Id:        note.ID,
+		OrderId:   note.OrderID,
+		AuthorId:  note.AuthorID,
+		Body:      note.Body,
+		CreatedAt: timestamppb.New(note.CreatedAt),
+	}
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"order-service/internal/checkout"
+	"order-service/internal/model"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+)
+
+func (s *Server) GetCheckoutQuote(ctx context.Context, r *pb.GetCheckoutQuoteRequest) (*pb.CheckoutQuote, error) {
+	quote, err := s.Service.Quote(ctx, addressFromPB(r.GetAddress()), r.GetShippingMethod())
+	if err != nil {
+		if st := checkoutError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	options := make([]*pb.ShippingOption, len(quote.Options))
+	for i, option := range quote.Options {
+		options[i] = &pb.ShippingOption{
+			Method:  option.Method,
+			Name:    option.Name,
+			Amount:  moneyToPB(option.Amount),
+			MinDays: option.MinDays,
+			MaxDays: option.MaxDays,
+		}
+	}
+
+	return &pb.CheckoutQuote{
+		Subtotal:        moneyToPB(quote.Subtotal),
+		Discount:        moneyToPB(quote.Discount),
+		ShippingOptions: options,
+		ShippingMethod:  quote.Method.Method,
+		Shipping:        moneyToPB(quote.Shipping),
+		Tax:             moneyToPB(quote.Tax),
+		GrandTotal:      moneyToPB(quote.Total),
+		FreeShipping:    quote.FreeShipping,
+	}, nil
+}
+
+func (s *Server) CreateCheckoutSession(ctx context.Context, r *pb.CreateCheckoutSessionRequest) (*pb.CheckoutSession, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	session, err := s.Service.CreateCheckoutSession(ctx, int64(userID), addressFromPB(r.GetAddress()), r.GetShippingMethod())
+	if err != nil {
+		if st := checkoutError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return checkoutSessionToPB(session), nil
+}
+
+func (s *Server) GetCheckoutSession(ctx context.Context, r *pb.GetCheckoutSessionRequest) (*pb.CheckoutSession, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	session, err := s.Service.GetCheckoutSession(ctx, int64(userID), r.GetId())
+	if err != nil {
+		if st := checkoutError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return checkoutSessionToPB(session), nil
+}
+
+// checkoutError maps the errors of pricing a checkout that the caller can act on.
+func checkoutError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrCheckoutSessionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrCheckoutSessionClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrInvalidAddress):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, checkout.ErrNoShippingMethods), errors.Is(err, checkout.ErrShippingMethodUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrEmptyCart):
+		return status.Error(codes.FailedPrecondition, "cart is empty")
+	case errors.Is(err, service.ErrStaleCart):
+		return status.Error(codes.FailedPrecondition, "cart has changed, validate it and review the changes")
+	case errors.Is(err, service.ErrGetCart):
+		return status.Error(codes.Internal, "failed to get cart")
+	case errors.Is(err, service.ErrMissingUserID):
+		return status.Error(codes.FailedPrecondition, "missing user id in context")
+	case errors.Is(err, service.ErrMissingMetadata):
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return nil
+}
+
+func checkoutSessionToPB(session *model.CheckoutSession) *pb.CheckoutSession {
+	lines := make([]*pb.CheckoutLine, len(session.Lines))
+	for i, line := range session.Lines {
+		lines[i] = &pb.CheckoutLine{
+			Sku:       line.Sku,
+			Name:      line.Name,
+			ImageUrl:  line.ImageURL,
+			Quantity:  line.Quantity,
+			Price:     moneyToPB(line.Price),
+			LineTotal: moneyToPB(line.Price.Mul(int64(line.Quantity))),
+		}
+	}
+
+	var orderID int64
+	if session.OrderID != nil {
+		orderID = *session.OrderID
+	}
+
+	return &pb.CheckoutSession{
+		Id:      session.ID,
+		Status:  pb.CheckoutSessionStatus(pb.CheckoutSessionStatus_value["SESSION_"+string(session.Status)]),
+		Lines:   lines,
+		Address: addressToPB(&session.Address),
+		ShippingOption: &pb.ShippingOption{
+			Method:  session.ShippingMethod,
+			Name:    session.ShippingMethodName,
+			Amount:  moneyToPB(session.Shipping),
+			MaxDays: session.DeliveryDays,
+		},
+		CouponCode: session.CouponCode,
+		Subtotal:   moneyToPB(session.Subtotal),
+		Discount:   moneyToPB(session.Discount),
+		Shipping:   moneyToPB(session.Shipping),
+		Tax:        moneyToPB(session.Tax),
+		GrandTotal: moneyToPB(session.Total),
+		ExpiresAt:  timestamppb.New(session.ExpiresAt),
+		OrderId:    orderID,
+	}
+}
+
+func addressFromPB(address *pb.Address) model.Address {
+	return model.Address{
+		FullName:   address.GetFullName(),
+		Line1:      address.GetLine1(),
+		Line2:      address.GetLine2(),
+		City:       address.GetCity(),
+		Region:     address.GetRegion(),
+		PostalCode: address.GetPostalCode(),
+		Country:    address.GetCountry(),
+	}
+}
+
+func addressToPB(address *model.Address) *pb.Address {
+	if address == nil {
+		return nil
+	}
+
+	return &pb.Address{
+		FullName:   address.FullName,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"order-service/internal/model"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+	"shared/money"
+	"time"
+)
+
+func (s *Server) CreateCoupon(ctx context.Context, r *pb.CreateCouponRequest) (*pb.Coupon, error) {
+	coupon := couponFromPB(r.GetCoupon())
+
+	err := s.Service.CreateCoupon(ctx, coupon)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidCoupon):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrCouponExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "failed to create coupon")
+	}
+
+	return couponToPB(coupon), nil
+}
+
+// GetCoupon may be called without a user, for guest carts; redemptions are then reported
+// as zero.
+func (s *Server) GetCoupon(ctx context.Context, r *pb.GetCouponRequest) (*pb.GetCouponResponse, error) {
+	var userID int64
+	if id, ok := ctx.Value("user-id").(int); ok {
+		userID = int64(id)
+	}
+
+	coupon, redemptions, err := s.Service.GetCoupon(ctx, r.GetCode(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrCouponNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "failed to get coupon")
+	}
+
+	return &pb.GetCouponResponse{
+		Coupon:          couponToPB(coupon),
+		UserRedemptions: redemptions,
+	}, nil
+}
+
+func couponFromPB(coupon *pb.Coupon) *model.Coupon {
+	result := &model.Coupon{
+		Code:         coupon.GetCode(),
+		Type:         model.CouponType(coupon.GetType().String()),
+		PercentOff:   coupon.GetPercentOff(),
+		AmountOff:    money.New(coupon.GetAmountOff().GetAmount(), coupon.GetAmountOff().GetCurrency()),
+		MinBasket:    money.New(coupon.GetMinBasket().GetAmount(), coupon.GetMinBasket().GetCurrency()),
+		BuySku:       coupon.GetBuySku(),
+		BuyQuantity:  coupon.GetBuyQuantity(),
+		GetQuantity:  coupon.GetGetQuantity(),
+		UsageLimit:   coupon.GetUsageLimit(),
+		PerUserLimit: coupon.GetPerUserLimit(),
+	}
+
+	if coupon.GetStartsAt() != nil {
+		startsAt := coupon.GetStartsAt().AsTime()
+		result.StartsAt = &startsAt
+	}
+	if coupon.GetEndsAt() != nil {
+		endsAt := coupon.GetEndsAt().AsTime()
+		result.EndsAt = &endsAt
+	}
+
+	return result
+}
+
+func couponToPB(coupon *model.Coupon) *pb.Coupon {
+	return &pb.Coupon{
+		Code:          coupon.Code,
+		Type:          pb.CouponType(pb.CouponType_value[string(coupon.Type)]),
+		PercentOff:    coupon.PercentOff,
+		AmountOff:     moneyToPB(coupon.AmountOff),
+		MinBasket:     moneyToPB(coupon.MinBasket),
+		BuySku:        coupon.BuySku,
+		BuyQuantity:   coupon.BuyQuantity,
+		GetQuantity:   coupon.GetQuantity,
+		UsageLimit:    coupon.UsageLimit,
+		PerUserLimit:  coupon.PerUserLimit,
+		TimesRedeemed: coupon.TimesRedeemed,
+		StartsAt:      timestampToPB(coupon.StartsAt),
+		EndsAt:        timestampToPB(coupon.EndsAt),
+	}
+}
+
+func timestampToPB(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
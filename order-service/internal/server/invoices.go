@@ -0,0 +1,36 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+)
+
+func (s *Server) GetInvoice(ctx context.Context, r *pb.GetInvoiceRequest) (*httpbody.HttpBody, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	staff := ctx.Value("role") == "staff"
+
+	_, pdf, err := s.Service.GetInvoice(ctx, int64(userID), staff, r.GetOrderId())
+	if err != nil {
+		log.Println(err)
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound), errors.Is(err, service.ErrInvoiceNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get invoice")
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/pdf",
+		Data:        pdf,
+	}, nil
+}
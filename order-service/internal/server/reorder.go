@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+)
+
+func (s *Server) Reorder(ctx context.Context, r *pb.ReorderRequest) (*pb.ReorderResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	lines, err := s.Service.Reorder(ctx, int64(userID), r.GetId())
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, service.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to reorder: %v", err))
+	}
+
+	resp := &pb.ReorderResponse{Lines: make([]*pb.ReorderLine, len(lines))}
+	for i, line := range lines {
+		resp.Lines[i] = &pb.ReorderLine{
+			Sku:           line.Sku,
+			Name:          line.Name,
+			Quantity:      line.Quantity,
+			Status:        pb.ReorderStatus(pb.ReorderStatus_value["REORDER_"+string(line.Status)]),
+			OriginalPrice: moneyToPB(line.OriginalPrice),
+		}
+		if line.CurrentPrice.Currency != "" {
+			resp.Lines[i].CurrentPrice = moneyToPB(line.CurrentPrice)
+		}
+	}
+
+	return resp, nil
+}
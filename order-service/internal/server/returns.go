@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"order-service/internal/model"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+)
+
+func (s *Server) RequestReturn(ctx context.Context, r *pb.RequestReturnRequest) (*pb.Return, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	items := make([]*model.ReturnItem, len(r.GetLines()))
+	for i, line := range r.GetLines() {
+		items[i] = &model.ReturnItem{
+			Sku:       line.GetSku(),
+			Quantity:  line.GetQuantity(),
+			Reason:    line.GetReason(),
+			PhotoRefs: line.GetPhotoRefs(),
+		}
+	}
+
+	ret, err := s.Service.RequestReturn(ctx, int64(userID), r.GetOrderId(), items)
+	if err != nil {
+		return nil, returnError(err)
+	}
+
+	return returnToPB(ret), nil
+}
+
+func (s *Server) ListOrderReturns(ctx context.Context, r *pb.ListOrderReturnsRequest) (*pb.ListOrderReturnsResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	returns, err := s.Service.GetOrderReturns(ctx, int64(userID), r.GetOrderId())
+	if err != nil {
+		return nil, returnError(err)
+	}
+
+	resp := &pb.ListOrderReturnsResponse{Returns: make([]*pb.Return, len(returns))}
+	for i, ret := range returns {
+		resp.Returns[i] = returnToPB(ret)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetReturn(ctx context.Context, r *pb.GetReturnRequest) (*pb.Return, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	staff := ctx.Value("role") == "staff"
+
+	ret, err := s.Service.GetReturn(ctx, int64(userID), staff, r.GetId())
+	if err != nil {
+		return nil, returnError(err)
+	}
+
+	return returnToPB(ret), nil
+}
+
+func (s *Server) ReviewReturn(ctx context.Context, r *pb.ReviewReturnRequest) (*pb.Return, error) {
+	ret, err := s.Service.ReviewReturn(ctx, r.GetId(), r.GetApprove(), r.GetNote())
+	if err != nil {
+		return nil, returnError(err)
+	}
+
+	return returnToPB(ret), nil
+}
+
+func (s *Server) ReceiveReturn(ctx context.Context, r *pb.ReceiveReturnRequest) (*pb.Return, error) {
+	inspections := make([]service.Inspection, len(r.GetItems()))
+	for i, item := range r.GetItems() {
+		inspections[i] = service.Inspection{
+			ItemID:           item.GetItemId(),
+			AcceptedQuantity: item.GetAcceptedQuantity(),
+			Restock:          item.GetRestock(),
+		}
+	}
+
+	ret, err := s.Service.ReceiveReturn(ctx, r.GetId(), inspections, r.GetNote())
+	if err != nil {
+		return nil, returnError(err)
+	}
+
+	return returnToPB(ret), nil
+}
+
+func returnError(err error) error {
+	log.Println(err)
+	switch {
+	case errors.Is(err, service.ErrInvalidReturn):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrOrderNotFound), errors.Is(err, service.ErrReturnNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrOrderNotReturnable),
+		errors.Is(err, service.ErrReturnQuantityExceeded),
+		errors.Is(err, service.ErrReturnStatusConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "error processing return")
+}
+
+func returnToPB(ret *model.Return) *pb.Return {
+	items := make([]*pb.ReturnItem, len(ret.Items))
+	for i, item := range ret.Items {
+		items[i] = &pb.ReturnItem{
+			Id:        item.ID,
+			Sku:       item.Sku,
+			Quantity:  item.Quantity,
+			UnitPrice: moneyToPB(item.UnitPrice),
+			Reason:    item.Reason,
+			PhotoRefs: item.PhotoRefs,
+			Restock:   item.Restock,
+		}
+		if item.AcceptedQuantity != nil {
+			items[i].AcceptedQuantity = *item.AcceptedQuantity
+		}
+	}
+
+	return &pb.Return{
+		Id:           ret.ID,
+		OrderId:      ret.OrderID,
+		Status:       pb.ReturnStatus(pb.ReturnStatus_value["RETURN_"+string(ret.Status)]),
+		Items:        items,
+		StaffNote:    ret.StaffNote,
+		RefundAmount: moneyToPB(ret.RefundAmount),
+		CreatedAt:    timestamppb.New(ret.CreatedAt),
+		UpdatedAt:    timestamppb.New(ret.UpdatedAt),
+	}
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"log"
+	"order-service/internal/model"
 	"order-service/internal/service"
 	pb "order-service/protobuf"
+	"shared/money"
 )
 
 type Server struct {
@@ -52,16 +55,14 @@ func (s *Server) CreateOrder(ctx context.Context, r *pb.CreateOrderRequest) (*pb
 		return nil, status.Error(codes.InvalidArgument, "invalid payment method")
 	}
 
-	orderID, orderStatus, err := s.Service.CreateOrderSaga(ctx, userIDInt, r.GetShippingAddress(), r.GetPaymentMethod().String(), paymentIntentID)
+	orderID, orderStatus, err := s.Service.CreateOrderSaga(ctx, userIDInt, r.GetCheckoutSessionId(), r.GetPaymentMethod().String(), paymentIntentID)
 	if err != nil {
 		log.Println(err)
-		switch {
-		case errors.Is(err, service.ErrEmptyCart):
-			return nil, status.Error(codes.FailedPrecondition, "cart is empty")
-		case errors.Is(err, service.ErrGetCart):
-			return nil, status.Error(codes.Internal, "failed to get cart")
-		case errors.Is(err, service.ErrMissingUserID):
-			return nil, status.Error(codes.FailedPrecondition, "missing user id in context")
+		if errors.Is(err, service.ErrCouponUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if st := checkoutError(err); st != nil {
+			return nil, st
 		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create order: %v", err))
 	}
@@ -86,58 +87,137 @@ func (s *Server) GetOrder(ctx context.Context, r *pb.GetOrderRequest) (*pb.Order
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("failed to get order: %v", err))
 	}
 
-	items := make([]*pb.OrderItem, len(order.Items))
-	for i, item := range order.Items {
-		items[i] = &pb.OrderItem{
-			Quantity: item.Quantity,
-			Price:    item.Price,
+	return orderToPB(order), nil
+}
+
+func (s *Server) ListUserOrders(ctx context.Context, r *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing") // return FAILED_PRECONDITION status here as the system should never get into this state
+	}
+
+	filter := model.OrderFilter{UserID: int64(userID)}
+	for _, st := range r.GetStatuses() {
+		name, ok := pb.Status_name[int32(st)]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown order status")
 		}
+		filter.Statuses = append(filter.Statuses, model.Status(name))
+	}
+	if r.GetCreatedAfter() != nil {
+		filter.CreatedAfter = r.GetCreatedAfter().AsTime()
+	}
+	if r.GetCreatedBefore() != nil {
+		filter.CreatedBefore = r.GetCreatedBefore().AsTime()
 	}
 
-	return &pb.Order{
-		Id:              order.ID,
-		UserId:          order.UserID,
-		Status:          pb.Status(pb.Status_value[string(order.Status)]),
-		Items:           items,
-		TotalPrice:      order.TotalPrice,
-		ShippingAddress: order.ShippingAddress,
+	page, err := s.Service.ListOrders(ctx, filter, r.GetSort() == pb.OrderSort_OLDEST_FIRST, r.GetPageSize(), r.GetPageToken())
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, service.ErrInvalidOrderQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get user orders: %v", err))
+	}
+
+	ordersResponse := make([]*pb.Order, len(page.Orders))
+	for i, order := range page.Orders {
+		ordersResponse[i] = orderToPB(order)
+	}
+
+	return &pb.ListOrdersResponse{
+		Orders:        ordersResponse,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
-func (s *Server) ListUserOrders(ctx context.Context, _ *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+func (s *Server) CancelOrder(ctx context.Context, r *pb.CancelOrderRequest) (*pb.Order, error) {
 	userID, ok := ctx.Value("user-id").(int)
 	if !ok {
-		return nil, status.Error(codes.FailedPrecondition, "user id missing") // return FAILED_PRECONDITION status here as the system should never get into this state
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
 	}
 
-	userIDInt := int64(userID)
+	order, err := s.Service.CancelOrder(ctx, int64(userID), r.GetId(), r.GetReason())
+	if err != nil {
+		log.Println(err)
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound):
+			return nil, status.Error(codes.NotFound, "order not found")
+		case errors.Is(err, service.ErrOrderNotCancellable):
+			return nil, status.Error(codes.FailedPrecondition, "order can no longer be cancelled")
+		case errors.Is(err, service.ErrInvalidReason):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to cancel order: %v", err))
+	}
 
-	orders, err := s.Service.GetUserOrders(ctx, userIDInt)
+	return orderToPB(order), nil
+}
+
+func (s *Server) HasPurchased(ctx context.Context, r *pb.HasPurchasedRequest) (*pb.HasPurchasedResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	purchased, err := s.Service.HasPurchased(ctx, int64(userID), r.GetSku())
 	if err != nil {
 		log.Println(err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get user orders: %v", err))
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to check purchase: %v", err))
+	}
+
+	return &pb.HasPurchasedResponse{Purchased: purchased}, nil
+}
+
+func orderToPB(order *model.Order) *pb.Order {
+	items := make([]*pb.OrderItem, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = &pb.OrderItem{
+			Quantity:  item.Quantity,
+			Price:     moneyToPB(item.Price),
+			ProductId: item.ProductID,
+			Sku:       item.Sku,
+			Name:      item.Name,
+			ImageUrl:  item.ImageURL,
+			LineTotal: moneyToPB(item.Price.Mul(item.Quantity)),
+		}
 	}
 
-	var ordersResponse []*pb.Order
-	for _, order := range orders {
-		items := make([]*pb.OrderItem, len(order.Items))
-		for i, item := range order.Items {
-			items[i] = &pb.OrderItem{
-				Quantity: item.Quantity,
-				Price:    item.Price,
-			}
+	history := make([]*pb.StatusChange, len(order.StatusHistory))
+	for i, change := range order.StatusHistory {
+		history[i] = &pb.StatusChange{
+			Status:    pb.Status(pb.Status_value[string(change.Status)]),
+			Reason:    change.Reason,
+			ChangedAt: timestamppb.New(change.ChangedAt),
 		}
-		ordersResponse = append(ordersResponse, &pb.Order{
-			Id:              order.ID,
-			UserId:          order.UserID,
-			Status:          pb.Status(pb.Status_value[string(order.Status)]),
-			Items:           items,
-			TotalPrice:      order.TotalPrice,
-			ShippingAddress: order.ShippingAddress,
-		})
 	}
 
-	return &pb.ListOrdersResponse{
-		Orders: ordersResponse,
-	}, nil
+	return &pb.Order{
+		Id:                 order.ID,
+		UserId:             order.UserID,
+		Status:             pb.Status(pb.Status_value[string(order.Status)]),
+		Items:              items,
+		TotalPrice:         moneyToPB(order.TotalPrice),
+		ShippingAddress:    order.ShippingAddress,
+		Discount:           moneyToPB(order.Discount),
+		CouponCode:         order.CouponCode,
+		Subtotal:           moneyToPB(order.Subtotal),
+		Shipping:           moneyToPB(order.Shipping),
+		Tax:                moneyToPB(order.Tax),
+		ShippingMethod:     order.ShippingMethod,
+		Address:            addressToPB(order.Address),
+		CancellationReason: order.CancellationReason,
+		CreatedAt:          timestamppb.New(order.CreatedAt),
+		PaymentMethod:      pb.PaymentMethod(pb.PaymentMethod_value[order.PaymentMethod]),
+		StatusHistory:      history,
+		SubscriptionId:     order.SubscriptionID,
+	}
+}
+
+func moneyToPB(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
 }
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"order-service/internal/model"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+)
+
+func (s *Server) CreateShipment(ctx context.Context, r *pb.CreateShipmentRequest) (*pb.Shipment, error) {
+	items := make([]*model.ShipmentItem, len(r.GetLines()))
+	for i, line := range r.GetLines() {
+		items[i] = &model.ShipmentItem{
+			Sku:      line.GetSku(),
+			Quantity: line.GetQuantity(),
+		}
+	}
+
+	shipment, err := s.Service.CreateShipment(ctx, r.GetOrderId(), r.GetCarrier(), items)
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	return shipmentToPB(shipment), nil
+}
+
+func (s *Server) ListOrderShipments(ctx context.Context, r *pb.ListOrderShipmentsRequest) (*pb.ListOrderShipmentsResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	staff := ctx.Value("role") == "staff"
+
+	shipments, err := s.Service.GetOrderShipments(ctx, int64(userID), staff, r.GetOrderId())
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	resp := &pb.ListOrderShipmentsResponse{Shipments: make([]*pb.Shipment, len(shipments))}
+	for i, shipment := range shipments {
+		resp.Shipments[i] = shipmentToPB(shipment)
+	}
+
+	return resp, nil
+}
+
+func shipmentError(err error) error {
+	log.Println(err)
+	switch {
+	case errors.Is(err, service.ErrInvalidShipment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrOrderNotShippable), errors.Is(err, service.ErrShipmentQuantityExceeded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "error processing shipment")
+}
+
+func shipmentToPB(shipment *model.Shipment) *pb.Shipment {
+	items := make([]*pb.ShipmentLine, len(shipment.Items))
+	for i, item := range shipment.Items {
+		items[i] = &pb.ShipmentLine{
+			Sku:      item.Sku,
+			Quantity: item.Quantity,
+		}
+	}
+
+	events := make([]*pb.TrackingEvent, len(shipment.Events))
+	for i, event := range shipment.Events {
+		events[i] = &pb.TrackingEvent{
+			Status:      shipmentStatusToPB(event.Status),
+			Description: event.Description,
+			Location:    event.Location,
+			OccurredAt:  timestamppb.New(event.OccurredAt),
+		}
+	}
+
+	resp := &pb.Shipment{
+		Id:                shipment.ID,
+		OrderId:           shipment.OrderID,
+		Carrier:           shipment.Carrier,
+		TrackingNumber:    shipment.TrackingNumber,
+		Status:            shipmentStatusToPB(shipment.Status),
+		Items:             items,
+		Events:            events,
+		EstimatedDelivery: timestamppb.New(shipment.EstimatedDelivery),
+		CreatedAt:         timestamppb.New(shipment.CreatedAt),
+	}
+	if shipment.DeliveredAt != nil {
+		resp.DeliveredAt = timestamppb.New(*shipment.DeliveredAt)
+	}
+
+	return resp
+}
+
+func shipmentStatusToPB(st model.ShipmentStatus) pb.ShipmentStatus {
+	return pb.ShipmentStatus(pb.ShipmentStatus_value["SHIPMENT_"+string(st)])
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"order-service/internal/checkout"
+	"order-service/internal/model"
+	"order-service/internal/service"
+	pb "order-service/protobuf"
+	"time"
+)
+
+func (s *Server) CreateSubscription(ctx context.Context, r *pb.CreateSubscriptionRequest) (*pb.Subscription, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	var paymentMethod string
+	switch r.GetPaymentMethod() {
+	case pb.PaymentMethod_CARD, pb.PaymentMethod_ON_DELIVERY:
+		paymentMethod = r.GetPaymentMethod().String()
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid payment method")
+	}
+
+	items := make([]*model.SubscriptionItem, len(r.GetItems()))
+	for i, item := range r.GetItems() {
+		items[i] = &model.SubscriptionItem{
+			Sku:      item.GetSku(),
+			Quantity: item.GetQuantity(),
+		}
+	}
+
+	sub := &model.Subscription{
+		Items:                 items,
+		IntervalDays:          r.GetIntervalDays(),
+		Address:               addressFromPB(r.GetAddress()),
+		ShippingMethod:        r.GetShippingMethod(),
+		PaymentMethod:         paymentMethod,
+		StoredPaymentMethodID: r.GetStoredPaymentMethodId(),
+	}
+
+	var firstOrderAt time.Time
+	if r.GetFirstOrderAt() != nil {
+		firstOrderAt = r.GetFirstOrderAt().AsTime()
+	}
+
+	sub, err := s.Service.CreateSubscription(ctx, int64(userID), sub, firstOrderAt)
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	return subscriptionToPB(sub), nil
+}
+
+func (s *Server) ListSubscriptions(ctx context.Context, r *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	subs, err := s.Service.ListSubscriptions(ctx, int64(userID))
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	resp := &pb.ListSubscriptionsResponse{Subscriptions: make([]*pb.Subscription, len(subs))}
+	for i, sub := range subs {
+		resp.Subscriptions[i] = subscriptionToPB(sub)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetSubscription(ctx context.Context, r *pb.GetSubscriptionRequest) (*pb.Subscription, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	sub, err := s.Service.GetSubscription(ctx, int64(userID), r.GetId())
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	return subscriptionToPB(sub), nil
+}
+
+func (s *Server) PauseSubscription(ctx context.Context, r *pb.PauseSubscriptionRequest) (*pb.Subscription, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	sub, err := s.Service.PauseSubscription(ctx, int64(userID), r.GetId())
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	return subscriptionToPB(sub), nil
+}
+
+func (s *Server) ResumeSubscription(ctx context.Context, r *pb.ResumeSubscriptionRequest) (*pb.Subscription, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	sub, err := s.Service.ResumeSubscription(ctx, int64(userID), r.GetId())
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	return subscriptionToPB(sub), nil
+}
+
+func (s *Server) SkipSubscriptionDelivery(ctx context.Context, r *pb.SkipSubscriptionDeliveryRequest) (*pb.Subscription, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	sub, err := s.Service.SkipSubscriptionDelivery(ctx, int64(userID), r.GetId())
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	return subscriptionToPB(sub), nil
+}
+
+func (s *Server) CancelSubscription(ctx context.Context, r *pb.CancelSubscriptionRequest) (*pb.Subscription, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	sub, err := s.Service.CancelSubscription(ctx, int64(userID), r.GetId())
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+
+	return subscriptionToPB(sub), nil
+}
+
+func subscriptionError(err error) error {
+	log.Println(err)
+	switch {
+	case errors.Is(err, service.ErrInvalidSubscription), errors.Is(err, model.ErrInvalidAddress):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrSubscriptionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrSubscriptionStatusConflict),
+		errors.Is(err, service.ErrSubscriptionOrderPending),
+		errors.Is(err, service.ErrProductUnavailable),
+		errors.Is(err, checkout.ErrNoShippingMethods),
+		errors.Is(err, checkout.ErrShippingMethodUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "error processing subscription")
+}
+
+func subscriptionToPB(sub *model.Subscription) *pb.Subscription {
+	items := make([]*pb.SubscriptionItem, len(sub.Items))
+	for i, item := range sub.Items {
+		items[i] = &pb.SubscriptionItem{
+			Sku:      item.Sku,
+			Quantity: item.Quantity,
+			Name:     item.Name,
+		}
+	}
+
+	resp := &pb.Subscription{
+		Id:                    sub.ID,
+		Status:                pb.SubscriptionStatus(pb.SubscriptionStatus_value["SUBSCRIPTION_"+string(sub.Status)]),
+		Items:                 items,
+		IntervalDays:          sub.IntervalDays,
+		Address:               addressToPB(&sub.Address),
+		ShippingMethod:        sub.ShippingMethod,
+		PaymentMethod:         pb.PaymentMethod(pb.PaymentMethod_value[sub.PaymentMethod]),
+		StoredPaymentMethodId: sub.StoredPaymentMethodID,
+		NextOrderAt:           timestamppb.New(sub.NextOrderAt),
+		RetryAt:               timestampToPB(sub.RetryAt),
+		FailedAttempts:        sub.FailedAttempts,
+		LastFailure:           sub.LastFailure,
+		CreatedAt:             timestamppb.New(sub.CreatedAt),
+		CancelledAt:           timestampToPB(sub.CancelledAt),
+	}
+	if sub.PendingOrderID != nil {
+		resp.PendingOrderId = *sub.PendingOrderID
+	}
+
+	return resp
+}
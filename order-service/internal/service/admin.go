@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	pb "order-service/protobuf"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxOverrideReasonLength = 500
+	maxNoteLength           = 2000
+	maxExportOrders         = 10000
+)
+
+var (
+	ErrInvalidStatusOverride = errors.New("invalid status override")
+	ErrStatusUnchanged       = errors.New("order already has that status")
+	ErrInvalidNote           = errors.New("invalid note")
+)
+
+// AdminOrder is an order together with the notes staff left on it.
+type AdminOrder struct {
+	Order *model.Order
+	Notes []*model.OrderNote
+}
+
+// SearchOrders returns a page of every customer's orders matching the filter and, if it
+// isn't empty, placed by the account with that email address.
+func (s *Service) SearchOrders(ctx context.Context, filter model.OrderFilter, email string, oldestFirst bool, pageSize int32, pageToken string) (*OrderPage, error) {
+	found, err := s.filterByEmail(ctx, &filter, email)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &OrderPage{}, nil
+	}
+
+	return s.ListOrders(ctx, filter, oldestFirst, pageSize, pageToken)
+}
+
+// ExportOrders writes the orders SearchOrders would find as CSV, one row per order.
+func (s *Service) ExportOrders(ctx context.Context, filter model.OrderFilter, email string, oldestFirst bool, w io.Writer) error {
+	if err := checkOrderFilter(filter); err != nil {
+		return err
+	}
+
+	found, err := s.filterByEmail(ctx, &filter, email)
+	if err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+	err = out.Write([]string{
+		"order_id", "created_at", "user_id", "status", "payment_method", "shipping_method", "currency",
+		"subtotal", "discount", "shipping", "tax", "total", "coupon_code", "items", "shipping_address",
+	})
+	if err != nil {
+		return err
+	}
+
+	var after *model.OrderCursor
+	for found {
+		orders, total, err := s.repo.ListOrders(ctx, filter, oldestFirst, after, maxOrderPageSize)
+		if err != nil {
+			return err
+		}
+
+		if after == nil && total > maxExportOrders {
+			return fmt.Errorf("%w: %d orders match, more than the %d an export can hold", ErrInvalidOrderQuery, total, maxExportOrders)
+		}
+
+		for _, order := range orders {
+			if err = out.Write(orderRecord(order)); err != nil {
+				return err
+			}
+		}
+
+		if len(orders) < maxOrderPageSize {
+			break
+		}
+		last := orders[len(orders)-1]
+		after = &model.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	out.Flush()
+
+	return out.Error()
+}
+
+// filterByEmail narrows the filter to the customer with the email address. It reports
+// false when no order can match: there is no such customer, or the filter is for another
+// one.
+func (s *Service) filterByEmail(ctx context.Context, filter *model.OrderFilter, email string) (bool, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return true, nil
+	}
+
+	user, err := s.userClient.GetUserByEmail(ctx, &pb.GetUserByEmailRequest{Email: email})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if filter.UserID != 0 && filter.UserID != user.GetUserId() {
+		return false, nil
+	}
+	filter.UserID = user.GetUserId()
+
+	return true, nil
+}
+
+// orderRecord is the CSV row of an order. Its lines are listed as "SKU x quantity",
+// separated by semicolons. Text that customers or staff chose is escaped with csvText.
+func orderRecord(order *model.Order) []string {
+	items := make([]string, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = fmt.Sprintf("%s x %d", item.Sku, item.Quantity)
+	}
+
+	return []string{
+		strconv.FormatInt(order.ID, 10),
+		order.CreatedAt.UTC().Format(time.RFC3339),
+		strconv.FormatInt(order.UserID, 10),
+		string(order.Status),
+		csvText(order.PaymentMethod),
+		csvText(order.ShippingMethod),
+		order.TotalPrice.Currency,
+		order.Subtotal.Decimal(),
+		order.Discount.Decimal(),
+		order.Shipping.Decimal(),
+		order.Tax.Decimal(),
+		order.TotalPrice.Decimal(),
+		csvText(order.CouponCode),
+		csvText(strings.Join(items, "; ")),
+		csvText(order.ShippingAddress),
+	}
+}
+
+// csvText keeps a spreadsheet from reading a cell as a formula, by prefixing cells that
+// start with a formula character with an apostrophe.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+
+	return s
+}
+
+// GetAdminOrder returns any customer's order with its notes.
+func (s *Service) GetAdminOrder(ctx context.Context, orderID int64) (*AdminOrder, error) {
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	notes, err := s.repo.GetOrderNotes(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminOrder{Order: order, Notes: notes}, nil
+}
+
+// OverrideOrderStatus sets the status of an order by hand, bypassing the usual
+// transitions. Nothing else follows from it: payments, stock and emails are up to staff.
+func (s *Service) OverrideOrderStatus(ctx context.Context, staffID, orderID int64, st model.Status, reason string) (*AdminOrder, error) {
+	reason = strings.TrimSpace(reason)
+	switch {
+	case reason == "":
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidStatusOverride)
+	case len(reason) > maxOverrideReasonLength:
+		return nil, fmt.Errorf("%w: reason is too long", ErrInvalidStatusOverride)
+	}
+
+	err := s.repo.OverrideOrderStatus(ctx, orderID, st, reason, staffID, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return nil, ErrOrderNotFound
+		case errors.Is(err, repository.ErrStatusUnchanged):
+			return nil, ErrStatusUnchanged
+		}
+		return nil, err
+	}
+
+	return s.GetAdminOrder(ctx, orderID)
+}
+
+func (s *Service) AddOrderNote(ctx context.Context, staffID, orderID int64, body string) (*model.OrderNote, error) {
+	body = strings.TrimSpace(body)
+	switch {
+	case body == "":
+		return nil, fmt.Errorf("%w: body is required", ErrInvalidNote)
+	case len(body) > maxNoteLength:
+		return nil, fmt.Errorf("%w: body is too long", ErrInvalidNote)
+	}
+
+	if _, err := s.repo.GetOrderByID(ctx, orderID); err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	note := &model.OrderNote{
+		OrderID:   orderID,
+		AuthorID:  staffID,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repo.AddOrderNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
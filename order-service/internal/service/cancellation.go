@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"html/template"
+	"log"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	pb "order-service/protobuf"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxCancellationReasonLength = 500
+
+// CancelOrder cancels one of the user's orders on their request. Only paid or confirmed
+// orders can be cancelled; the orders.cancelled event then has the payment refunded, the
+// stock restored and the customer notified. If the event can't be sent, the order stays
+// cancelled and cancelling it again resends the event.
+func (s *Service) CancelOrder(ctx context.Context, userID, orderID int64, reason string) (*model.Order, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxCancellationReasonLength {
+		return nil, ErrInvalidReason
+	}
+
+	outgoingCtx, err := outgoingAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The customer is looked up first so that a cancelled order always gets its event.
+	userResp, err := s.userClient.GetProfile(outgoingCtx, &pb.GetUserRequest{})
+	if err != nil {
+		switch {
+		case status.Code(err) == codes.FailedPrecondition:
+			return nil, ErrMissingUserID
+		case status.Code(err) == codes.NotFound:
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	err = s.repo.CancelOrder(ctx, orderID, userID, reason, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return nil, ErrOrderNotFound
+		case errors.Is(err, repository.ErrOrderNotCancellable):
+			return nil, ErrOrderNotCancellable
+		}
+		return nil, err
+	}
+
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventData, err := s.cancelledOrderData(ctx, order, userResp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sendOrderCancelledEvent(ctx, eventData); err != nil {
+		log.Println(err)
+		return nil, ErrSendingEvent
+	}
+
+	if err = s.repo.MarkCancellationEventSent(ctx, orderID); err != nil {
+		// The event is out; failing the request would only invite a second one.
+		log.Printf("Error marking the cancellation event of order %d sent: %s", orderID, err)
+	}
+
+	return order, nil
+}
+
+func (s *Service) cancelledOrderData(ctx context.Context, order *model.Order, user *pb.GetUserResponse) (OrderData, error) {
+	lines, err := s.repo.GetOrderLines(ctx, order.ID)
+	if err != nil {
+		return OrderData{}, err
+	}
+
+	lineBySku := make(map[string]model.CheckoutLine, len(lines))
+	for _, line := range lines {
+		lineBySku[line.Sku] = line
+	}
+
+	items := make([]*OrderItemData, len(order.Items))
+	for i, item := range order.Items {
+		// Orders placed before checkout sessions only know the SKU.
+		name := item.Sku
+		var imageURL string
+		if line, ok := lineBySku[item.Sku]; ok {
+			name = line.Name
+			imageURL = line.ImageURL
+		}
+
+		items[i] = &OrderItemData{
+			Quantity:       int32(item.Quantity),
+			Price:          item.Price,
+			Sku:            item.Sku,
+			Name:           name,
+			ImageURL:       template.URL(imageURL),
+			ItemTotalPrice: item.Price.Mul(item.Quantity),
+		}
+	}
+
+	return OrderData{
+		CustomerFirstName:  user.GetFirstName(),
+		CustomerLastName:   user.GetLastName(),
+		CustomerEmail:      user.GetEmail(),
+		OrderID:            order.ID,
+		OrderDate:          order.CreatedAt,
+		UserID:             order.UserID,
+		Items:              items,
+		Amount:             order.TotalPrice,
+		Subtotal:           order.Subtotal,
+		Discount:           order.Discount,
+		Shipping:           order.Shipping,
+		Tax:                order.Tax,
+		Currency:           order.TotalPrice.Currency,
+		ShippingAddress:    order.ShippingAddress,
+		ShippingMethod:     order.ShippingMethod,
+		CheckoutSessionID:  order.CheckoutSessionID,
+		CancellationReason: order.CancellationReason,
+	}, nil
+}
+
+func (s *Service) sendOrderCancelledEvent(ctx context.Context, eventData OrderData) error {
+	event := OrderCreatedEvent{
+		EventID:   uuid.NewString(),
+		EventType: "orders.cancelled",
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data:      eventData,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Key:   []byte(strconv.Itoa(int(eventData.OrderID))),
+		Value: eventBytes,
+	}
+
+	err = s.orderCancelledWriter.WriteMessages(ctx, msg)
+
+	return err
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"log"
+	"order-service/internal/checkout"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	pb "order-service/protobuf"
+	"time"
+)
+
+type CheckoutEvent struct {
+	EventID   string              `json:"event_id"`
+	EventType string              `json:"event_type"`
+	Timestamp time.Time           `json:"timestamp"`
+	Version   string              `json:"version"`
+	Data      CheckoutSessionData `json:"data"`
+}
+
+type CheckoutSessionData struct {
+	SessionID string    `json:"session_id"`
+	UserID    int64     `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Quote prices the caller's cart shipped to address by method, or by the cheapest method
+// available when method is empty.
+func (s *Service) Quote(ctx context.Context, address model.Address, method string) (*checkout.Quote, error) {
+	outgoingCtx, err := outgoingAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.getCheckoutCart(outgoingCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.checkout.Quote(ctx, address.Normalize(), method, checkoutCart(cart))
+}
+
+// getCheckoutCart returns the caller's cart once it's validated against the catalog.
+// Validation re-prices changed lines, so the cart returned reflects the catalog.
+func (s *Service) getCheckoutCart(outgoingCtx context.Context) (*pb.GetCartResponse, error) {
+	validateResp, err := s.cartClient.ValidateCart(outgoingCtx, &pb.ValidateCartRequest{})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, ErrMissingUserID
+		}
+		return nil, ErrGetCart
+	}
+
+	if !validateResp.GetValid() {
+		return nil, ErrStaleCart
+	}
+
+	getCartResp, err := s.cartClient.GetCart(outgoingCtx, &pb.GetCartRequest{})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, ErrMissingUserID
+		}
+		return nil, ErrGetCart
+	}
+
+	if len(getCartResp.GetItems()) == 0 {
+		return nil, ErrEmptyCart
+	}
+
+	return getCartResp, nil
+}
+
+func checkoutCart(cart *pb.GetCartResponse) checkout.Cart {
+	return checkout.Cart{
+		Subtotal:     moneyFromPB(cart.GetSubtotal()),
+		Discount:     moneyFromPB(cart.GetDiscount()),
+		Items:        int64(cart.GetTotalItems()),
+		FreeShipping: cart.GetFreeShipping(),
+	}
+}
+
+// outgoingAuth forwards the caller's token to the services called on their behalf.
+func outgoingAuth(ctx context.Context) (context.Context, error) {
+	mt, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrMissingMetadata
+	}
+
+	tokens := mt.Get("Authorization")
+	if len(tokens) == 0 {
+		return nil, ErrMissingMetadata
+	}
+
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("Authorization", tokens[0])), nil
+}
+
+// CreateCheckoutSession freezes the caller's cart and its quote until the session expires.
+// The payment and the order are both made for the session, not for the live cart.
+func (s *Service) CreateCheckoutSession(ctx context.Context, userID int64, address model.Address, method string) (*model.CheckoutSession, error) {
+	outgoingCtx, err := outgoingAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.getCheckoutCart(outgoingCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	address = address.Normalize()
+	quote, err := s.checkout.Quote(ctx, address, method, checkoutCart(cart))
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]*model.CheckoutLine, len(cart.GetItems()))
+	for i, item := range cart.GetItems() {
+		lines[i] = &model.CheckoutLine{
+			Sku:       item.GetSku(),
+			ProductID: item.GetProductId(),
+			Name:      item.GetName(),
+			ImageURL:  item.GetImageUrl(),
+			Quantity:  item.GetQuantity(),
+			Price:     moneyFromPB(item.GetPrice()),
+		}
+	}
+
+	now := time.Now()
+	session := &model.CheckoutSession{
+		ID:                 uuid.NewString(),
+		UserID:             userID,
+		Status:             model.SessionOpen,
+		Lines:              lines,
+		Address:            address,
+		ShippingMethod:     quote.Method.Method,
+		ShippingMethodName: quote.Method.Name,
+		DeliveryDays:       quote.Method.MaxDays,
+		CouponCode:         cart.GetCouponCode(),
+		Subtotal:           quote.Subtotal,
+		Discount:           quote.Discount,
+		Shipping:           quote.Shipping,
+		Tax:                quote.Tax,
+		Total:              quote.Total,
+		CreatedAt:          now,
+		ExpiresAt:          now.Add(s.sessionTTL),
+	}
+
+	if err = s.repo.CreateCheckoutSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// GetCheckoutSession returns one of the user's checkout sessions. A session past its expiry
+// is reported as expired even before the expiry sweep closes it.
+func (s *Service) GetCheckoutSession(ctx context.Context, userID int64, id string) (*model.CheckoutSession, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrCheckoutSessionNotFound
+	}
+
+	session, err := s.repo.GetCheckoutSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrCheckoutSessionNotFound) {
+			return nil, ErrCheckoutSessionNotFound
+		}
+		return nil, err
+	}
+
+	if session.UserID != userID {
+		return nil, ErrCheckoutSessionNotFound
+	}
+
+	if session.Status == model.SessionOpen && !session.Open(time.Now()) {
+		session.Status = model.SessionExpired
+	}
+
+	return session, nil
+}
+
+// RunSessionExpiry closes expired checkout sessions every interval until ctx is cancelled.
+func (s *Service) RunSessionExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ExpireCheckoutSessions(ctx); err != nil {
+				log.Printf("Error expiring checkout sessions: %s", err)
+			}
+		}
+	}
+}
+
+// ExpireCheckoutSessions closes the open sessions past their expiry and emits
+// checkout.expired for each, so that a payment made for one is released.
+func (s *Service) ExpireCheckoutSessions(ctx context.Context) error {
+	sessions, err := s.repo.ExpireCheckoutSessions(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		err = s.sendCheckoutExpiredEvent(ctx, CheckoutSessionData{
+			SessionID: session.ID,
+			UserID:    session.UserID,
+			ExpiresAt: session.ExpiresAt,
+		})
+		if err != nil {
+			log.Printf("Error sending checkout.expired for session %s: %s", session.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) sendCheckoutExpiredEvent(ctx context.Context, eventData CheckoutSessionData) error {
+	event := CheckoutEvent{
+		EventID:   uuid.NewString(),
+		EventType: "checkout.expired",
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data:      eventData,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Key:   []byte(eventData.SessionID),
+		Value: eventBytes,
+	}
+
+	return s.checkoutExpiredWriter.WriteMessages(ctx, msg)
+}
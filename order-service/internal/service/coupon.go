@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	"shared/money"
+	"strings"
+)
+
+const defaultCouponCurrency = "USD"
+
+// CreateCoupon validates and stores a new promotion code. Codes are case-insensitive and
+// stored upper-cased.
+func (s *Service) CreateCoupon(ctx context.Context, coupon *model.Coupon) error {
+	coupon.Code = strings.ToUpper(strings.TrimSpace(coupon.Code))
+	if err := validateCoupon(coupon); err != nil {
+		return err
+	}
+
+	err := s.repo.CreateCoupon(ctx, coupon)
+	if errors.Is(err, repository.ErrCouponExists) {
+		return ErrCouponExists
+	}
+
+	return err
+}
+
+// GetCoupon returns a coupon and how often the user has redeemed it. A zero user ID, as for
+// guest carts, reports no redemptions.
+func (s *Service) GetCoupon(ctx context.Context, code string, userID int64) (*model.Coupon, int32, error) {
+	coupon, err := s.repo.GetCoupon(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		if errors.Is(err, repository.ErrCouponNotFound) {
+			return nil, 0, ErrCouponNotFound
+		}
+		return nil, 0, err
+	}
+
+	if userID == 0 {
+		return coupon, 0, nil
+	}
+
+	redemptions, err := s.repo.CountUserRedemptions(ctx, coupon.Code, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return coupon, redemptions, nil
+}
+
+func validateCoupon(coupon *model.Coupon) error {
+	if coupon.Code == "" || len(coupon.Code) > 64 {
+		return ErrInvalidCoupon
+	}
+
+	// Every amount of a coupon is in a single currency.
+	currency := coupon.AmountOff.Currency
+	if currency == "" {
+		currency = coupon.MinBasket.Currency
+	}
+	if currency == "" {
+		currency = defaultCouponCurrency
+	}
+	if !money.IsSupported(currency) ||
+		(coupon.AmountOff.Currency != "" && coupon.AmountOff.Currency != currency) ||
+		(coupon.MinBasket.Currency != "" && coupon.MinBasket.Currency != currency) {
+		return ErrInvalidCoupon
+	}
+	coupon.AmountOff.Currency = currency
+	coupon.MinBasket.Currency = currency
+
+	if coupon.AmountOff.Amount < 0 || coupon.MinBasket.Amount < 0 || coupon.UsageLimit < 0 || coupon.PerUserLimit < 0 {
+		return ErrInvalidCoupon
+	}
+
+	if coupon.StartsAt != nil && coupon.EndsAt != nil && !coupon.EndsAt.After(*coupon.StartsAt) {
+		return ErrInvalidCoupon
+	}
+
+	switch coupon.Type {
+	case model.Percentage:
+		if coupon.PercentOff <= 0 || coupon.PercentOff > 100 {
+			return ErrInvalidCoupon
+		}
+	case model.FixedAmount:
+		if coupon.AmountOff.Amount == 0 {
+			return ErrInvalidCoupon
+		}
+	case model.BuyXGetY:
+		if coupon.BuySku == "" || coupon.BuyQuantity <= 0 || coupon.GetQuantity <= 0 {
+			return ErrInvalidCoupon
+		}
+	case model.FreeShipping:
+	default:
+		return ErrInvalidCoupon
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"errors"
+	"order-service/internal/model"
+	"shared/money"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateCoupon(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		coupon       model.Coupon
+		wantErr      error
+		wantCurrency string
+	}{
+		{
+			name:         "percentage",
+			coupon:       model.Coupon{Code: "SAVE10", Type: model.Percentage, PercentOff: 10},
+			wantCurrency: "USD",
+		},
+		{
+			name:         "full percentage",
+			coupon:       model.Coupon{Code: "FREE", Type: model.Percentage, PercentOff: 100},
+			wantCurrency: "USD",
+		},
+		{
+			name:    "percentage above 100",
+			coupon:  model.Coupon{Code: "SAVE101", Type: model.Percentage, PercentOff: 101},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "zero percentage",
+			coupon:  model.Coupon{Code: "SAVE0", Type: model.Percentage},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:         "fixed amount",
+			coupon:       model.Coupon{Code: "FIVE", Type: model.FixedAmount, AmountOff: money.New(500, "EUR")},
+			wantCurrency: "EUR",
+		},
+		{
+			name:    "fixed amount of zero",
+			coupon:  model.Coupon{Code: "NONE", Type: model.FixedAmount, AmountOff: money.New(0, "EUR")},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "negative amount",
+			coupon:  model.Coupon{Code: "MINUS", Type: model.FixedAmount, AmountOff: money.New(-500, "EUR")},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:         "currency from minimum basket",
+			coupon:       model.Coupon{Code: "SHIP", Type: model.FreeShipping, MinBasket: money.New(5000, "UAH")},
+			wantCurrency: "UAH",
+		},
+		{
+			name: "amounts in different currencies",
+			coupon: model.Coupon{
+				Code:      "MIXED",
+				Type:      model.FixedAmount,
+				AmountOff: money.New(500, "EUR"),
+				MinBasket: money.New(5000, "USD"),
+			},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "unsupported currency",
+			coupon:  model.Coupon{Code: "POUND", Type: model.FixedAmount, AmountOff: money.New(500, "GBP")},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "negative minimum basket",
+			coupon:  model.Coupon{Code: "SHIP", Type: model.FreeShipping, MinBasket: money.New(-1, "EUR")},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:         "buy x get y",
+			coupon:       model.Coupon{Code: "3FOR2", Type: model.BuyXGetY, BuySku: "A", BuyQuantity: 2, GetQuantity: 1},
+			wantCurrency: "USD",
+		},
+		{
+			name:    "buy x get y without sku",
+			coupon:  model.Coupon{Code: "3FOR2", Type: model.BuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "buy x get y without free units",
+			coupon:  model.Coupon{Code: "3FOR2", Type: model.BuyXGetY, BuySku: "A", BuyQuantity: 2},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "unknown type",
+			coupon:  model.Coupon{Code: "WHAT", Type: "MYSTERY"},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "empty code",
+			coupon:  model.Coupon{Type: model.FreeShipping},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "code too long",
+			coupon:  model.Coupon{Code: strings.Repeat("X", 65), Type: model.FreeShipping},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "negative usage limit",
+			coupon:  model.Coupon{Code: "SHIP", Type: model.FreeShipping, UsageLimit: -1},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "negative per user limit",
+			coupon:  model.Coupon{Code: "SHIP", Type: model.FreeShipping, PerUserLimit: -1},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:         "validity window",
+			coupon:       model.Coupon{Code: "SHIP", Type: model.FreeShipping, StartsAt: &start, EndsAt: &end},
+			wantCurrency: "USD",
+		},
+		{
+			name:    "ends when it starts",
+			coupon:  model.Coupon{Code: "SHIP", Type: model.FreeShipping, StartsAt: &start, EndsAt: &start},
+			wantErr: ErrInvalidCoupon,
+		},
+		{
+			name:    "ends before it starts",
+			coupon:  model.Coupon{Code: "SHIP", Type: model.FreeShipping, StartsAt: &end, EndsAt: &start},
+			wantErr: ErrInvalidCoupon,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCoupon(&tt.coupon)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validateCoupon() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if tt.coupon.AmountOff.Currency != tt.wantCurrency || tt.coupon.MinBasket.Currency != tt.wantCurrency {
+				t.Errorf("validateCoupon() currencies = %q, %q, want %q",
+					tt.coupon.AmountOff.Currency, tt.coupon.MinBasket.Currency, tt.wantCurrency)
+			}
+		})
+	}
+}
+
+func TestCouponActive(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	coupon := model.Coupon{StartsAt: &start, EndsAt: &end}
+
+	tests := []struct {
+		at   time.Time
+		want bool
+	}{
+		{at: start.Add(-time.Second), want: false},
+		{at: start, want: true},
+		{at: end.Add(-time.Second), want: true},
+		{at: end, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := coupon.Active(tt.at); got != tt.want {
+			t.Errorf("Active(%s) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
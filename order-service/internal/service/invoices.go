@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"order-service/internal/invoice"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	pb "order-service/protobuf"
+	"strings"
+	"time"
+)
+
+var ErrInvoiceNotFound = errors.New("order has no invoice yet")
+
+// InvoiceData is the invoice attached to the order confirmation email.
+type InvoiceData struct {
+	Number   string `json:"number"`
+	FileName string `json:"file_name"`
+	PDF      []byte `json:"pdf"`
+}
+
+// GetInvoice returns the invoice of one of the user's orders, or of any order to staff,
+// with its PDF. A confirmed order whose invoice couldn't be issued on confirmation gets it
+// now.
+func (s *Service) GetInvoice(ctx context.Context, userID int64, staff bool, orderID int64) (*model.Invoice, []byte, error) {
+	var order *model.Order
+	var err error
+	if staff {
+		order, err = s.repo.GetOrderByID(ctx, orderID)
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, nil, ErrOrderNotFound
+		}
+	} else {
+		order, err = s.GetOrder(ctx, userID, orderID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inv, err := s.repo.GetInvoiceByOrderID(ctx, orderID)
+	if err == nil {
+		pdf, err := s.readInvoice(ctx, inv)
+		return inv, pdf, err
+	}
+	if !errors.Is(err, repository.ErrInvoiceNotFound) {
+		return nil, nil, err
+	}
+
+	if !order.Status.Invoiceable() {
+		return nil, nil, ErrInvoiceNotFound
+	}
+
+	// The customer is looked up by ID as the caller may be staff.
+	user, err := s.userClient.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: order.UserID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.issueInvoice(ctx, order, user.GetFirstName()+" "+user.GetLastName(), user.GetEmail())
+}
+
+// issueInvoice returns the order's invoice with its PDF, issuing it first if the order has
+// none yet.
+func (s *Service) issueInvoice(ctx context.Context, order *model.Order, customerName, customerEmail string) (*model.Invoice, []byte, error) {
+	var pdf []byte
+	inv, issued, err := s.repo.IssueInvoice(ctx, order.ID, time.Now(), func(inv *model.Invoice) error {
+		var err error
+		pdf, err = s.invoices.Render(invoice.Document{
+			Invoice:       inv,
+			Order:         order,
+			CustomerName:  strings.TrimSpace(customerName),
+			CustomerEmail: customerEmail,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.blobs.Put(ctx, inv.BlobKey, bytes.NewReader(pdf))
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, nil, ErrOrderNotFound
+		}
+		return nil, nil, err
+	}
+
+	if !issued {
+		pdf, err = s.readInvoice(ctx, inv)
+	}
+
+	return inv, pdf, err
+}
+
+func (s *Service) readInvoice(ctx context.Context, inv *model.Invoice) ([]byte, error) {
+	r, err := s.blobs.Get(ctx, inv.BlobKey)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
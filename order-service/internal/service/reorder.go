@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	pb "order-service/protobuf"
+	"shared/money"
+)
+
+type ReorderStatus string
+
+const (
+	ReorderAdded             ReorderStatus = "ADDED"
+	ReorderPriceChanged      ReorderStatus = "PRICE_CHANGED"
+	ReorderDiscontinued      ReorderStatus = "DISCONTINUED"
+	ReorderInsufficientStock ReorderStatus = "INSUFFICIENT_STOCK"
+	ReorderFailed            ReorderStatus = "FAILED"
+)
+
+// ReorderLine is what became of an order line when it was added to the cart again.
+// CurrentPrice is the price in the cart, only known for lines that were added.
+type ReorderLine struct {
+	Sku           string
+	Name          string
+	Quantity      int64
+	Status        ReorderStatus
+	OriginalPrice money.Money
+	CurrentPrice  money.Money
+}
+
+// Reorder adds the lines of one of the user's orders to their cart. Lines whose product is
+// discontinued or short of stock, or that the cart fails to add, are skipped; the others are
+// added at today's price. A failed line doesn't stop the rest, since the lines before it are
+// already in the cart.
+func (s *Service) Reorder(ctx context.Context, userID, orderID int64) ([]*ReorderLine, error) {
+	outgoingCtx, err := outgoingAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.GetOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]*ReorderLine, len(order.Items))
+	for i, item := range order.Items {
+		line := &ReorderLine{
+			Sku:           item.Sku,
+			Name:          item.Name,
+			Quantity:      item.Quantity,
+			OriginalPrice: item.Price,
+		}
+		lines[i] = line
+
+		resp, err := s.cartClient.AddItem(outgoingCtx, &pb.AddItemRequest{Sku: item.Sku, Quantity: int32(item.Quantity)})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				line.Status = ReorderDiscontinued
+				continue
+			case codes.FailedPrecondition:
+				line.Status = ReorderInsufficientStock
+				continue
+			}
+			log.Printf("Error adding %s to the cart when reordering order %d: %s", item.Sku, orderID, err)
+			line.Status = ReorderFailed
+			continue
+		}
+
+		line.CurrentPrice = moneyFromPB(resp.GetItem().GetPrice())
+		line.Status = ReorderAdded
+		// The cart prices in the catalog currency, which needn't be the order's; prices in
+		// different currencies can't be told apart without a rate.
+		if line.CurrentPrice.Currency == line.OriginalPrice.Currency && line.CurrentPrice.Amount != line.OriginalPrice.Amount {
+			line.Status = ReorderPriceChanged
+		}
+	}
+
+	return lines, nil
+}
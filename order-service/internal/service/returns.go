@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	"shared/money"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxReturnReasonLength = 500
+	maxReturnPhotos       = 5
+	maxPhotoRefLength     = 2048
+	maxStaffNoteLength    = 1000
+)
+
+var (
+	ErrInvalidReturn          = errors.New("invalid return")
+	ErrReturnNotFound         = errors.New("return not found")
+	ErrOrderNotReturnable     = errors.New("only delivered orders can be returned")
+	ErrReturnQuantityExceeded = errors.New("return quantity exceeds what can still be returned")
+	ErrReturnStatusConflict   = errors.New("return is not in a status that allows this")
+)
+
+type ReturnEvent struct {
+	EventID   string     `json:"event_id"`
+	EventType string     `json:"event_type"`
+	Timestamp time.Time  `json:"timestamp"`
+	Version   string     `json:"version"`
+	Data      ReturnData `json:"data"`
+}
+
+// ReturnData describes a return in its events. Once the return is received, Items hold the
+// accepted quantities and RefundAmount what is to be paid back.
+type ReturnData struct {
+	ReturnID     int64             `json:"return_id"`
+	OrderID      int64             `json:"order_id"`
+	UserID       int64             `json:"user_id"`
+	Status       string            `json:"status"`
+	Items        []*ReturnItemData `json:"items"`
+	RefundAmount money.Money       `json:"refund_amount"`
+	StaffNote    string            `json:"staff_note"`
+}
+
+type ReturnItemData struct {
+	Sku      string `json:"sku"`
+	Quantity int32  `json:"quantity"`
+	Reason   string `json:"reason"`
+	Restock  bool   `json:"restock"`
+}
+
+// Inspection is what staff found when a returned item arrived.
+type Inspection struct {
+	ItemID           int64
+	AcceptedQuantity int64
+	Restock          bool
+}
+
+// RequestReturn opens a return for lines of one of the user's delivered orders.
+func (s *Service) RequestReturn(ctx context.Context, userID, orderID int64, items []*model.ReturnItem) (*model.Return, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: at least one item is required", ErrInvalidReturn)
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		item.Sku = strings.TrimSpace(item.Sku)
+		item.Reason = strings.TrimSpace(item.Reason)
+
+		switch {
+		case item.Sku == "":
+			return nil, fmt.Errorf("%w: sku is required", ErrInvalidReturn)
+		case seen[item.Sku]:
+			return nil, fmt.Errorf("%w: sku %s is listed twice", ErrInvalidReturn, item.Sku)
+		case item.Quantity <= 0:
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidReturn)
+		case item.Reason == "":
+			return nil, fmt.Errorf("%w: a reason is required", ErrInvalidReturn)
+		case len(item.Reason) > maxReturnReasonLength:
+			return nil, fmt.Errorf("%w: reason is longer than %d characters", ErrInvalidReturn, maxReturnReasonLength)
+		case len(item.PhotoRefs) > maxReturnPhotos:
+			return nil, fmt.Errorf("%w: at most %d photos per item", ErrInvalidReturn, maxReturnPhotos)
+		}
+		for _, ref := range item.PhotoRefs {
+			if ref == "" || len(ref) > maxPhotoRefLength {
+				return nil, fmt.Errorf("%w: invalid photo reference", ErrInvalidReturn)
+			}
+		}
+		if item.PhotoRefs == nil {
+			item.PhotoRefs = []string{}
+		}
+
+		seen[item.Sku] = true
+	}
+
+	ret := &model.Return{
+		OrderID:   orderID,
+		UserID:    userID,
+		Status:    model.ReturnRequested,
+		Items:     items,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repo.CreateReturn(ctx, ret); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return nil, ErrOrderNotFound
+		case errors.Is(err, repository.ErrOrderNotReturnable):
+			return nil, ErrOrderNotReturnable
+		case errors.Is(err, repository.ErrReturnItemNotInOrder):
+			return nil, fmt.Errorf("%w: %s", ErrInvalidReturn, err)
+		case errors.Is(err, repository.ErrReturnQuantityExceeded):
+			return nil, ErrReturnQuantityExceeded
+		}
+		return nil, err
+	}
+
+	if err := s.sendReturnEvent(ctx, "returns.requested", ret); err != nil {
+		return nil, ErrSendingEvent
+	}
+
+	return ret, nil
+}
+
+// GetReturn returns a return to the customer who requested it, or to staff.
+func (s *Service) GetReturn(ctx context.Context, userID int64, staff bool, id int64) (*model.Return, error) {
+	ret, err := s.repo.GetReturn(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrReturnNotFound) {
+			return nil, ErrReturnNotFound
+		}
+		return nil, err
+	}
+
+	if !staff && ret.UserID != userID {
+		return nil, ErrReturnNotFound
+	}
+
+	return ret, nil
+}
+
+func (s *Service) GetOrderReturns(ctx context.Context, userID, orderID int64) ([]*model.Return, error) {
+	if _, err := s.GetOrder(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetOrderReturns(ctx, orderID)
+}
+
+// ReviewReturn approves or rejects a requested return.
+func (s *Service) ReviewReturn(ctx context.Context, id int64, approve bool, note string) (*model.Return, error) {
+	note = strings.TrimSpace(note)
+	if len(note) > maxStaffNoteLength {
+		return nil, fmt.Errorf("%w: note is longer than %d characters", ErrInvalidReturn, maxStaffNoteLength)
+	}
+
+	status, eventType := model.ReturnRejected, "returns.rejected"
+	if approve {
+		status, eventType = model.ReturnApproved, "returns.approved"
+	}
+
+	err := s.repo.UpdateReturnStatus(ctx, id, model.ReturnRequested, status, note, time.Now())
+	if err != nil {
+		return nil, s.returnUpdateError(ctx, id, err)
+	}
+
+	ret, err := s.repo.GetReturn(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sendReturnEvent(ctx, eventType, ret); err != nil {
+		return nil, ErrSendingEvent
+	}
+
+	return ret, nil
+}
+
+// ReceiveReturn records the inspection of an approved return that has arrived. Every item
+// must be inspected; the refund covers the accepted units at the share of the order total
+// paid for them, and the returns.received event restocks them and pays the refund.
+func (s *Service) ReceiveReturn(ctx context.Context, id int64, inspections []Inspection, note string) (*model.Return, error) {
+	note = strings.TrimSpace(note)
+	if len(note) > maxStaffNoteLength {
+		return nil, fmt.Errorf("%w: note is longer than %d characters", ErrInvalidReturn, maxStaffNoteLength)
+	}
+
+	ret, err := s.repo.GetReturn(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrReturnNotFound) {
+			return nil, ErrReturnNotFound
+		}
+		return nil, err
+	}
+
+	if ret.Status != model.ReturnApproved {
+		return nil, ErrReturnStatusConflict
+	}
+
+	inspectionByItem := make(map[int64]Inspection, len(inspections))
+	for _, inspection := range inspections {
+		inspectionByItem[inspection.ItemID] = inspection
+	}
+
+	var accepted money.Money
+	accepted.Currency = ret.RefundAmount.Currency
+	for _, item := range ret.Items {
+		inspection, ok := inspectionByItem[item.ID]
+		if !ok {
+			return nil, fmt.Errorf("%w: item %d was not inspected", ErrInvalidReturn, item.ID)
+		}
+		if inspection.AcceptedQuantity < 0 || inspection.AcceptedQuantity > item.Quantity {
+			return nil, fmt.Errorf("%w: accepted quantity of item %d must be between 0 and %d", ErrInvalidReturn, item.ID, item.Quantity)
+		}
+		delete(inspectionByItem, item.ID)
+
+		acceptedQuantity := inspection.AcceptedQuantity
+		item.AcceptedQuantity = &acceptedQuantity
+		item.Restock = inspection.Restock && acceptedQuantity > 0
+		accepted.Amount += item.UnitPrice.Amount * acceptedQuantity
+	}
+	if len(inspectionByItem) > 0 {
+		return nil, fmt.Errorf("%w: inspected items are not part of the return", ErrInvalidReturn)
+	}
+
+	ret.RefundAmount, err = s.refundFor(ctx, ret.OrderID, accepted)
+	if err != nil {
+		return nil, err
+	}
+	ret.StaffNote = note
+
+	if err = s.repo.ReceiveReturn(ctx, ret, time.Now()); err != nil {
+		return nil, s.returnUpdateError(ctx, id, err)
+	}
+
+	ret, err = s.repo.GetReturn(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sendReturnEvent(ctx, "returns.received", ret); err != nil {
+		return nil, ErrSendingEvent
+	}
+
+	return ret, nil
+}
+
+// MarkReturnRefunded completes a return once the payment service has refunded it.
+func (s *Service) MarkReturnRefunded(ctx context.Context, eventData ReturnData) error {
+	err := s.repo.UpdateReturnStatus(ctx, eventData.ReturnID, model.ReturnReceived, model.ReturnRefunded, "", time.Now())
+	if errors.Is(err, repository.ErrReturnStatusChanged) {
+		// Redelivered event.
+		return nil
+	}
+
+	return err
+}
+
+// refundFor prices returned goods worth value at list price. Discounts and tax are shared
+// out in proportion to the order subtotal, and the refunds of an order never exceed what
+// was paid for its goods.
+func (s *Service) refundFor(ctx context.Context, orderID int64, value money.Money) (money.Money, error) {
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	paidForGoods := order.TotalPrice.Amount - order.Shipping.Amount
+	refund := value.Amount
+	if order.Subtotal.Amount > 0 {
+		refund = (2*value.Amount*paidForGoods + order.Subtotal.Amount) / (2 * order.Subtotal.Amount)
+	}
+
+	refunded, err := s.repo.RefundedAmount(ctx, orderID, value.Currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	refund = min(refund, max(paidForGoods-refunded.Amount, 0))
+
+	return money.New(refund, value.Currency), nil
+}
+
+// returnUpdateError explains why a return couldn't be moved to its next status.
+func (s *Service) returnUpdateError(ctx context.Context, id int64, err error) error {
+	if !errors.Is(err, repository.ErrReturnStatusChanged) {
+		return err
+	}
+
+	if _, err = s.repo.GetReturn(ctx, id); errors.Is(err, repository.ErrReturnNotFound) {
+		return ErrReturnNotFound
+	}
+
+	return ErrReturnStatusConflict
+}
+
+func (s *Service) sendReturnEvent(ctx context.Context, eventType string, ret *model.Return) error {
+	items := make([]*ReturnItemData, len(ret.Items))
+	for i, item := range ret.Items {
+		quantity := item.Quantity
+		if item.AcceptedQuantity != nil {
+			quantity = *item.AcceptedQuantity
+		}
+
+		items[i] = &ReturnItemData{
+			Sku:      item.Sku,
+			Quantity: int32(quantity),
+			Reason:   item.Reason,
+			Restock:  item.Restock,
+		}
+	}
+
+	event := ReturnEvent{
+		EventID:   uuid.NewString(),
+		EventType: eventType,
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data: ReturnData{
+			ReturnID:     ret.ID,
+			OrderID:      ret.OrderID,
+			UserID:       ret.UserID,
+			Status:       string(ret.Status),
+			Items:        items,
+			RefundAmount: ret.RefundAmount,
+			StaffNote:    ret.StaffNote,
+		},
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Topic: eventType,
+		Key:   []byte(strconv.Itoa(int(ret.OrderID))),
+		Value: eventBytes,
+	}
+
+	err = s.returnsWriter.WriteMessages(ctx, msg)
+
+	return err
+}
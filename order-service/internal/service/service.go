@@ -2,30 +2,54 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 	"html/template"
 	"log"
+	"order-service/internal/checkout"
+	"order-service/internal/fulfillment"
+	"order-service/internal/invoice"
 	"order-service/internal/model"
 	"order-service/internal/repository"
 	pb "order-service/protobuf"
+	"shared/blob"
+	"shared/money"
 	"strconv"
+	"strings"
 	"time"
 )
 
 var (
 	ErrEmptyCart       = errors.New("cart is empty")
+	ErrStaleCart       = errors.New("cart is out of date with the catalog")
 	ErrGetCart         = errors.New("failed to get cart")
 	ErrMissingUserID   = errors.New("missing user id in context")
 	ErrMissingMetadata = errors.New("missing metadata in context")
 	ErrSendingEvent    = errors.New("error sending event")
 	ErrUserNotFound    = errors.New("user not found")
+
+	ErrInvalidCoupon     = errors.New("invalid coupon")
+	ErrCouponExists      = errors.New("coupon already exists")
+	ErrCouponNotFound    = errors.New("coupon not found")
+	ErrCouponUnavailable = errors.New("coupon is no longer available")
+
+	ErrCheckoutSessionNotFound = errors.New("checkout session not found")
+	ErrCheckoutSessionClosed   = errors.New("checkout session has expired or was already used")
+
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrOrderNotCancellable = errors.New("order can no longer be cancelled")
+	ErrInvalidReason       = errors.New("cancellation reason is too long")
+
+	ErrInvalidOrderQuery = errors.New("invalid order query")
+)
+
+const (
+	defaultOrderPageSize = 20
+	maxOrderPageSize     = 100
 )
 
 type OrderCreatedEvent struct {
@@ -46,119 +70,173 @@ type OrderData struct {
 	PaymentIntentID   *string          `json:"payment_intent_id"`
 	UserID            int64            `json:"user_id"`
 	Items             []*OrderItemData `json:"items"`
-	Amount            float64          `json:"amount"`
+	Amount            money.Money      `json:"amount"`
+	Subtotal          money.Money      `json:"subtotal"`
+	Discount          money.Money      `json:"discount"`
+	Shipping          money.Money      `json:"shipping"`
+	Tax               money.Money      `json:"tax"`
+	Currency          string           `json:"currency"`
 	ShippingAddress   string           `json:"shipping_address"`
+	ShippingMethod    string           `json:"shipping_method"`
 	EstimatedDelivery time.Time        `json:"estimated_delivery"`
+	CheckoutSessionID string           `json:"checkout_session_id"`
+	// StoredPaymentMethodID is the saved card a subscription order is charged to.
+	StoredPaymentMethodID string `json:"stored_payment_method_id,omitempty"`
+	// FailureReason is only set on payment.failed events.
+	FailureReason string `json:"failure_reason,omitempty"`
+	// CancellationReason is only set on orders.cancelled events.
+	CancellationReason string `json:"cancellation_reason,omitempty"`
+	// Invoice is only set on orders.confirmed events, unless issuing it failed.
+	Invoice *InvoiceData `json:"invoice,omitempty"`
 }
 
 type OrderItemData struct {
 	Quantity       int32        `json:"quantity"`
-	Price          float64      `json:"price"`
+	Price          money.Money  `json:"price"`
 	Sku            string       `json:"sku"`
 	Name           string       `json:"name"`
 	ImageURL       template.URL `json:"image_url"`
-	ItemTotalPrice float64      `json:"item_total_price"`
+	ItemTotalPrice money.Money  `json:"item_total_price"`
 }
 
 type Service struct {
 	repo                 *repository.Repository
+	checkout             *checkout.Calculator
 	cartClient           pb.ShoppingCartServiceClient
 	userClient           pb.UserServiceClient
+	productClient        pb.ProductCatalogServiceClient
 	orderCreatedWriter   *kafka.Writer
 	orderConfirmedWriter *kafka.Writer
+
+	sessionTTL            time.Duration
+	checkoutExpiredWriter *kafka.Writer
+	orderCancelledWriter  *kafka.Writer
+	// returnsWriter has no topic of its own; each return event names its topic.
+	returnsWriter *kafka.Writer
+
+	carriers fulfillment.Carriers
+	// shipmentsWriter has no topic of its own either.
+	shipmentsWriter *kafka.Writer
+
+	invoices *invoice.Renderer
+	blobs    blob.Store
+
+	subscriptions            SubscriptionPolicy
+	subscriptionFailedWriter *kafka.Writer
 }
 
-func New(repo *repository.Repository, cartClient pb.ShoppingCartServiceClient, userClient pb.UserServiceClient, orderCreatedWriter, orderConfirmedWriter *kafka.Writer) *Service {
+func New(repo *repository.Repository, calculator *checkout.Calculator, sessionTTL time.Duration, cartClient pb.ShoppingCartServiceClient, userClient pb.UserServiceClient, productClient pb.ProductCatalogServiceClient, orderCreatedWriter, orderConfirmedWriter, checkoutExpiredWriter, orderCancelledWriter, returnsWriter *kafka.Writer, carriers fulfillment.Carriers, shipmentsWriter *kafka.Writer, invoices *invoice.Renderer, blobs blob.Store, subscriptions SubscriptionPolicy, subscriptionFailedWriter *kafka.Writer) *Service {
 	return &Service{
-		repo:                 repo,
-		cartClient:           cartClient,
-		userClient:           userClient,
-		orderCreatedWriter:   orderCreatedWriter,
-		orderConfirmedWriter: orderConfirmedWriter,
+		repo:                     repo,
+		checkout:                 calculator,
+		sessionTTL:               sessionTTL,
+		checkoutExpiredWriter:    checkoutExpiredWriter,
+		cartClient:               cartClient,
+		userClient:               userClient,
+		productClient:            productClient,
+		orderCreatedWriter:       orderCreatedWriter,
+		orderConfirmedWriter:     orderConfirmedWriter,
+		orderCancelledWriter:     orderCancelledWriter,
+		returnsWriter:            returnsWriter,
+		carriers:                 carriers,
+		shipmentsWriter:          shipmentsWriter,
+		invoices:                 invoices,
+		blobs:                    blobs,
+		subscriptions:            subscriptions,
+		subscriptionFailedWriter: subscriptionFailedWriter,
 	}
 }
 
-func (s *Service) CreateOrderSaga(ctx context.Context, userID int64, shippingAddress, paymentMethod string, paymentIntentID *string) (int64, string, error) {
-	mt, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, "", ErrMissingMetadata
+// CreateOrderSaga places the order frozen in a checkout session. The cart isn't read
+// again, so the order records exactly the lines and amounts the payment was made for.
+func (s *Service) CreateOrderSaga(ctx context.Context, userID int64, sessionID, paymentMethod string, paymentIntentID *string) (int64, string, error) {
+	outgoingCtx, err := outgoingAuth(ctx)
+	if err != nil {
+		return 0, "", err
 	}
 
-	token := mt.Get("Authorization")[0]
-	md := metadata.Pairs("Authorization", token)
-	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
-
-	getCartResp, err := s.cartClient.GetCart(outgoingCtx, &pb.GetCartRequest{})
+	session, err := s.GetCheckoutSession(ctx, userID, sessionID)
 	if err != nil {
-		if status.Code(err) == codes.FailedPrecondition {
-			return 0, "", ErrMissingUserID
-		}
-		return 0, "", ErrGetCart
+		return 0, "", err
 	}
 
-	itemsLen := len(getCartResp.GetItems())
-
-	if itemsLen == 0 {
-		return 0, "", ErrEmptyCart
+	if session.Status != model.SessionOpen {
+		return 0, "", ErrCheckoutSessionClosed
 	}
 
-	items := make([]*model.OrderItem, itemsLen)
-	for i, item := range getCartResp.GetItems() {
+	items := make([]*model.OrderItem, len(session.Lines))
+	for i, line := range session.Lines {
 		items[i] = &model.OrderItem{
-			Quantity: int64(item.GetQuantity()),
-			Price:    item.GetPrice(),
-			Sku:      item.GetSku(),
+			Quantity:  int64(line.Quantity),
+			Price:     line.Price,
+			Sku:       line.Sku,
+			ProductID: line.ProductID,
+			Name:      line.Name,
+			ImageURL:  line.ImageURL,
 		}
 	}
 
 	log.Println("Creating order..")
 
-	amount := getCartResp.GetTotalPrice()
+	amount := session.Total
 
+	// The session total takes the coupon discount into account; the order records which
+	// coupon it was and redeems it, and completes the session, in the same transaction.
 	order := &model.Order{
-		UserID:          userID,
-		Status:          model.Pending,
-		Items:           items,
-		TotalPrice:      amount,
-		ShippingAddress: shippingAddress,
-		CreatedAt:       time.Now(),
+		UserID:            userID,
+		Status:            model.Pending,
+		Items:             items,
+		TotalPrice:        amount,
+		ShippingAddress:   session.Address.String(),
+		CreatedAt:         time.Now(),
+		CouponCode:        session.CouponCode,
+		Discount:          session.Discount,
+		Subtotal:          session.Subtotal,
+		Shipping:          session.Shipping,
+		Tax:               session.Tax,
+		ShippingMethod:    session.ShippingMethod,
+		Address:           &session.Address,
+		CheckoutSessionID: session.ID,
+		PaymentMethod:     paymentMethod,
 	}
 
 	orderID, err := s.repo.CreateOrder(ctx, order)
 	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCouponUnavailable):
+			return 0, "", ErrCouponUnavailable
+		case errors.Is(err, repository.ErrCheckoutSessionUnavailable):
+			return 0, "", ErrCheckoutSessionClosed
+		}
 		return 0, "", err
 	}
 	order.ID = orderID
 
+	// The order is placed and the session consumed at this point, so clearing the cart or
+	// reading the profile must not fail the call: a retry would find the session closed and
+	// the order would never be announced.
 	_, err = s.cartClient.ClearCart(outgoingCtx, &pb.ClearCartRequest{})
 	if err != nil {
-		if status.Code(err) == codes.FailedPrecondition {
-			return 0, "", ErrMissingUserID
-		}
-		return 0, "", err
+		log.Printf("Error clearing cart after order %d: %s", order.ID, err)
 	}
 
 	var dataItems []*OrderItemData
-	for _, item := range getCartResp.GetItems() {
+	for _, line := range session.Lines {
 		dataItems = append(dataItems, &OrderItemData{
-			Quantity:       item.Quantity,
-			Price:          item.Price,
-			Sku:            item.Sku,
-			Name:           item.Name,
-			ImageURL:       template.URL(item.ImageUrl),
-			ItemTotalPrice: float64(item.Quantity) * item.Price,
+			Quantity:       line.Quantity,
+			Price:          line.Price,
+			Sku:            line.Sku,
+			Name:           line.Name,
+			ImageURL:       template.URL(line.ImageURL),
+			ItemTotalPrice: line.Price.Mul(int64(line.Quantity)),
 		})
 	}
 
+	// Without the profile the order is still announced, only the confirmation email can't
+	// be addressed.
 	userResp, err := s.userClient.GetProfile(outgoingCtx, &pb.GetUserRequest{})
 	if err != nil {
-		switch {
-		case status.Code(err) == codes.FailedPrecondition:
-			return 0, "", ErrMissingUserID
-		case status.Code(err) == codes.NotFound:
-			return 0, "", ErrUserNotFound
-		}
-		return 0, "", err
+		log.Printf("Error getting profile of user %d for order %d: %s", userID, order.ID, err)
 	}
 
 	orderData := OrderData{
@@ -172,8 +250,15 @@ func (s *Service) CreateOrderSaga(ctx context.Context, userID int64, shippingAdd
 		UserID:            userID,
 		Items:             dataItems,
 		Amount:            amount,
-		ShippingAddress:   shippingAddress,
-		EstimatedDelivery: time.Now().Add(72 * time.Hour),
+		Subtotal:          order.Subtotal,
+		Discount:          order.Discount,
+		Shipping:          order.Shipping,
+		Tax:               order.Tax,
+		Currency:          amount.Currency,
+		ShippingAddress:   order.ShippingAddress,
+		ShippingMethod:    session.ShippingMethodName,
+		EstimatedDelivery: order.CreatedAt.AddDate(0, 0, int(session.DeliveryDays)),
+		CheckoutSessionID: session.ID,
 	}
 
 	if err := s.sendOrderCreatedEvent(ctx, orderData); err != nil {
@@ -186,22 +271,103 @@ func (s *Service) CreateOrderSaga(ctx context.Context, userID int64, shippingAdd
 func (s *Service) GetOrder(ctx context.Context, userID int64, orderID int64) (*model.Order, error) {
 	order, err := s.repo.GetOrderByID(ctx, orderID)
 	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
 		return nil, err
 	}
 	if order.UserID != userID {
-		return nil, fmt.Errorf("order not found for user")
+		return nil, ErrOrderNotFound
 	}
 
 	return order, nil
 }
 
-func (s *Service) GetUserOrders(ctx context.Context, userID int64) ([]*model.Order, error) {
-	orders, err := s.repo.GetUserOrders(ctx, userID)
+// OrderPage is a page of an order listing. NextPageToken is empty on the last page, and
+// TotalCount counts the matching orders across all pages.
+type OrderPage struct {
+	Orders        []*model.Order
+	NextPageToken string
+	TotalCount    int64
+}
+
+// ListOrders returns a page of the orders matching the filter, newest first unless
+// oldestFirst. pageToken is the NextPageToken of the previous page, or empty for the first.
+func (s *Service) ListOrders(ctx context.Context, filter model.OrderFilter, oldestFirst bool, pageSize int32, pageToken string) (*OrderPage, error) {
+	switch {
+	case pageSize < 0:
+		return nil, fmt.Errorf("%w: page size can't be negative", ErrInvalidOrderQuery)
+	case pageSize == 0:
+		pageSize = defaultOrderPageSize
+	case pageSize > maxOrderPageSize:
+		pageSize = maxOrderPageSize
+	}
+
+	if err := checkOrderFilter(filter); err != nil {
+		return nil, err
+	}
+
+	var after *model.OrderCursor
+	if pageToken != "" {
+		cursor, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor
+	}
+
+	// One more than the page size tells whether there is a next page.
+	orders, total, err := s.repo.ListOrders(ctx, filter, oldestFirst, after, int(pageSize)+1)
 	if err != nil {
 		return nil, err
 	}
 
-	return orders, nil
+	page := &OrderPage{Orders: orders, TotalCount: total}
+	if len(orders) > int(pageSize) {
+		page.Orders = orders[:pageSize]
+		last := page.Orders[pageSize-1]
+		page.NextPageToken = encodePageToken(model.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
+
+func checkOrderFilter(filter model.OrderFilter) error {
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return fmt.Errorf("%w: created_after must be before created_before", ErrInvalidOrderQuery)
+	}
+
+	return nil
+}
+
+// Page tokens are opaque to clients: the creation time in microseconds and the ID of the
+// last order of the page.
+func encodePageToken(cursor model.OrderCursor) string {
+	raw := fmt.Sprintf("%d.%d", cursor.CreatedAt.UnixMicro(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (model.OrderCursor, error) {
+	invalid := fmt.Errorf("%w: invalid page token", ErrInvalidOrderQuery)
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return model.OrderCursor{}, invalid
+	}
+
+	micros, id, ok := strings.Cut(string(raw), ".")
+	createdAt, timeErr := strconv.ParseInt(micros, 10, 64)
+	orderID, idErr := strconv.ParseInt(id, 10, 64)
+	if !ok || timeErr != nil || idErr != nil {
+		return model.OrderCursor{}, invalid
+	}
+
+	return model.OrderCursor{CreatedAt: time.UnixMicro(createdAt), ID: orderID}, nil
+}
+
+// HasPurchased reports whether the user has received an order containing the SKU.
+func (s *Service) HasPurchased(ctx context.Context, userID int64, sku string) (bool, error) {
+	return s.repo.HasDeliveredOrderWithSKU(ctx, userID, sku)
 }
 
 func (s *Service) ConfirmOrder(ctx context.Context, eventData OrderData) error {
@@ -210,11 +376,36 @@ func (s *Service) ConfirmOrder(ctx context.Context, eventData OrderData) error {
 		st = model.Confirmed
 	}
 
-	err := s.repo.UpdateOrderStatus(ctx, eventData.OrderID, st)
+	err := s.repo.UpdateOrderStatus(ctx, eventData.OrderID, st, time.Now())
 	if err != nil {
 		return err
 	}
 
+	// A subscription that can't be moved on to its next delivery keeps this as its pending
+	// order, so no other is placed for it; the confirmation still goes out.
+	if err = s.completeSubscriptionOrder(ctx, eventData.OrderID); err != nil {
+		log.Printf("Error completing subscription order %d: %s", eventData.OrderID, err)
+	}
+
+	order, err := s.repo.GetOrderByID(ctx, eventData.OrderID)
+	if err != nil {
+		return err
+	}
+
+	// The confirmation goes out without the invoice if it can't be issued; GetInvoice
+	// issues it later.
+	customerName := eventData.CustomerFirstName + " " + eventData.CustomerLastName
+	inv, pdf, err := s.issueInvoice(ctx, order, customerName, eventData.CustomerEmail)
+	if err != nil {
+		log.Printf("Error issuing invoice for order %d: %s", order.ID, err)
+	} else {
+		eventData.Invoice = &InvoiceData{
+			Number:   inv.Number,
+			FileName: inv.Number + ".pdf",
+			PDF:      pdf,
+		}
+	}
+
 	if err = s.sendOrderConfirmedEvent(ctx, eventData); err != nil {
 		return ErrSendingEvent
 	}
@@ -222,13 +413,20 @@ func (s *Service) ConfirmOrder(ctx context.Context, eventData OrderData) error {
 	return nil
 }
 
-func (s *Service) CancelOrder(ctx context.Context, eventData OrderData) error {
-	err := s.repo.UpdateOrderStatus(ctx, eventData.OrderID, model.Cancelled)
+// CompensateOrder cancels an order whose payment or stock reservation failed during the
+// order saga. A subscription order is retried later.
+func (s *Service) CompensateOrder(ctx context.Context, eventData OrderData) error {
+	err := s.repo.UpdateOrderStatus(ctx, eventData.OrderID, model.Cancelled, time.Now())
 	if err != nil {
 		return err
 	}
 
-	return nil
+	reason := eventData.FailureReason
+	if reason == "" {
+		reason = "the payment failed"
+	}
+
+	return s.failSubscriptionOrder(ctx, 0, eventData.OrderID, reason)
 }
 
 func (s *Service) sendOrderConfirmedEvent(ctx context.Context, eventData OrderData) error {
@@ -278,3 +476,7 @@ func (s *Service) sendOrderCreatedEvent(ctx context.Context, eventData OrderData
 
 	return err
 }
+
+func moneyFromPB(m *pb.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"html/template"
+	"log"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	pb "order-service/protobuf"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const trackingBatch = 100
+
+var (
+	ErrInvalidShipment          = errors.New("invalid shipment")
+	ErrOrderNotShippable        = errors.New("order can't be shipped in its current status")
+	ErrShipmentQuantityExceeded = errors.New("shipment quantity exceeds what is left to ship")
+)
+
+type ShipmentEvent struct {
+	EventID   string       `json:"event_id"`
+	EventType string       `json:"event_type"`
+	Timestamp time.Time    `json:"timestamp"`
+	Version   string       `json:"version"`
+	Data      ShipmentData `json:"data"`
+}
+
+// ShipmentData describes a shipment in its events. OrderStatus is the status of the whole
+// order once the event happened, e.g. DELIVERED when this was the last parcel to arrive.
+type ShipmentData struct {
+	ShipmentID        int64               `json:"shipment_id"`
+	OrderID           int64               `json:"order_id"`
+	UserID            int64               `json:"user_id"`
+	CustomerFirstName string              `json:"customer_first_name"`
+	CustomerLastName  string              `json:"customer_last_name"`
+	CustomerEmail     string              `json:"customer_email"`
+	Carrier           string              `json:"carrier"`
+	TrackingNumber    string              `json:"tracking_number"`
+	Status            string              `json:"status"`
+	OrderStatus       string              `json:"order_status"`
+	Items             []*ShipmentItemData `json:"items"`
+	ShippingAddress   string              `json:"shipping_address"`
+	EstimatedDelivery time.Time           `json:"estimated_delivery"`
+	ShippedAt         time.Time           `json:"shipped_at"`
+	DeliveredAt       *time.Time          `json:"delivered_at"`
+}
+
+type ShipmentItemData struct {
+	Sku      string       `json:"sku"`
+	Name     string       `json:"name"`
+	ImageURL template.URL `json:"image_url"`
+	Quantity int64        `json:"quantity"`
+}
+
+// CreateShipment books a parcel with the carrier for lines of a paid or confirmed order and
+// records it. The order becomes PARTIALLY_SHIPPED or SHIPPED depending on what is left.
+func (s *Service) CreateShipment(ctx context.Context, orderID int64, carrierName string, items []*model.ShipmentItem) (*model.Shipment, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: at least one item is required", ErrInvalidShipment)
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		item.Sku = strings.TrimSpace(item.Sku)
+
+		switch {
+		case item.Sku == "":
+			return nil, fmt.Errorf("%w: sku is required", ErrInvalidShipment)
+		case seen[item.Sku]:
+			return nil, fmt.Errorf("%w: sku %s is listed twice", ErrInvalidShipment, item.Sku)
+		case item.Quantity <= 0:
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidShipment)
+		}
+
+		seen[item.Sku] = true
+	}
+
+	carrier, err := s.carriers.Get(strings.TrimSpace(carrierName))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown carrier %q", ErrInvalidShipment, carrierName)
+	}
+
+	// Check what is left to ship before a label is paid for; it's checked again when the
+	// shipment is stored.
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	if !order.Status.Shippable() {
+		return nil, ErrOrderNotShippable
+	}
+
+	shipments, err := s.repo.GetOrderShipments(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	unshipped := make(map[string]int64)
+	for _, item := range order.Items {
+		unshipped[item.Sku] += item.Quantity
+	}
+	for _, shipment := range shipments {
+		for _, item := range shipment.Items {
+			unshipped[item.Sku] -= item.Quantity
+		}
+	}
+
+	for _, item := range items {
+		left, ok := unshipped[item.Sku]
+		if !ok {
+			return nil, fmt.Errorf("%w: sku %s is not part of the order", ErrInvalidShipment, item.Sku)
+		}
+		if item.Quantity > left {
+			return nil, ErrShipmentQuantityExceeded
+		}
+	}
+
+	label, err := carrier.CreateShipment(ctx, order, items)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment := &model.Shipment{
+		OrderID:           orderID,
+		Carrier:           carrier.Name(),
+		TrackingNumber:    label.TrackingNumber,
+		Status:            model.ShipmentLabelCreated,
+		Items:             items,
+		EstimatedDelivery: label.EstimatedDelivery,
+		CreatedAt:         time.Now(),
+	}
+
+	orderStatus, err := s.repo.CreateShipment(ctx, shipment)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return nil, ErrOrderNotFound
+		case errors.Is(err, repository.ErrOrderNotShippable):
+			return nil, ErrOrderNotShippable
+		case errors.Is(err, repository.ErrShipmentItemNotInOrder):
+			return nil, fmt.Errorf("%w: %s", ErrInvalidShipment, err)
+		case errors.Is(err, repository.ErrShipmentQuantityExceeded):
+			return nil, ErrShipmentQuantityExceeded
+		}
+		return nil, err
+	}
+
+	if err = s.sendShipmentEvent(ctx, "shipments.created", order, shipment, orderStatus); err != nil {
+		return nil, ErrSendingEvent
+	}
+
+	return shipment, nil
+}
+
+// GetOrderShipments returns the shipments of one of the user's orders, or of any order to
+// staff.
+func (s *Service) GetOrderShipments(ctx context.Context, userID int64, staff bool, orderID int64) ([]*model.Shipment, error) {
+	if staff {
+		if _, err := s.repo.GetOrderByID(ctx, orderID); err != nil {
+			if errors.Is(err, repository.ErrOrderNotFound) {
+				return nil, ErrOrderNotFound
+			}
+			return nil, err
+		}
+	} else if _, err := s.GetOrder(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetOrderShipments(ctx, orderID)
+}
+
+// RunTrackingSync polls the carriers for updates on undelivered shipments every interval
+// until ctx is cancelled.
+func (s *Service) RunTrackingSync(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.SyncShipmentTracking(ctx); err != nil {
+				log.Printf("Error syncing shipment tracking: %s", err)
+			}
+		}
+	}
+}
+
+// SyncShipmentTracking records the latest tracking events of every undelivered shipment,
+// and emits shipments.delivered for those that arrived.
+func (s *Service) SyncShipmentTracking(ctx context.Context) error {
+	started := time.Now()
+
+	for {
+		shipments, err := s.repo.GetShipmentsToTrack(ctx, started, trackingBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, shipment := range shipments {
+			if err = s.trackShipment(ctx, shipment); err != nil {
+				log.Printf("Error tracking shipment %d: %s", shipment.ID, err)
+
+				// Leave it for the next sync rather than retrying it in this one.
+				if err = s.repo.MarkTracked(ctx, shipment.ID, time.Now()); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(shipments) < trackingBatch {
+			return nil
+		}
+	}
+}
+
+func (s *Service) trackShipment(ctx context.Context, shipment *model.Shipment) error {
+	carrier, err := s.carriers.Get(shipment.Carrier)
+	if err != nil {
+		return err
+	}
+
+	events, err := carrier.Track(ctx, shipment.TrackingNumber)
+	if err != nil {
+		return err
+	}
+
+	delivered, orderStatus, err := s.repo.RecordTracking(ctx, shipment.ID, events, time.Now())
+	if err != nil || !delivered {
+		return err
+	}
+
+	order, err := s.repo.GetOrderByID(ctx, shipment.OrderID)
+	if err != nil {
+		return err
+	}
+
+	shipment, err = s.repo.GetShipment(ctx, shipment.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.sendShipmentEvent(ctx, "shipments.delivered", order, shipment, orderStatus)
+}
+
+func (s *Service) sendShipmentEvent(ctx context.Context, eventType string, order *model.Order, shipment *model.Shipment, orderStatus model.Status) error {
+	// The customer is looked up by ID: the caller is staff or the tracking sync.
+	user, err := s.userClient.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: order.UserID})
+	if err != nil {
+		return err
+	}
+
+	lines, err := s.repo.GetOrderLines(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+
+	lineBySku := make(map[string]model.CheckoutLine, len(lines))
+	for _, line := range lines {
+		lineBySku[line.Sku] = line
+	}
+
+	items := make([]*ShipmentItemData, len(shipment.Items))
+	for i, item := range shipment.Items {
+		line := lineBySku[item.Sku]
+		items[i] = &ShipmentItemData{
+			Sku:      item.Sku,
+			Name:     line.Name,
+			ImageURL: template.URL(line.ImageURL),
+			Quantity: item.Quantity,
+		}
+	}
+
+	event := ShipmentEvent{
+		EventID:   uuid.NewString(),
+		EventType: eventType,
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data: ShipmentData{
+			ShipmentID:        shipment.ID,
+			OrderID:           order.ID,
+			UserID:            order.UserID,
+			CustomerFirstName: user.GetFirstName(),
+			CustomerLastName:  user.GetLastName(),
+			CustomerEmail:     user.GetEmail(),
+			Carrier:           shipment.Carrier,
+			TrackingNumber:    shipment.TrackingNumber,
+			Status:            string(shipment.Status),
+			OrderStatus:       string(orderStatus),
+			Items:             items,
+			ShippingAddress:   order.ShippingAddress,
+			EstimatedDelivery: shipment.EstimatedDelivery,
+			ShippedAt:         shipment.CreatedAt,
+			DeliveredAt:       shipment.DeliveredAt,
+		},
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Topic: eventType,
+		Key:   []byte(strconv.Itoa(int(order.ID))),
+		Value: eventBytes,
+	}
+
+	err = s.shipmentsWriter.WriteMessages(ctx, msg)
+
+	return err
+}
@@ -0,0 +1,569 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"html/template"
+	"log"
+	"order-service/internal/checkout"
+	"order-service/internal/model"
+	"order-service/internal/repository"
+	pb "order-service/protobuf"
+	"shared/money"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxSubscriptionItems     = 50
+	maxSubscriptionInterval  = 365
+	maxPaymentMethodIDLength = 255
+	subscriptionBatch        = 100
+	// subscriptionClaim is how long the scheduler has to place a subscription's order before
+	// another run may try again.
+	subscriptionClaim = 10 * time.Minute
+)
+
+var (
+	ErrInvalidSubscription        = errors.New("invalid subscription")
+	ErrSubscriptionNotFound       = errors.New("subscription not found")
+	ErrSubscriptionStatusConflict = errors.New("subscription is not in a status that allows this")
+	ErrSubscriptionOrderPending   = errors.New("the order of the next delivery was already placed")
+	ErrProductUnavailable         = errors.New("product is no longer available")
+	ErrOutOfStock                 = errors.New("not enough stock")
+)
+
+// SubscriptionPolicy is how a subscription order that fails, for lack of stock or because
+// the payment was declined, is retried. After MaxAttempts failed attempts the delivery is
+// skipped.
+type SubscriptionPolicy struct {
+	RetryDelay  time.Duration
+	MaxAttempts int32
+}
+
+type SubscriptionEvent struct {
+	EventID   string           `json:"event_id"`
+	EventType string           `json:"event_type"`
+	Timestamp time.Time        `json:"timestamp"`
+	Version   string           `json:"version"`
+	Data      SubscriptionData `json:"data"`
+}
+
+// SubscriptionData tells the customer why the order of a delivery failed. RetryAt is when
+// it's tried again; it's nil once the delivery was skipped, and NextOrderAt is then the
+// next delivery's.
+type SubscriptionData struct {
+	SubscriptionID    int64                   `json:"subscription_id"`
+	UserID            int64                   `json:"user_id"`
+	CustomerFirstName string                  `json:"customer_first_name"`
+	CustomerLastName  string                  `json:"customer_last_name"`
+	CustomerEmail     string                  `json:"customer_email"`
+	Items             []*SubscriptionItemData `json:"items"`
+	Reason            string                  `json:"reason"`
+	Attempt           int32                   `json:"attempt"`
+	RetryAt           *time.Time              `json:"retry_at"`
+	NextOrderAt       time.Time               `json:"next_order_at"`
+}
+
+type SubscriptionItemData struct {
+	Sku      string `json:"sku"`
+	Name     string `json:"name"`
+	Quantity int32  `json:"quantity"`
+}
+
+// CreateSubscription subscribes the user to the items, ordered every IntervalDays from
+// firstOrderAt, or from now if it's zero or past. The products must be on sale, and the
+// shipping method available for the address. A card must be one the user saved with the
+// payment service; a card that isn't theirs fails the first payment.
+func (s *Service) CreateSubscription(ctx context.Context, userID int64, sub *model.Subscription, firstOrderAt time.Time) (*model.Subscription, error) {
+	sub.Address = sub.Address.Normalize()
+	sub.StoredPaymentMethodID = strings.TrimSpace(sub.StoredPaymentMethodID)
+	if err := validateSubscription(sub); err != nil {
+		return nil, err
+	}
+
+	lines, subtotal, err := s.subscriptionLines(ctx, sub.Items, false)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.checkout.Quote(ctx, sub.Address, sub.ShippingMethod, checkout.Cart{
+		Subtotal: subtotal,
+		Items:    unitCount(lines),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, line := range lines {
+		sub.Items[i].Name = line.Name
+	}
+
+	now := time.Now()
+	if firstOrderAt.Before(now) {
+		firstOrderAt = now
+	}
+
+	sub.UserID = userID
+	sub.Status = model.SubscriptionActive
+	sub.ShippingMethod = quote.Method.Method
+	sub.NextOrderAt = firstOrderAt
+	sub.CreatedAt = now
+
+	if err = s.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+func validateSubscription(sub *model.Subscription) error {
+	switch {
+	case len(sub.Items) == 0:
+		return fmt.Errorf("%w: at least one item is required", ErrInvalidSubscription)
+	case len(sub.Items) > maxSubscriptionItems:
+		return fmt.Errorf("%w: at most %d items are allowed", ErrInvalidSubscription, maxSubscriptionItems)
+	case sub.IntervalDays < 1 || sub.IntervalDays > maxSubscriptionInterval:
+		return fmt.Errorf("%w: interval must be between 1 and %d days", ErrInvalidSubscription, maxSubscriptionInterval)
+	}
+
+	seen := make(map[string]bool, len(sub.Items))
+	for _, item := range sub.Items {
+		item.Sku = strings.TrimSpace(item.Sku)
+		switch {
+		case item.Sku == "":
+			return fmt.Errorf("%w: sku is required", ErrInvalidSubscription)
+		case item.Quantity <= 0:
+			return fmt.Errorf("%w: quantity of %s must be positive", ErrInvalidSubscription, item.Sku)
+		case seen[item.Sku]:
+			return fmt.Errorf("%w: %s is listed twice", ErrInvalidSubscription, item.Sku)
+		}
+		seen[item.Sku] = true
+	}
+
+	switch sub.PaymentMethod {
+	case "CARD":
+		if sub.StoredPaymentMethodID == "" || len(sub.StoredPaymentMethodID) > maxPaymentMethodIDLength {
+			return fmt.Errorf("%w: a saved card is required to pay by card", ErrInvalidSubscription)
+		}
+	case "ON_DELIVERY":
+		if sub.StoredPaymentMethodID != "" {
+			return fmt.Errorf("%w: a saved card can't be used to pay on delivery", ErrInvalidSubscription)
+		}
+	default:
+		return fmt.Errorf("%w: invalid payment method", ErrInvalidSubscription)
+	}
+
+	return sub.Address.Validate()
+}
+
+// subscriptionLines prices the items at today's catalog prices, failing with
+// ErrProductUnavailable for a product no longer on sale, and with ErrOutOfStock if
+// checkStock and there isn't enough of one.
+func (s *Service) subscriptionLines(ctx context.Context, items []*model.SubscriptionItem, checkStock bool) ([]*model.OrderItem, money.Money, error) {
+	var subtotal money.Money
+	lines := make([]*model.OrderItem, len(items))
+	for i, item := range items {
+		name := item.Name
+		if name == "" {
+			name = item.Sku
+		}
+
+		resp, err := s.productClient.GetProductBySKU(ctx, &pb.GetProductBySKURequest{Sku: item.Sku})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, money.Money{}, fmt.Errorf("%w: %s", ErrProductUnavailable, name)
+			}
+			return nil, money.Money{}, err
+		}
+
+		product := resp.GetProduct()
+		if !product.GetIsActive() {
+			return nil, money.Money{}, fmt.Errorf("%w: %s", ErrProductUnavailable, name)
+		}
+		if checkStock && product.GetStockQuantity() < item.Quantity {
+			return nil, money.Money{}, fmt.Errorf("%w: %s", ErrOutOfStock, name)
+		}
+
+		price := moneyFromPB(product.GetPrice())
+		lines[i] = &model.OrderItem{
+			Quantity:  int64(item.Quantity),
+			Price:     price,
+			Sku:       item.Sku,
+			ProductID: product.GetId(),
+			Name:      product.GetName(),
+			ImageURL:  product.GetImageUrl(),
+		}
+
+		if i == 0 {
+			subtotal = money.New(0, price.Currency)
+		}
+		if subtotal, err = subtotal.Add(price.Mul(int64(item.Quantity))); err != nil {
+			return nil, money.Money{}, err
+		}
+	}
+
+	return lines, subtotal, nil
+}
+
+// unitCount is the number of units the lines add up to, as shipping is charged per unit.
+func unitCount(lines []*model.OrderItem) int64 {
+	var units int64
+	for _, line := range lines {
+		units += line.Quantity
+	}
+
+	return units
+}
+
+func (s *Service) GetSubscription(ctx context.Context, userID, id int64) (*model.Subscription, error) {
+	sub, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrSubscriptionNotFound) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, err
+	}
+	if sub.UserID != userID {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return sub, nil
+}
+
+func (s *Service) ListSubscriptions(ctx context.Context, userID int64) ([]*model.Subscription, error) {
+	return s.repo.GetUserSubscriptions(ctx, userID)
+}
+
+// PauseSubscription stops placing orders for an active subscription. A failed order waiting
+// to be retried is given up.
+func (s *Service) PauseSubscription(ctx context.Context, userID, id int64) (*model.Subscription, error) {
+	return s.updateSubscription(ctx, userID, id, func(sub *model.Subscription, now time.Time) error {
+		if sub.Status != model.SubscriptionActive {
+			return ErrSubscriptionStatusConflict
+		}
+
+		sub.Status = model.SubscriptionPaused
+		sub.RetryAt = nil
+		sub.FailedAttempts = 0
+		return nil
+	})
+}
+
+// ResumeSubscription reactivates a paused subscription. A delivery that came due while it
+// was paused is ordered right away.
+func (s *Service) ResumeSubscription(ctx context.Context, userID, id int64) (*model.Subscription, error) {
+	return s.updateSubscription(ctx, userID, id, func(sub *model.Subscription, now time.Time) error {
+		if sub.Status != model.SubscriptionPaused {
+			return ErrSubscriptionStatusConflict
+		}
+
+		sub.Status = model.SubscriptionActive
+		if sub.NextOrderAt.Before(now) {
+			sub.NextOrderAt = now
+		}
+		return nil
+	})
+}
+
+// SkipSubscriptionDelivery moves the next order of an active or paused subscription one
+// interval later. It can't skip a delivery whose order was already placed.
+func (s *Service) SkipSubscriptionDelivery(ctx context.Context, userID, id int64) (*model.Subscription, error) {
+	return s.updateSubscription(ctx, userID, id, func(sub *model.Subscription, now time.Time) error {
+		switch {
+		case sub.Status == model.SubscriptionCancelled:
+			return ErrSubscriptionStatusConflict
+		case sub.PendingOrderID != nil:
+			return ErrSubscriptionOrderPending
+		}
+
+		sub.AdvanceDelivery(now)
+		return nil
+	})
+}
+
+func (s *Service) CancelSubscription(ctx context.Context, userID, id int64) (*model.Subscription, error) {
+	return s.updateSubscription(ctx, userID, id, func(sub *model.Subscription, now time.Time) error {
+		if sub.Status == model.SubscriptionCancelled {
+			return ErrSubscriptionStatusConflict
+		}
+
+		sub.Status = model.SubscriptionCancelled
+		sub.RetryAt = nil
+		sub.CancelledAt = &now
+		return nil
+	})
+}
+
+func (s *Service) updateSubscription(ctx context.Context, userID, id int64, update func(*model.Subscription, time.Time) error) (*model.Subscription, error) {
+	now := time.Now()
+	sub, err := s.repo.UpdateSubscription(ctx, id, now, func(sub *model.Subscription) error {
+		if sub.UserID != userID {
+			return ErrSubscriptionNotFound
+		}
+		return update(sub, now)
+	})
+	if errors.Is(err, repository.ErrSubscriptionNotFound) {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return sub, err
+}
+
+// RunSubscriptions places the orders of due subscriptions every interval until ctx is
+// cancelled.
+func (s *Service) RunSubscriptions(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.PlaceSubscriptionOrders(ctx); err != nil {
+				log.Printf("Error placing subscription orders: %s", err)
+			}
+		}
+	}
+}
+
+// PlaceSubscriptionOrders places an order for every active subscription with a delivery, or
+// a retry, due. A subscription whose order couldn't be placed for a reason other than the
+// products or the shipping method is tried again once its claim ends.
+func (s *Service) PlaceSubscriptionOrders(ctx context.Context) error {
+	for {
+		now := time.Now()
+		subs, err := s.repo.ClaimDueSubscriptions(ctx, now, now.Add(subscriptionClaim), subscriptionBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, sub := range subs {
+			if err = s.placeSubscriptionOrder(ctx, sub); err != nil {
+				log.Printf("Error placing order for subscription %d: %s", sub.ID, err)
+			}
+		}
+
+		if len(subs) < subscriptionBatch {
+			return nil
+		}
+	}
+}
+
+// placeSubscriptionOrder places the order of the subscription's due delivery at today's
+// prices and starts the order saga for it, which charges the saved card, if any, and
+// reserves the stock.
+func (s *Service) placeSubscriptionOrder(ctx context.Context, sub *model.Subscription) error {
+	user, err := s.userClient.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: sub.UserID})
+	if err != nil {
+		return err
+	}
+
+	lines, subtotal, err := s.subscriptionLines(ctx, sub.Items, true)
+	var quote *checkout.Quote
+	if err == nil {
+		quote, err = s.checkout.Quote(ctx, sub.Address, sub.ShippingMethod, checkout.Cart{
+			Subtotal: subtotal,
+			Items:    unitCount(lines),
+		})
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrProductUnavailable), errors.Is(err, ErrOutOfStock):
+			return s.failSubscriptionOrder(ctx, sub.ID, 0, err.Error())
+		case errors.Is(err, checkout.ErrNoShippingMethods), errors.Is(err, checkout.ErrShippingMethodUnavailable):
+			return s.failSubscriptionOrder(ctx, sub.ID, 0, "the shipping method is no longer available for the address")
+		}
+		return err
+	}
+
+	address := sub.Address
+	order := &model.Order{
+		UserID:          sub.UserID,
+		Status:          model.Pending,
+		Items:           lines,
+		TotalPrice:      quote.Total,
+		ShippingAddress: address.String(),
+		CreatedAt:       time.Now(),
+		Discount:        quote.Discount,
+		Subtotal:        quote.Subtotal,
+		Shipping:        quote.Shipping,
+		Tax:             quote.Tax,
+		ShippingMethod:  quote.Method.Method,
+		Address:         &address,
+		PaymentMethod:   sub.PaymentMethod,
+		SubscriptionID:  sub.ID,
+	}
+
+	order.ID, err = s.repo.CreateOrder(ctx, order)
+	if err != nil {
+		// Paused or cancelled since it was claimed.
+		if errors.Is(err, repository.ErrSubscriptionUnavailable) {
+			return nil
+		}
+		return err
+	}
+
+	dataItems := make([]*OrderItemData, len(lines))
+	for i, line := range lines {
+		dataItems[i] = &OrderItemData{
+			Quantity:       int32(line.Quantity),
+			Price:          line.Price,
+			Sku:            line.Sku,
+			Name:           line.Name,
+			ImageURL:       template.URL(line.ImageURL),
+			ItemTotalPrice: line.Price.Mul(line.Quantity),
+		}
+	}
+
+	orderData := OrderData{
+		CustomerFirstName:     user.GetFirstName(),
+		CustomerLastName:      user.GetLastName(),
+		CustomerEmail:         user.GetEmail(),
+		OrderID:               order.ID,
+		OrderDate:             order.CreatedAt,
+		PaymentMethod:         order.PaymentMethod,
+		StoredPaymentMethodID: sub.StoredPaymentMethodID,
+		UserID:                order.UserID,
+		Items:                 dataItems,
+		Amount:                order.TotalPrice,
+		Subtotal:              order.Subtotal,
+		Discount:              order.Discount,
+		Shipping:              order.Shipping,
+		Tax:                   order.Tax,
+		Currency:              order.TotalPrice.Currency,
+		ShippingAddress:       order.ShippingAddress,
+		ShippingMethod:        quote.Method.Name,
+		EstimatedDelivery:     order.CreatedAt.AddDate(0, 0, int(quote.Method.MaxDays)),
+	}
+
+	if err = s.sendOrderCreatedEvent(ctx, orderData); err != nil {
+		return ErrSendingEvent
+	}
+
+	return nil
+}
+
+// completeSubscriptionOrder moves the subscription that placed a confirmed order on to its
+// next delivery. Orders placed at checkout have no subscription to update.
+func (s *Service) completeSubscriptionOrder(ctx context.Context, orderID int64) error {
+	now := time.Now()
+	_, err := s.repo.UpdatePendingSubscription(ctx, orderID, now, func(sub *model.Subscription) error {
+		sub.PendingOrderID = nil
+		sub.LastFailure = ""
+		sub.AdvanceDelivery(now)
+		return nil
+	})
+	if errors.Is(err, repository.ErrSubscriptionNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// failSubscriptionOrder records a failed attempt at the order of a subscription's due
+// delivery and tells the customer. The attempt is either the pending order orderID, whose
+// payment or stock reservation failed, or, when orderID is 0, an order of subscription id
+// that couldn't be placed. The order is retried after the policy's delay, and the delivery
+// skipped once MaxAttempts attempts failed. Nothing is counted for a subscription that was
+// paused or cancelled in the meantime.
+func (s *Service) failSubscriptionOrder(ctx context.Context, id, orderID int64, reason string) error {
+	now := time.Now()
+	counted := false
+	var attempt int32
+	record := func(sub *model.Subscription) error {
+		sub.PendingOrderID = nil
+		if sub.Status != model.SubscriptionActive {
+			return nil
+		}
+
+		counted = true
+		attempt = sub.FailedAttempts + 1
+		sub.LastFailure = reason
+		if attempt >= s.subscriptions.MaxAttempts {
+			sub.AdvanceDelivery(now)
+			return nil
+		}
+
+		retryAt := now.Add(s.subscriptions.RetryDelay)
+		sub.RetryAt = &retryAt
+		sub.FailedAttempts = attempt
+		return nil
+	}
+
+	var sub *model.Subscription
+	var err error
+	if orderID != 0 {
+		sub, err = s.repo.UpdatePendingSubscription(ctx, orderID, now, record)
+		if errors.Is(err, repository.ErrSubscriptionNotFound) {
+			return nil
+		}
+	} else {
+		sub, err = s.repo.UpdateSubscription(ctx, id, now, record)
+	}
+	if err != nil || !counted {
+		return err
+	}
+
+	user, err := s.userClient.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: sub.UserID})
+	if err != nil {
+		return err
+	}
+
+	items := make([]*SubscriptionItemData, len(sub.Items))
+	for i, item := range sub.Items {
+		items[i] = &SubscriptionItemData{
+			Sku:      item.Sku,
+			Name:     item.Name,
+			Quantity: item.Quantity,
+		}
+	}
+
+	err = s.sendSubscriptionFailedEvent(ctx, SubscriptionData{
+		SubscriptionID:    sub.ID,
+		UserID:            sub.UserID,
+		CustomerFirstName: user.GetFirstName(),
+		CustomerLastName:  user.GetLastName(),
+		CustomerEmail:     user.GetEmail(),
+		Items:             items,
+		Reason:            reason,
+		Attempt:           attempt,
+		RetryAt:           sub.RetryAt,
+		NextOrderAt:       sub.NextOrderAt,
+	})
+	if err != nil {
+		return ErrSendingEvent
+	}
+
+	return nil
+}
+
+func (s *Service) sendSubscriptionFailedEvent(ctx context.Context, eventData SubscriptionData) error {
+	event := SubscriptionEvent{
+		EventID:   uuid.NewString(),
+		EventType: "subscriptions.order_failed",
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data:      eventData,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Key:   []byte(strconv.Itoa(int(eventData.SubscriptionID))),
+		Value: eventBytes,
+	}
+
+	return s.subscriptionFailedWriter.WriteMessages(ctx, msg)
+}
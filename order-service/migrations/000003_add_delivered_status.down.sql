@@ -0,0 +1,2 @@
+-- Postgres cannot drop a value from an enum type; move delivered orders back to CONFIRMED instead.
+UPDATE orders SET status = 'CONFIRMED' WHERE status = 'DELIVERED';
@@ -0,0 +1,34 @@
+CREATE TYPE coupon_type AS ENUM ('PERCENTAGE', 'FIXED_AMOUNT', 'BUY_X_GET_Y', 'FREE_SHIPPING');
+
+CREATE TABLE IF NOT EXISTS coupons (
+    code VARCHAR(64) PRIMARY KEY,
+    type coupon_type NOT NULL,
+    percent_off INT NOT NULL DEFAULT 0,
+    amount_off NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    min_basket NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    buy_sku VARCHAR(255),
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    usage_limit INT NOT NULL DEFAULT 0,
+    per_user_limit INT NOT NULL DEFAULT 0,
+    times_redeemed INT NOT NULL DEFAULT 0,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS coupon_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    coupon_code VARCHAR(64) NOT NULL REFERENCES coupons(code),
+    user_id BIGINT NOT NULL,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    discount NUMERIC(10, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    redeemed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_code_user ON coupon_redemptions (coupon_code, user_id);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS coupon_code VARCHAR(64);
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS discount NUMERIC(10, 2) NOT NULL DEFAULT 0;
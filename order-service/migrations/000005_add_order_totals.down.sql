@@ -0,0 +1,5 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS address;
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping_method;
+ALTER TABLE orders DROP COLUMN IF EXISTS tax;
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping;
+ALTER TABLE orders DROP COLUMN IF EXISTS subtotal;
@@ -0,0 +1,8 @@
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS subtotal NUMERIC(10, 2) NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping NUMERIC(10, 2) NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax NUMERIC(10, 2) NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_method VARCHAR(64);
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS address JSONB;
+
+-- Orders placed before shipping and tax were charged paid the discounted cart total.
+UPDATE orders SET subtotal = total_price + discount WHERE subtotal = 0;
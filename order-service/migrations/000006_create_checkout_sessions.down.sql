@@ -0,0 +1,4 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS checkout_session_id;
+
+DROP TABLE IF EXISTS checkout_sessions;
+DROP TYPE IF EXISTS checkout_session_status;
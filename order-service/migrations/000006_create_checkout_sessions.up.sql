@@ -0,0 +1,26 @@
+CREATE TYPE checkout_session_status AS ENUM ('OPEN', 'COMPLETED', 'EXPIRED');
+
+CREATE TABLE IF NOT EXISTS checkout_sessions (
+    id UUID PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    status checkout_session_status NOT NULL DEFAULT 'OPEN',
+    lines JSONB NOT NULL,
+    address JSONB NOT NULL,
+    shipping_method VARCHAR(64) NOT NULL,
+    shipping_method_name VARCHAR(255) NOT NULL,
+    delivery_days INT NOT NULL DEFAULT 0,
+    coupon_code VARCHAR(64),
+    subtotal NUMERIC(10, 2) NOT NULL,
+    discount NUMERIC(10, 2) NOT NULL,
+    shipping NUMERIC(10, 2) NOT NULL,
+    tax NUMERIC(10, 2) NOT NULL,
+    total NUMERIC(10, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    order_id BIGINT REFERENCES orders(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checkout_sessions_open_expiry ON checkout_sessions (expires_at) WHERE status = 'OPEN';
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS checkout_session_id UUID;
@@ -0,0 +1,27 @@
+CREATE TYPE return_status AS ENUM ('REQUESTED', 'APPROVED', 'REJECTED', 'RECEIVED', 'REFUNDED');
+
+CREATE TABLE IF NOT EXISTS returns (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id),
+    user_id BIGINT NOT NULL,
+    status return_status NOT NULL DEFAULT 'REQUESTED',
+    staff_note TEXT,
+    refund_amount NUMERIC(10, 2),
+    currency VARCHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_returns_order ON returns (order_id);
+
+CREATE TABLE IF NOT EXISTS return_items (
+    id BIGSERIAL PRIMARY KEY,
+    return_id BIGINT NOT NULL REFERENCES returns(id) ON DELETE CASCADE,
+    sku VARCHAR(255) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(10, 2) NOT NULL,
+    reason TEXT NOT NULL,
+    photo_refs JSONB NOT NULL DEFAULT '[]',
+    accepted_quantity INT,
+    restock BOOLEAN NOT NULL DEFAULT FALSE
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS shipment_events;
+DROP TABLE IF EXISTS shipment_items;
+DROP TABLE IF EXISTS shipments;
+DROP TYPE IF EXISTS shipment_status;
+
+-- Postgres cannot drop a value from an enum type; move shipped orders back to CONFIRMED instead.
+UPDATE orders SET status = 'CONFIRMED' WHERE status IN ('PARTIALLY_SHIPPED', 'SHIPPED');
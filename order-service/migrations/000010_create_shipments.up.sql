@@ -0,0 +1,38 @@
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'PARTIALLY_SHIPPED';
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'SHIPPED';
+
+CREATE TYPE shipment_status AS ENUM ('LABEL_CREATED', 'IN_TRANSIT', 'OUT_FOR_DELIVERY', 'DELIVERED', 'EXCEPTION');
+
+CREATE TABLE IF NOT EXISTS shipments (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id),
+    carrier VARCHAR(50) NOT NULL,
+    tracking_number VARCHAR(100) NOT NULL,
+    status shipment_status NOT NULL DEFAULT 'LABEL_CREATED',
+    estimated_delivery TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,
+    last_tracked_at TIMESTAMPTZ,
+    UNIQUE (carrier, tracking_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shipments_order ON shipments (order_id);
+CREATE INDEX IF NOT EXISTS idx_shipments_in_flight ON shipments (last_tracked_at) WHERE delivered_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS shipment_items (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id BIGINT NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    sku VARCHAR(255) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0)
+);
+
+CREATE TABLE IF NOT EXISTS shipment_events (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id BIGINT NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    status shipment_status NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    location TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (shipment_id, status, occurred_at)
+);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS order_status_history;
+
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS product_id,
+    DROP COLUMN IF EXISTS name,
+    DROP COLUMN IF EXISTS image_url;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS payment_method;
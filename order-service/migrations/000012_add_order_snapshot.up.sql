@@ -0,0 +1,30 @@
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS payment_method VARCHAR(20);
+
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS product_id VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS name TEXT,
+    ADD COLUMN IF NOT EXISTS image_url TEXT;
+
+-- Orders placed from a checkout session have the product name and image in its lines.
+UPDATE order_items oi
+SET name = line->>'name', image_url = line->>'image_url'
+FROM checkout_sessions cs, jsonb_array_elements(cs.lines) AS line
+WHERE cs.order_id = oi.order_id AND line->>'sku' = oi.sku;
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    status order_status NOT NULL,
+    reason TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order ON order_status_history (order_id, changed_at);
+
+-- Changes before this migration weren't recorded: every order starts PENDING, then has
+-- its current status, dated when it was cancelled if it was.
+INSERT INTO order_status_history (order_id, status, changed_at)
+SELECT id, 'PENDING', created_at FROM orders;
+
+INSERT INTO order_status_history (order_id, status, reason, changed_at)
+SELECT id, status, cancellation_reason, COALESCE(cancelled_at, created_at) FROM orders WHERE status <> 'PENDING';
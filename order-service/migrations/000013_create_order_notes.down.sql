@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_order_items_sku;
+
+DROP TABLE IF EXISTS order_notes;
+
+ALTER TABLE order_status_history DROP COLUMN IF EXISTS changed_by;
@@ -0,0 +1,14 @@
+ALTER TABLE order_status_history ADD COLUMN IF NOT EXISTS changed_by BIGINT;
+
+CREATE TABLE IF NOT EXISTS order_notes (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    author_id BIGINT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_notes_order ON order_notes (order_id, created_at);
+
+-- Staff search orders by SKU.
+CREATE INDEX IF NOT EXISTS idx_order_items_sku ON order_items (sku);
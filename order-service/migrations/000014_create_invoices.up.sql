@@ -0,0 +1,14 @@
+-- Invoice numbers run without gaps within a year, so they come from a counter updated in
+-- the transaction that issues the invoice rather than from a sequence.
+CREATE TABLE IF NOT EXISTS invoice_counters (
+    year INT PRIMARY KEY,
+    last_number BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    number VARCHAR(32) NOT NULL UNIQUE,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    blob_key TEXT NOT NULL
+);
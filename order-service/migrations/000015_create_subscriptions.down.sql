@@ -0,0 +1,3 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS subscription_id;
+DROP TABLE IF EXISTS subscriptions;
+DROP TYPE IF EXISTS subscription_status;
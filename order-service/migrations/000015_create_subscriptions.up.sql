@@ -0,0 +1,31 @@
+CREATE TYPE subscription_status AS ENUM ('ACTIVE', 'PAUSED', 'CANCELLED');
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    status subscription_status NOT NULL DEFAULT 'ACTIVE',
+    items JSONB NOT NULL,
+    interval_days INT NOT NULL CHECK (interval_days > 0),
+    address JSONB NOT NULL,
+    shipping_method VARCHAR(64) NOT NULL,
+    payment_method VARCHAR(20) NOT NULL,
+    stored_payment_method_id VARCHAR(255),
+    next_order_at TIMESTAMPTZ NOT NULL,
+    retry_at TIMESTAMPTZ,
+    failed_attempts INT NOT NULL DEFAULT 0,
+    last_failure TEXT,
+    pending_order_id BIGINT REFERENCES orders(id),
+    -- Set while the scheduler is placing an order, so other instances leave the
+    -- subscription alone until then.
+    claimed_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    cancelled_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscriptions_user ON subscriptions (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_subscriptions_due ON subscriptions (COALESCE(retry_at, next_order_at))
+    WHERE status = 'ACTIVE' AND pending_order_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_subscriptions_pending_order ON subscriptions (pending_order_id);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS subscription_id BIGINT REFERENCES subscriptions(id);
@@ -0,0 +1,2 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS cancellation_event_pending BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: admin.proto
+
+package protobuf
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminOrderService_SearchOrders_FullMethodName        = "/order.AdminOrderService/SearchOrders"
+	AdminOrderService_ExportOrders_FullMethodName        = "/order.AdminOrderService/ExportOrders"
+	AdminOrderService_GetOrder_FullMethodName            = "/order.AdminOrderService/GetOrder"
+	AdminOrderService_OverrideOrderStatus_FullMethodName = "/order.AdminOrderService/OverrideOrderStatus"
+	AdminOrderService_AddOrderNote_FullMethodName        = "/order.AdminOrderService/AddOrderNote"
+)
+
+// AdminOrderServiceClient is the client API for AdminOrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminOrderService is the back office API over every customer's orders. All of its
+// methods require a token with the staff role.
+type AdminOrderServiceClient interface {
+	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	// ExportOrders returns every order matching the search as a CSV file, up to 10000 orders.
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	GetOrder(ctx context.Context, in *AdminGetOrderRequest, opts ...grpc.CallOption) (*AdminOrder, error)
+	// OverrideOrderStatus sets the status of an order regardless of its current one. It
+	// doesn't refund, restock or notify the customer.
+	OverrideOrderStatus(ctx context.Context, in *OverrideOrderStatusRequest, opts ...grpc.CallOption) (*AdminOrder, error)
+	AddOrderNote(ctx context.Context, in *AddOrderNoteRequest, opts ...grpc.CallOption) (*OrderNote, error)
+}
+
+type adminOrderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminOrderServiceClient(cc grpc.ClientConnInterface) AdminOrderServiceClient {
+	return &adminOrderServiceClient{cc}
+}
+
+func (c *adminOrderServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, AdminOrderService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminOrderServiceClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, AdminOrderService_ExportOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminOrderServiceClient) GetOrder(ctx context.Context, in *AdminGetOrderRequest, opts ...grpc.CallOption) (*AdminOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminOrder)
+	err := c.cc.Invoke(ctx, AdminOrderService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminOrderServiceClient) OverrideOrderStatus(ctx context.Context, in *OverrideOrderStatusRequest, opts ...grpc.CallOption) (*AdminOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminOrder)
+	err := c.cc.Invoke(ctx, AdminOrderService_OverrideOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminOrderServiceClient) AddOrderNote(ctx context.Context, in *AddOrderNoteRequest, opts ...grpc.CallOption) (*OrderNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderNote)
+	err := c.cc.Invoke(ctx, AdminOrderService_AddOrderNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminOrderServiceServer is the server API for AdminOrderService service.
+// All implementations must embed UnimplementedAdminOrderServiceServer
+// for forward compatibility.
+//
+// AdminOrderService is the back office API over every customer's orders. All of its
+// methods require a token with the staff role.
+type AdminOrderServiceServer interface {
+	SearchOrders(context.Context, *SearchOrdersRequest) (*ListOrdersResponse, error)
+	// ExportOrders returns every order matching the search as a CSV file, up to 10000 orders.
+	ExportOrders(context.Context, *ExportOrdersRequest) (*httpbody.HttpBody, error)
+	GetOrder(context.Context, *AdminGetOrderRequest) (*AdminOrder, error)
+	// OverrideOrderStatus sets the status of an order regardless of its current one. It
+	// doesn't refund, restock or notify the customer.
+	OverrideOrderStatus(context.Context, *OverrideOrderStatusRequest) (*AdminOrder, error)
+	AddOrderNote(context.Context, *AddOrderNoteRequest) (*OrderNote, error)
+	mustEmbedUnimplementedAdminOrderServiceServer()
+}
+
+// UnimplementedAdminOrderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminOrderServiceServer struct{}
+
+func (UnimplementedAdminOrderServiceServer) SearchOrders(context.Context, *SearchOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
+func (UnimplementedAdminOrderServiceServer) ExportOrders(context.Context, *ExportOrdersRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
+func (UnimplementedAdminOrderServiceServer) GetOrder(context.Context, *AdminGetOrderRequest) (*AdminOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedAdminOrderServiceServer) OverrideOrderStatus(context.Context, *OverrideOrderStatusRequest) (*AdminOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideOrderStatus not implemented")
+}
+func (UnimplementedAdminOrderServiceServer) AddOrderNote(context.Context, *AddOrderNoteRequest) (*OrderNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrderNote not implemented")
+}
+func (UnimplementedAdminOrderServiceServer) mustEmbedUnimplementedAdminOrderServiceServer() {}
+func (UnimplementedAdminOrderServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAdminOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminOrderServiceServer will
+// result in compilation errors.
+type UnsafeAdminOrderServiceServer interface {
+	mustEmbedUnimplementedAdminOrderServiceServer()
+}
+
+func RegisterAdminOrderServiceServer(s grpc.ServiceRegistrar, srv AdminOrderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminOrderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminOrderService_ServiceDesc, srv)
+}
+
+func _AdminOrderService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminOrderServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminOrderService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminOrderServiceServer).SearchOrders(ctx, req.(*SearchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminOrderService_ExportOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminOrderServiceServer).ExportOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminOrderService_ExportOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminOrderServiceServer).ExportOrders(ctx, req.(*ExportOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminOrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminOrderServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminOrderService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminOrderServiceServer).GetOrder(ctx, req.(*AdminGetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminOrderService_OverrideOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminOrderServiceServer).OverrideOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminOrderService_OverrideOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminOrderServiceServer).OverrideOrderStatus(ctx, req.(*OverrideOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminOrderService_AddOrderNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrderNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminOrderServiceServer).AddOrderNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminOrderService_AddOrderNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminOrderServiceServer).AddOrderNote(ctx, req.(*AddOrderNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminOrderService_ServiceDesc is the grpc.ServiceDesc for AdminOrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminOrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order.AdminOrderService",
+	HandlerType: (*AdminOrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchOrders",
+			Handler:    _AdminOrderService_SearchOrders_Handler,
+		},
+		{
+			MethodName: "ExportOrders",
+			Handler:    _AdminOrderService_ExportOrders_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _AdminOrderService_GetOrder_Handler,
+		},
+		{
+			MethodName: "OverrideOrderStatus",
+			Handler:    _AdminOrderService_OverrideOrderStatus_Handler,
+		},
+		{
+			MethodName: "AddOrderNote",
+			Handler:    _AdminOrderService_AddOrderNote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
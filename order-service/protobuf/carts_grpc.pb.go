@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShoppingCartService_GetCart_FullMethodName    = "/cart.ShoppingCartService/GetCart"
-	ShoppingCartService_AddItem_FullMethodName    = "/cart.ShoppingCartService/AddItem"
-	ShoppingCartService_UpdateItem_FullMethodName = "/cart.ShoppingCartService/UpdateItem"
-	ShoppingCartService_RemoveItem_FullMethodName = "/cart.ShoppingCartService/RemoveItem"
-	ShoppingCartService_ClearCart_FullMethodName  = "/cart.ShoppingCartService/ClearCart"
+	ShoppingCartService_GetCart_FullMethodName                = "/cart.ShoppingCartService/GetCart"
+	ShoppingCartService_AddItem_FullMethodName                = "/cart.ShoppingCartService/AddItem"
+	ShoppingCartService_UpdateItem_FullMethodName             = "/cart.ShoppingCartService/UpdateItem"
+	ShoppingCartService_RemoveItem_FullMethodName             = "/cart.ShoppingCartService/RemoveItem"
+	ShoppingCartService_SetCartCurrency_FullMethodName        = "/cart.ShoppingCartService/SetCartCurrency"
+	ShoppingCartService_ClearCart_FullMethodName              = "/cart.ShoppingCartService/ClearCart"
+	ShoppingCartService_ApplyCoupon_FullMethodName            = "/cart.ShoppingCartService/ApplyCoupon"
+	ShoppingCartService_RemoveCoupon_FullMethodName           = "/cart.ShoppingCartService/RemoveCoupon"
+	ShoppingCartService_ValidateCart_FullMethodName           = "/cart.ShoppingCartService/ValidateCart"
+	ShoppingCartService_CreateGuestCart_FullMethodName        = "/cart.ShoppingCartService/CreateGuestCart"
+	ShoppingCartService_MergeCart_FullMethodName              = "/cart.ShoppingCartService/MergeCart"
+	ShoppingCartService_SaveForLater_FullMethodName           = "/cart.ShoppingCartService/SaveForLater"
+	ShoppingCartService_CreateWishlist_FullMethodName         = "/cart.ShoppingCartService/CreateWishlist"
+	ShoppingCartService_ListWishlists_FullMethodName          = "/cart.ShoppingCartService/ListWishlists"
+	ShoppingCartService_GetWishlist_FullMethodName            = "/cart.ShoppingCartService/GetWishlist"
+	ShoppingCartService_DeleteWishlist_FullMethodName         = "/cart.ShoppingCartService/DeleteWishlist"
+	ShoppingCartService_AddWishlistItem_FullMethodName        = "/cart.ShoppingCartService/AddWishlistItem"
+	ShoppingCartService_RemoveWishlistItem_FullMethodName     = "/cart.ShoppingCartService/RemoveWishlistItem"
+	ShoppingCartService_MoveWishlistItemToCart_FullMethodName = "/cart.ShoppingCartService/MoveWishlistItemToCart"
 )
 
 // ShoppingCartServiceClient is the client API for ShoppingCartService service.
@@ -34,7 +48,21 @@ type ShoppingCartServiceClient interface {
 	AddItem(ctx context.Context, in *AddItemRequest, opts ...grpc.CallOption) (*AddItemResponse, error)
 	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
 	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*RemoveItemResponse, error)
+	SetCartCurrency(ctx context.Context, in *SetCartCurrencyRequest, opts ...grpc.CallOption) (*SetCartCurrencyResponse, error)
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
+	ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*ApplyCouponResponse, error)
+	RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*RemoveCouponResponse, error)
+	ValidateCart(ctx context.Context, in *ValidateCartRequest, opts ...grpc.CallOption) (*ValidateCartResponse, error)
+	CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error)
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error)
+	SaveForLater(ctx context.Context, in *SaveForLaterRequest, opts ...grpc.CallOption) (*SaveForLaterResponse, error)
+	CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*CreateWishlistResponse, error)
+	ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error)
+	GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error)
+	DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error)
+	AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*AddWishlistItemResponse, error)
+	RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*RemoveWishlistItemResponse, error)
+	MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*MoveWishlistItemToCartResponse, error)
 }
 
 type shoppingCartServiceClient struct {
@@ -85,6 +113,16 @@ func (c *shoppingCartServiceClient) RemoveItem(ctx context.Context, in *RemoveIt
 	return out, nil
 }
 
+func (c *shoppingCartServiceClient) SetCartCurrency(ctx context.Context, in *SetCartCurrencyRequest, opts ...grpc.CallOption) (*SetCartCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCartCurrencyResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_SetCartCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shoppingCartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ClearCartResponse)
@@ -95,6 +133,136 @@ func (c *shoppingCartServiceClient) ClearCart(ctx context.Context, in *ClearCart
 	return out, nil
 }
 
+func (c *shoppingCartServiceClient) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*ApplyCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyCouponResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_ApplyCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*RemoveCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCouponResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_RemoveCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) ValidateCart(ctx context.Context, in *ValidateCartRequest, opts ...grpc.CallOption) (*ValidateCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCartResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_ValidateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGuestCartResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_CreateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCartResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) SaveForLater(ctx context.Context, in *SaveForLaterRequest, opts ...grpc.CallOption) (*SaveForLaterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveForLaterResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_SaveForLater_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*CreateWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWishlistResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_CreateWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistsResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_ListWishlists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWishlistResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_GetWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWishlistResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_DeleteWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*AddWishlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWishlistItemResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_AddWishlistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*RemoveWishlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWishlistItemResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_RemoveWishlistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingCartServiceClient) MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*MoveWishlistItemToCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveWishlistItemToCartResponse)
+	err := c.cc.Invoke(ctx, ShoppingCartService_MoveWishlistItemToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShoppingCartServiceServer is the server API for ShoppingCartService service.
 // All implementations must embed UnimplementedShoppingCartServiceServer
 // for forward compatibility.
@@ -103,7 +271,21 @@ type ShoppingCartServiceServer interface {
 	AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error)
 	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
 	RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error)
+	SetCartCurrency(context.Context, *SetCartCurrencyRequest) (*SetCartCurrencyResponse, error)
 	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
+	ApplyCoupon(context.Context, *ApplyCouponRequest) (*ApplyCouponResponse, error)
+	RemoveCoupon(context.Context, *RemoveCouponRequest) (*RemoveCouponResponse, error)
+	ValidateCart(context.Context, *ValidateCartRequest) (*ValidateCartResponse, error)
+	CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error)
+	MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error)
+	SaveForLater(context.Context, *SaveForLaterRequest) (*SaveForLaterResponse, error)
+	CreateWishlist(context.Context, *CreateWishlistRequest) (*CreateWishlistResponse, error)
+	ListWishlists(context.Context, *ListWishlistsRequest) (*ListWishlistsResponse, error)
+	GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error)
+	DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error)
+	AddWishlistItem(context.Context, *AddWishlistItemRequest) (*AddWishlistItemResponse, error)
+	RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*RemoveWishlistItemResponse, error)
+	MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*MoveWishlistItemToCartResponse, error)
 	mustEmbedUnimplementedShoppingCartServiceServer()
 }
 
@@ -126,9 +308,51 @@ func (UnimplementedShoppingCartServiceServer) UpdateItem(context.Context, *Updat
 func (UnimplementedShoppingCartServiceServer) RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveItem not implemented")
 }
+func (UnimplementedShoppingCartServiceServer) SetCartCurrency(context.Context, *SetCartCurrencyRequest) (*SetCartCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCartCurrency not implemented")
+}
 func (UnimplementedShoppingCartServiceServer) ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
 }
+func (UnimplementedShoppingCartServiceServer) ApplyCoupon(context.Context, *ApplyCouponRequest) (*ApplyCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCoupon not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) RemoveCoupon(context.Context, *RemoveCouponRequest) (*RemoveCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoupon not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) ValidateCart(context.Context, *ValidateCartRequest) (*ValidateCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCart not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCart not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) SaveForLater(context.Context, *SaveForLaterRequest) (*SaveForLaterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveForLater not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) CreateWishlist(context.Context, *CreateWishlistRequest) (*CreateWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWishlist not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) ListWishlists(context.Context, *ListWishlistsRequest) (*ListWishlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlists not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlist not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWishlist not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) AddWishlistItem(context.Context, *AddWishlistItemRequest) (*AddWishlistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWishlistItem not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*RemoveWishlistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlistItem not implemented")
+}
+func (UnimplementedShoppingCartServiceServer) MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*MoveWishlistItemToCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveWishlistItemToCart not implemented")
+}
 func (UnimplementedShoppingCartServiceServer) mustEmbedUnimplementedShoppingCartServiceServer() {}
 func (UnimplementedShoppingCartServiceServer) testEmbeddedByValue()                             {}
 
@@ -222,6 +446,24 @@ func _ShoppingCartService_RemoveItem_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShoppingCartService_SetCartCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCartCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).SetCartCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_SetCartCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).SetCartCurrency(ctx, req.(*SetCartCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShoppingCartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClearCartRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +482,240 @@ func _ShoppingCartService_ClearCart_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShoppingCartService_ApplyCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).ApplyCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_ApplyCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).ApplyCoupon(ctx, req.(*ApplyCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_RemoveCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).RemoveCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_RemoveCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).RemoveCoupon(ctx, req.(*RemoveCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_ValidateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).ValidateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_ValidateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).ValidateCart(ctx, req.(*ValidateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_CreateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).CreateGuestCart(ctx, req.(*CreateGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_SaveForLater_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveForLaterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).SaveForLater(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_SaveForLater_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).SaveForLater(ctx, req.(*SaveForLaterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_CreateWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).CreateWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_CreateWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).CreateWishlist(ctx, req.(*CreateWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_ListWishlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).ListWishlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_ListWishlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).ListWishlists(ctx, req.(*ListWishlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_GetWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).GetWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_GetWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).GetWishlist(ctx, req.(*GetWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_DeleteWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).DeleteWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_DeleteWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).DeleteWishlist(ctx, req.(*DeleteWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_AddWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWishlistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).AddWishlistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_AddWishlistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).AddWishlistItem(ctx, req.(*AddWishlistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_RemoveWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWishlistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).RemoveWishlistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_RemoveWishlistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).RemoveWishlistItem(ctx, req.(*RemoveWishlistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingCartService_MoveWishlistItemToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveWishlistItemToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingCartServiceServer).MoveWishlistItemToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingCartService_MoveWishlistItemToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingCartServiceServer).MoveWishlistItemToCart(ctx, req.(*MoveWishlistItemToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShoppingCartService_ServiceDesc is the grpc.ServiceDesc for ShoppingCartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,10 +739,66 @@ var ShoppingCartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveItem",
 			Handler:    _ShoppingCartService_RemoveItem_Handler,
 		},
+		{
+			MethodName: "SetCartCurrency",
+			Handler:    _ShoppingCartService_SetCartCurrency_Handler,
+		},
 		{
 			MethodName: "ClearCart",
 			Handler:    _ShoppingCartService_ClearCart_Handler,
 		},
+		{
+			MethodName: "ApplyCoupon",
+			Handler:    _ShoppingCartService_ApplyCoupon_Handler,
+		},
+		{
+			MethodName: "RemoveCoupon",
+			Handler:    _ShoppingCartService_RemoveCoupon_Handler,
+		},
+		{
+			MethodName: "ValidateCart",
+			Handler:    _ShoppingCartService_ValidateCart_Handler,
+		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _ShoppingCartService_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _ShoppingCartService_MergeCart_Handler,
+		},
+		{
+			MethodName: "SaveForLater",
+			Handler:    _ShoppingCartService_SaveForLater_Handler,
+		},
+		{
+			MethodName: "CreateWishlist",
+			Handler:    _ShoppingCartService_CreateWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlists",
+			Handler:    _ShoppingCartService_ListWishlists_Handler,
+		},
+		{
+			MethodName: "GetWishlist",
+			Handler:    _ShoppingCartService_GetWishlist_Handler,
+		},
+		{
+			MethodName: "DeleteWishlist",
+			Handler:    _ShoppingCartService_DeleteWishlist_Handler,
+		},
+		{
+			MethodName: "AddWishlistItem",
+			Handler:    _ShoppingCartService_AddWishlistItem_Handler,
+		},
+		{
+			MethodName: "RemoveWishlistItem",
+			Handler:    _ShoppingCartService_RemoveWishlistItem_Handler,
+		},
+		{
+			MethodName: "MoveWishlistItemToCart",
+			Handler:    _ShoppingCartService_MoveWishlistItemToCart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "carts.proto",
@@ -8,8 +8,10 @@ package protobuf
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +24,324 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OrderSort int32
+
+const (
+	OrderSort_NEWEST_FIRST OrderSort = 0
+	OrderSort_OLDEST_FIRST OrderSort = 1
+)
+
+// Enum value maps for OrderSort.
+var (
+	OrderSort_name = map[int32]string{
+		0: "NEWEST_FIRST",
+		1: "OLDEST_FIRST",
+	}
+	OrderSort_value = map[string]int32{
+		"NEWEST_FIRST": 0,
+		"OLDEST_FIRST": 1,
+	}
+)
+
+func (x OrderSort) Enum() *OrderSort {
+	p := new(OrderSort)
+	*p = x
+	return p
+}
+
+func (x OrderSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderSort) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[0]
+}
+
+func (x OrderSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSort.Descriptor instead.
+func (OrderSort) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{0}
+}
+
+type ReturnStatus int32
+
+const (
+	ReturnStatus_RETURN_REQUESTED ReturnStatus = 0
+	ReturnStatus_RETURN_APPROVED  ReturnStatus = 1
+	ReturnStatus_RETURN_REJECTED  ReturnStatus = 2
+	// Received and inspected; the refund is on its way.
+	ReturnStatus_RETURN_RECEIVED ReturnStatus = 3
+	ReturnStatus_RETURN_REFUNDED ReturnStatus = 4
+)
+
+// Enum value maps for ReturnStatus.
+var (
+	ReturnStatus_name = map[int32]string{
+		0: "RETURN_REQUESTED",
+		1: "RETURN_APPROVED",
+		2: "RETURN_REJECTED",
+		3: "RETURN_RECEIVED",
+		4: "RETURN_REFUNDED",
+	}
+	ReturnStatus_value = map[string]int32{
+		"RETURN_REQUESTED": 0,
+		"RETURN_APPROVED":  1,
+		"RETURN_REJECTED":  2,
+		"RETURN_RECEIVED":  3,
+		"RETURN_REFUNDED":  4,
+	}
+)
+
+func (x ReturnStatus) Enum() *ReturnStatus {
+	p := new(ReturnStatus)
+	*p = x
+	return p
+}
+
+func (x ReturnStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReturnStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[1].Descriptor()
+}
+
+func (ReturnStatus) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[1]
+}
+
+func (x ReturnStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReturnStatus.Descriptor instead.
+func (ReturnStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{1}
+}
+
+type ShipmentStatus int32
+
+const (
+	ShipmentStatus_SHIPMENT_LABEL_CREATED    ShipmentStatus = 0
+	ShipmentStatus_SHIPMENT_IN_TRANSIT       ShipmentStatus = 1
+	ShipmentStatus_SHIPMENT_OUT_FOR_DELIVERY ShipmentStatus = 2
+	ShipmentStatus_SHIPMENT_DELIVERED        ShipmentStatus = 3
+	// The carrier reported a problem, e.g. a failed delivery attempt.
+	ShipmentStatus_SHIPMENT_EXCEPTION ShipmentStatus = 4
+)
+
+// Enum value maps for ShipmentStatus.
+var (
+	ShipmentStatus_name = map[int32]string{
+		0: "SHIPMENT_LABEL_CREATED",
+		1: "SHIPMENT_IN_TRANSIT",
+		2: "SHIPMENT_OUT_FOR_DELIVERY",
+		3: "SHIPMENT_DELIVERED",
+		4: "SHIPMENT_EXCEPTION",
+	}
+	ShipmentStatus_value = map[string]int32{
+		"SHIPMENT_LABEL_CREATED":    0,
+		"SHIPMENT_IN_TRANSIT":       1,
+		"SHIPMENT_OUT_FOR_DELIVERY": 2,
+		"SHIPMENT_DELIVERED":        3,
+		"SHIPMENT_EXCEPTION":        4,
+	}
+)
+
+func (x ShipmentStatus) Enum() *ShipmentStatus {
+	p := new(ShipmentStatus)
+	*p = x
+	return p
+}
+
+func (x ShipmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShipmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[2].Descriptor()
+}
+
+func (ShipmentStatus) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[2]
+}
+
+func (x ShipmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShipmentStatus.Descriptor instead.
+func (ShipmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{2}
+}
+
+type ReorderStatus int32
+
+const (
+	ReorderStatus_REORDER_ADDED ReorderStatus = 0
+	// Added, at a price other than the order's.
+	ReorderStatus_REORDER_PRICE_CHANGED ReorderStatus = 1
+	// Skipped: the product is no longer sold.
+	ReorderStatus_REORDER_DISCONTINUED ReorderStatus = 2
+	// Skipped: there isn't enough stock for the ordered quantity on top of what the cart has.
+	ReorderStatus_REORDER_INSUFFICIENT_STOCK ReorderStatus = 3
+	// Skipped: the cart couldn't be reached or refused the line for another reason.
+	ReorderStatus_REORDER_FAILED ReorderStatus = 4
+)
+
+// Enum value maps for ReorderStatus.
+var (
+	ReorderStatus_name = map[int32]string{
+		0: "REORDER_ADDED",
+		1: "REORDER_PRICE_CHANGED",
+		2: "REORDER_DISCONTINUED",
+		3: "REORDER_INSUFFICIENT_STOCK",
+		4: "REORDER_FAILED",
+	}
+	ReorderStatus_value = map[string]int32{
+		"REORDER_ADDED":              0,
+		"REORDER_PRICE_CHANGED":      1,
+		"REORDER_DISCONTINUED":       2,
+		"REORDER_INSUFFICIENT_STOCK": 3,
+		"REORDER_FAILED":             4,
+	}
+)
+
+func (x ReorderStatus) Enum() *ReorderStatus {
+	p := new(ReorderStatus)
+	*p = x
+	return p
+}
+
+func (x ReorderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReorderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[3].Descriptor()
+}
+
+func (ReorderStatus) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[3]
+}
+
+func (x ReorderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReorderStatus.Descriptor instead.
+func (ReorderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{3}
+}
+
+type CheckoutSessionStatus int32
+
+const (
+	CheckoutSessionStatus_SESSION_OPEN      CheckoutSessionStatus = 0
+	CheckoutSessionStatus_SESSION_COMPLETED CheckoutSessionStatus = 1
+	CheckoutSessionStatus_SESSION_EXPIRED   CheckoutSessionStatus = 2
+)
+
+// Enum value maps for CheckoutSessionStatus.
+var (
+	CheckoutSessionStatus_name = map[int32]string{
+		0: "SESSION_OPEN",
+		1: "SESSION_COMPLETED",
+		2: "SESSION_EXPIRED",
+	}
+	CheckoutSessionStatus_value = map[string]int32{
+		"SESSION_OPEN":      0,
+		"SESSION_COMPLETED": 1,
+		"SESSION_EXPIRED":   2,
+	}
+)
+
+func (x CheckoutSessionStatus) Enum() *CheckoutSessionStatus {
+	p := new(CheckoutSessionStatus)
+	*p = x
+	return p
+}
+
+func (x CheckoutSessionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckoutSessionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[4].Descriptor()
+}
+
+func (CheckoutSessionStatus) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[4]
+}
+
+func (x CheckoutSessionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckoutSessionStatus.Descriptor instead.
+func (CheckoutSessionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{4}
+}
+
+type CouponType int32
+
+const (
+	CouponType_PERCENTAGE    CouponType = 0
+	CouponType_FIXED_AMOUNT  CouponType = 1
+	CouponType_BUY_X_GET_Y   CouponType = 2
+	CouponType_FREE_SHIPPING CouponType = 3
+)
+
+// Enum value maps for CouponType.
+var (
+	CouponType_name = map[int32]string{
+		0: "PERCENTAGE",
+		1: "FIXED_AMOUNT",
+		2: "BUY_X_GET_Y",
+		3: "FREE_SHIPPING",
+	}
+	CouponType_value = map[string]int32{
+		"PERCENTAGE":    0,
+		"FIXED_AMOUNT":  1,
+		"BUY_X_GET_Y":   2,
+		"FREE_SHIPPING": 3,
+	}
+)
+
+func (x CouponType) Enum() *CouponType {
+	p := new(CouponType)
+	*p = x
+	return p
+}
+
+func (x CouponType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponType) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[5].Descriptor()
+}
+
+func (CouponType) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[5]
+}
+
+func (x CouponType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponType.Descriptor instead.
+func (CouponType) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{5}
+}
+
 type Status int32
 
 const (
@@ -29,6 +349,10 @@ const (
 	Status_PAID      Status = 1
 	Status_CONFIRMED Status = 2
 	Status_CANCELLED Status = 3
+	Status_DELIVERED Status = 4
+	Status_SHIPPED   Status = 5
+	// Some lines have been shipped, others not yet.
+	Status_PARTIALLY_SHIPPED Status = 6
 )
 
 // Enum value maps for Status.
@@ -38,12 +362,18 @@ var (
 		1: "PAID",
 		2: "CONFIRMED",
 		3: "CANCELLED",
+		4: "DELIVERED",
+		5: "SHIPPED",
+		6: "PARTIALLY_SHIPPED",
 	}
 	Status_value = map[string]int32{
-		"PENDING":   0,
-		"PAID":      1,
-		"CONFIRMED": 2,
-		"CANCELLED": 3,
+		"PENDING":           0,
+		"PAID":              1,
+		"CONFIRMED":         2,
+		"CANCELLED":         3,
+		"DELIVERED":         4,
+		"SHIPPED":           5,
+		"PARTIALLY_SHIPPED": 6,
 	}
 )
 
@@ -58,11 +388,11 @@ func (x Status) String() string {
 }
 
 func (Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_orders_proto_enumTypes[0].Descriptor()
+	return file_orders_proto_enumTypes[6].Descriptor()
 }
 
 func (Status) Type() protoreflect.EnumType {
-	return &file_orders_proto_enumTypes[0]
+	return &file_orders_proto_enumTypes[6]
 }
 
 func (x Status) Number() protoreflect.EnumNumber {
@@ -71,7 +401,7 @@ func (x Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Status.Descriptor instead.
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{0}
+	return file_orders_proto_rawDescGZIP(), []int{6}
 }
 
 type PaymentMethod int32
@@ -107,11 +437,11 @@ func (x PaymentMethod) String() string {
 }
 
 func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_orders_proto_enumTypes[1].Descriptor()
+	return file_orders_proto_enumTypes[7].Descriptor()
 }
 
 func (PaymentMethod) Type() protoreflect.EnumType {
-	return &file_orders_proto_enumTypes[1]
+	return &file_orders_proto_enumTypes[7]
 }
 
 func (x PaymentMethod) Number() protoreflect.EnumNumber {
@@ -120,14 +450,70 @@ func (x PaymentMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentMethod.Descriptor instead.
 func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{1}
+	return file_orders_proto_rawDescGZIP(), []int{7}
+}
+
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatus_SUBSCRIPTION_ACTIVE    SubscriptionStatus = 0
+	SubscriptionStatus_SUBSCRIPTION_PAUSED    SubscriptionStatus = 1
+	SubscriptionStatus_SUBSCRIPTION_CANCELLED SubscriptionStatus = 2
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_ACTIVE",
+		1: "SUBSCRIPTION_PAUSED",
+		2: "SUBSCRIPTION_CANCELLED",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_ACTIVE":    0,
+		"SUBSCRIPTION_PAUSED":    1,
+		"SUBSCRIPTION_CANCELLED": 2,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[8].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[8]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{8}
 }
 
+// OrderItem is an order line as it was when the order was placed. The product snapshot is
+// empty for orders placed before it was recorded.
 type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Quantity int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Unit price paid.
+	Price         *Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	ProductId     string `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl      string `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	LineTotal     *Money `protobuf:"bytes,8,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -139,14 +525,3174 @@ func (x *OrderItem) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *OrderItem) GetLineTotal() *Money {
+	if x != nil {
+		return x.LineTotal
+	}
+	return nil
+}
+
+type StatusChange struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status Status                 `protobuf:"varint,1,opt,name=status,proto3,enum=order.Status" json:"status,omitempty"`
+	// Set when the change was explained, e.g. the customer's cancellation reason.
+	Reason    string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// The staff member who overrode the status; only set for staff, 0 otherwise.
+	ChangedBy     int64 `protobuf:"varint,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_orders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatusChange) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+func (x *StatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *StatusChange) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+// CreateOrderRequest places the order frozen in a checkout session. Card payments must use
+// the payment intent created for the same session.
+type CreateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,2,opt,name=payment_method,json=paymentMethod,proto3,enum=order.PaymentMethod" json:"payment_method,omitempty"`
+	// Types that are valid to be assigned to PaymentInfo:
+	//
+	//	*CreateOrderRequest_PaymentIntentId
+	PaymentInfo       isCreateOrderRequest_PaymentInfo `protobuf_oneof:"payment_info"`
+	CheckoutSessionId string                           `protobuf:"bytes,6,opt,name=checkout_session_id,json=checkoutSessionId,proto3" json:"checkout_session_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_orders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateOrderRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *CreateOrderRequest) GetPaymentInfo() isCreateOrderRequest_PaymentInfo {
+	if x != nil {
+		return x.PaymentInfo
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPaymentIntentId() string {
+	if x != nil {
+		if x, ok := x.PaymentInfo.(*CreateOrderRequest_PaymentIntentId); ok {
+			return x.PaymentIntentId
+		}
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCheckoutSessionId() string {
+	if x != nil {
+		return x.CheckoutSessionId
+	}
+	return ""
+}
+
+type isCreateOrderRequest_PaymentInfo interface {
+	isCreateOrderRequest_PaymentInfo()
+}
+
+type CreateOrderRequest_PaymentIntentId struct {
+	PaymentIntentId string `protobuf:"bytes,3,opt,name=payment_intent_id,json=paymentIntentId,proto3,oneof"`
+}
+
+func (*CreateOrderRequest_PaymentIntentId) isCreateOrderRequest_PaymentInfo() {}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_orders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateOrderResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateOrderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Order struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status Status                 `protobuf:"varint,3,opt,name=status,proto3,enum=order.Status" json:"status,omitempty"`
+	Items  []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// Grand total charged.
+	TotalPrice      *Money `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	ShippingAddress string `protobuf:"bytes,6,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	Discount        *Money `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	CouponCode      string `protobuf:"bytes,8,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	Subtotal        *Money `protobuf:"bytes,9,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Shipping        *Money `protobuf:"bytes,10,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Tax             *Money `protobuf:"bytes,11,opt,name=tax,proto3" json:"tax,omitempty"`
+	ShippingMethod  string `protobuf:"bytes,12,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	// Unset for orders placed before addresses were structured; shipping_address has the text.
+	Address *Address `protobuf:"bytes,13,opt,name=address,proto3" json:"address,omitempty"`
+	// Set on orders the customer cancelled, if they gave a reason.
+	CancellationReason string                 `protobuf:"bytes,14,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unspecified for orders placed before it was recorded.
+	PaymentMethod PaymentMethod `protobuf:"varint,16,opt,name=payment_method,json=paymentMethod,proto3,enum=order.PaymentMethod" json:"payment_method,omitempty"`
+	// Oldest first.
+	StatusHistory []*StatusChange `protobuf:"bytes,17,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	// Set on orders placed by a subscription.
+	SubscriptionId int64 `protobuf:"varint,18,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_orders_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *Order) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *Order) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Order) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *Order) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Order) GetShipping() *Money {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+func (x *Order) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Order) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+func (x *Order) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Order) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Order) GetStatusHistory() []*StatusChange {
+	if x != nil {
+		return x.StatusHistory
+	}
+	return nil
+}
+
+func (x *Order) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_orders_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Only paid or confirmed orders can be cancelled.
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_orders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 100; 0 selects 20.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, with the same filters and sort; empty for the
+	// first page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Empty matches every status.
+	Statuses []Status `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=order.Status" json:"statuses,omitempty"`
+	// Orders placed at or after created_after and before created_before; either may be unset.
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Sort          OrderSort              `protobuf:"varint,6,opt,name=sort,proto3,enum=order.OrderSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_orders_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatuses() []Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetSort() OrderSort {
+	if x != nil {
+		return x.Sort
+	}
+	return OrderSort_NEWEST_FIRST
+}
+
+type ReturnLine struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sku      string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason   string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// References to photos the customer uploaded, e.g. URLs.
+	PhotoRefs     []string `protobuf:"bytes,4,rep,name=photo_refs,json=photoRefs,proto3" json:"photo_refs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnLine) Reset() {
+	*x = ReturnLine{}
+	mi := &file_orders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnLine) ProtoMessage() {}
+
+func (x *ReturnLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnLine.ProtoReflect.Descriptor instead.
+func (*ReturnLine) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReturnLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReturnLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReturnLine) GetPhotoRefs() []string {
+	if x != nil {
+		return x.PhotoRefs
+	}
+	return nil
+}
+
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Lines         []*ReturnLine          `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	mi := &file_orders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RequestReturnRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RequestReturnRequest) GetLines() []*ReturnLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReturnItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku       string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity  int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice *Money                 `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Reason    string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	PhotoRefs []string               `protobuf:"bytes,6,rep,name=photo_refs,json=photoRefs,proto3" json:"photo_refs,omitempty"`
+	// Set once the return is received.
+	AcceptedQuantity int64 `protobuf:"varint,7,opt,name=accepted_quantity,json=acceptedQuantity,proto3" json:"accepted_quantity,omitempty"`
+	Restock          bool  `protobuf:"varint,8,opt,name=restock,proto3" json:"restock,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReturnItem) Reset() {
+	*x = ReturnItem{}
+	mi := &file_orders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItem) ProtoMessage() {}
+
+func (x *ReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItem.ProtoReflect.Descriptor instead.
+func (*ReturnItem) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReturnItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *ReturnItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetPhotoRefs() []string {
+	if x != nil {
+		return x.PhotoRefs
+	}
+	return nil
+}
+
+func (x *ReturnItem) GetAcceptedQuantity() int64 {
+	if x != nil {
+		return x.AcceptedQuantity
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
+type Return struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        ReturnStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=order.ReturnStatus" json:"status,omitempty"`
+	Items         []*ReturnItem          `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	StaffNote     string                 `protobuf:"bytes,5,opt,name=staff_note,json=staffNote,proto3" json:"staff_note,omitempty"`
+	RefundAmount  *Money                 `protobuf:"bytes,6,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Return) Reset() {
+	*x = Return{}
+	mi := &file_orders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Return) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Return) ProtoMessage() {}
+
+func (x *Return) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Return.ProtoReflect.Descriptor instead.
+func (*Return) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Return) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Return) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Return) GetStatus() ReturnStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReturnStatus_RETURN_REQUESTED
+}
+
+func (x *Return) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Return) GetStaffNote() string {
+	if x != nil {
+		return x.StaffNote
+	}
+	return ""
+}
+
+func (x *Return) GetRefundAmount() *Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *Return) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Return) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListOrderReturnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderReturnsRequest) Reset() {
+	*x = ListOrderReturnsRequest{}
+	mi := &file_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderReturnsRequest) ProtoMessage() {}
+
+func (x *ListOrderReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListOrderReturnsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ListOrderReturnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Returns       []*Return              `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderReturnsResponse) Reset() {
+	*x = ListOrderReturnsResponse{}
+	mi := &file_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderReturnsResponse) ProtoMessage() {}
+
+func (x *ListOrderReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListOrderReturnsResponse) GetReturns() []*Return {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+type GetReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnRequest) Reset() {
+	*x = GetReturnRequest{}
+	mi := &file_orders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnRequest) ProtoMessage() {}
+
+func (x *GetReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReturnRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReviewReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewReturnRequest) Reset() {
+	*x = ReviewReturnRequest{}
+	mi := &file_orders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReturnRequest) ProtoMessage() {}
+
+func (x *ReviewReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReviewReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReviewReturnRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewReturnRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type InspectedItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ItemId           int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	AcceptedQuantity int64                  `protobuf:"varint,2,opt,name=accepted_quantity,json=acceptedQuantity,proto3" json:"accepted_quantity,omitempty"`
+	// Whether the accepted units can be sold again.
+	Restock       bool `protobuf:"varint,3,opt,name=restock,proto3" json:"restock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectedItem) Reset() {
+	*x = InspectedItem{}
+	mi := &file_orders_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectedItem) ProtoMessage() {}
+
+func (x *InspectedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectedItem.ProtoReflect.Descriptor instead.
+func (*InspectedItem) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InspectedItem) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *InspectedItem) GetAcceptedQuantity() int64 {
+	if x != nil {
+		return x.AcceptedQuantity
+	}
+	return 0
+}
+
+func (x *InspectedItem) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
+// Every item of the return must be inspected.
+type ReceiveReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items         []*InspectedItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnRequest) Reset() {
+	*x = ReceiveReturnRequest{}
+	mi := &file_orders_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequest) ProtoMessage() {}
+
+func (x *ReceiveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReceiveReturnRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReceiveReturnRequest) GetItems() []*InspectedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReceiveReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ShipmentLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentLine) Reset() {
+	*x = ShipmentLine{}
+	mi := &file_orders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentLine) ProtoMessage() {}
+
+func (x *ShipmentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentLine.ProtoReflect.Descriptor instead.
+func (*ShipmentLine) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ShipmentLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ShipmentLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier       string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Lines         []*ShipmentLine        `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_orders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateShipmentRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetLines() []*ShipmentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type TrackingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ShipmentStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=order.ShipmentStatus" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackingEvent) Reset() {
+	*x = TrackingEvent{}
+	mi := &file_orders_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingEvent) ProtoMessage() {}
+
+func (x *TrackingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackingEvent.ProtoReflect.Descriptor instead.
+func (*TrackingEvent) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TrackingEvent) GetStatus() ShipmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ShipmentStatus_SHIPMENT_LABEL_CREATED
+}
+
+func (x *TrackingEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TrackingEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *TrackingEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Status         ShipmentStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=order.ShipmentStatus" json:"status,omitempty"`
+	Items          []*ShipmentLine        `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	// Oldest first.
+	Events            []*TrackingEvent       `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	EstimatedDelivery *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=estimated_delivery,json=estimatedDelivery,proto3" json:"estimated_delivery,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the shipment is delivered.
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_orders_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Shipment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Shipment) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetStatus() ShipmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ShipmentStatus_SHIPMENT_LABEL_CREATED
+}
+
+func (x *Shipment) GetItems() []*ShipmentLine {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Shipment) GetEvents() []*TrackingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Shipment) GetEstimatedDelivery() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedDelivery
+	}
+	return nil
+}
+
+func (x *Shipment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type ListOrderShipmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderShipmentsRequest) Reset() {
+	*x = ListOrderShipmentsRequest{}
+	mi := &file_orders_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderShipmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderShipmentsRequest) ProtoMessage() {}
+
+func (x *ListOrderShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListOrderShipmentsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ListOrderShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderShipmentsResponse) Reset() {
+	*x = ListOrderShipmentsResponse{}
+	mi := &file_orders_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderShipmentsResponse) ProtoMessage() {}
+
+func (x *ListOrderShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListOrderShipmentsResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type ReorderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRequest) Reset() {
+	*x = ReorderRequest{}
+	mi := &file_orders_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRequest) ProtoMessage() {}
+
+func (x *ReorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReorderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReorderLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        ReorderStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=order.ReorderStatus" json:"status,omitempty"`
+	OriginalPrice *Money                 `protobuf:"bytes,5,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	// The unit price in the cart; only set for lines that were added. A line is only
+	// reported as PRICE_CHANGED when both prices are in the same currency.
+	CurrentPrice  *Money `protobuf:"bytes,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLine) Reset() {
+	*x = ReorderLine{}
+	mi := &file_orders_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLine) ProtoMessage() {}
+
+func (x *ReorderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLine.ProtoReflect.Descriptor instead.
+func (*ReorderLine) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReorderLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReorderLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReorderLine) GetStatus() ReorderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReorderStatus_REORDER_ADDED
+}
+
+func (x *ReorderLine) GetOriginalPrice() *Money {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return nil
+}
+
+func (x *ReorderLine) GetCurrentPrice() *Money {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return nil
+}
+
+type ReorderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*ReorderLine         `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderResponse) Reset() {
+	*x = ReorderResponse{}
+	mi := &file_orders_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderResponse) ProtoMessage() {}
+
+func (x *ReorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderResponse.ProtoReflect.Descriptor instead.
+func (*ReorderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReorderResponse) GetLines() []*ReorderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_orders_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetInvoiceRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ListOrdersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Orders matching the filters across all pages.
+	TotalCount    int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_orders_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type HasPurchasedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasPurchasedRequest) Reset() {
+	*x = HasPurchasedRequest{}
+	mi := &file_orders_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasPurchasedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasPurchasedRequest) ProtoMessage() {}
+
+func (x *HasPurchasedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasPurchasedRequest.ProtoReflect.Descriptor instead.
+func (*HasPurchasedRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HasPurchasedRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type HasPurchasedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purchased     bool                   `protobuf:"varint,1,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasPurchasedResponse) Reset() {
+	*x = HasPurchasedResponse{}
+	mi := &file_orders_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasPurchasedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasPurchasedResponse) ProtoMessage() {}
+
+func (x *HasPurchasedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasPurchasedResponse.ProtoReflect.Descriptor instead.
+func (*HasPurchasedResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HasPurchasedResponse) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+type Address struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FullName string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Line1    string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2    string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City     string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	// State or province code, where the country has them.
+	Region     string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode string `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 code.
+	Country       string `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_orders_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Address) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ShippingOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinDays       int32                  `protobuf:"varint,4,opt,name=min_days,json=minDays,proto3" json:"min_days,omitempty"`
+	MaxDays       int32                  `protobuf:"varint,5,opt,name=max_days,json=maxDays,proto3" json:"max_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingOption) Reset() {
+	*x = ShippingOption{}
+	mi := &file_orders_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingOption) ProtoMessage() {}
+
+func (x *ShippingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingOption.ProtoReflect.Descriptor instead.
+func (*ShippingOption) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ShippingOption) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ShippingOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShippingOption) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ShippingOption) GetMinDays() int32 {
+	if x != nil {
+		return x.MinDays
+	}
+	return 0
+}
+
+func (x *ShippingOption) GetMaxDays() int32 {
+	if x != nil {
+		return x.MaxDays
+	}
+	return 0
+}
+
+type GetCheckoutQuoteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Empty selects the cheapest method available for the address.
+	ShippingMethod string `protobuf:"bytes,2,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCheckoutQuoteRequest) Reset() {
+	*x = GetCheckoutQuoteRequest{}
+	mi := &file_orders_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckoutQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckoutQuoteRequest) ProtoMessage() {}
+
+func (x *GetCheckoutQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckoutQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckoutQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCheckoutQuoteRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *GetCheckoutQuoteRequest) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+// CheckoutQuote prices the caller's cart shipped to an address. All amounts are in the
+// cart currency and grand_total = subtotal - discount + shipping + tax.
+type CheckoutQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Subtotal        *Money                 `protobuf:"bytes,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount        *Money                 `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	ShippingOptions []*ShippingOption      `protobuf:"bytes,3,rep,name=shipping_options,json=shippingOptions,proto3" json:"shipping_options,omitempty"`
+	ShippingMethod  string                 `protobuf:"bytes,4,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	Shipping        *Money                 `protobuf:"bytes,5,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Tax             *Money                 `protobuf:"bytes,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	GrandTotal      *Money                 `protobuf:"bytes,7,opt,name=grand_total,json=grandTotal,proto3" json:"grand_total,omitempty"`
+	FreeShipping    bool                   `protobuf:"varint,8,opt,name=free_shipping,json=freeShipping,proto3" json:"free_shipping,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckoutQuote) Reset() {
+	*x = CheckoutQuote{}
+	mi := &file_orders_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutQuote) ProtoMessage() {}
+
+func (x *CheckoutQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutQuote.ProtoReflect.Descriptor instead.
+func (*CheckoutQuote) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CheckoutQuote) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *CheckoutQuote) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CheckoutQuote) GetShippingOptions() []*ShippingOption {
+	if x != nil {
+		return x.ShippingOptions
+	}
+	return nil
+}
+
+func (x *CheckoutQuote) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+func (x *CheckoutQuote) GetShipping() *Money {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+func (x *CheckoutQuote) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *CheckoutQuote) GetGrandTotal() *Money {
+	if x != nil {
+		return x.GrandTotal
+	}
+	return nil
+}
+
+func (x *CheckoutQuote) GetFreeShipping() bool {
+	if x != nil {
+		return x.FreeShipping
+	}
+	return false
+}
+
+type CreateCheckoutSessionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Empty selects the cheapest method available for the address.
+	ShippingMethod string `protobuf:"bytes,2,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCheckoutSessionRequest) Reset() {
+	*x = CreateCheckoutSessionRequest{}
+	mi := &file_orders_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCheckoutSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCheckoutSessionRequest) ProtoMessage() {}
+
+func (x *CreateCheckoutSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCheckoutSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCheckoutSessionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateCheckoutSessionRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateCheckoutSessionRequest) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+type GetCheckoutSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckoutSessionRequest) Reset() {
+	*x = GetCheckoutSessionRequest{}
+	mi := &file_orders_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckoutSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckoutSessionRequest) ProtoMessage() {}
+
+func (x *GetCheckoutSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckoutSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckoutSessionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCheckoutSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckoutLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	LineTotal     *Money                 `protobuf:"bytes,6,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutLine) Reset() {
+	*x = CheckoutLine{}
+	mi := &file_orders_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutLine) ProtoMessage() {}
+
+func (x *CheckoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutLine.ProtoReflect.Descriptor instead.
+func (*CheckoutLine) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckoutLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CheckoutLine) GetLineTotal() *Money {
+	if x != nil {
+		return x.LineTotal
+	}
+	return nil
+}
+
+// CheckoutSession is a cart and its quote frozen until expires_at. Paying for it and
+// placing its order both use these amounts, whatever happens to the cart meanwhile.
+type CheckoutSession struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         CheckoutSessionStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=order.CheckoutSessionStatus" json:"status,omitempty"`
+	Lines          []*CheckoutLine        `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	Address        *Address               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	ShippingOption *ShippingOption        `protobuf:"bytes,5,opt,name=shipping_option,json=shippingOption,proto3" json:"shipping_option,omitempty"`
+	CouponCode     string                 `protobuf:"bytes,6,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	Subtotal       *Money                 `protobuf:"bytes,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount       *Money                 `protobuf:"bytes,8,opt,name=discount,proto3" json:"discount,omitempty"`
+	Shipping       *Money                 `protobuf:"bytes,9,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Tax            *Money                 `protobuf:"bytes,10,opt,name=tax,proto3" json:"tax,omitempty"`
+	GrandTotal     *Money                 `protobuf:"bytes,11,opt,name=grand_total,json=grandTotal,proto3" json:"grand_total,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Set once the session is completed.
+	OrderId       int64 `protobuf:"varint,13,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutSession) Reset() {
+	*x = CheckoutSession{}
+	mi := &file_orders_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutSession) ProtoMessage() {}
+
+func (x *CheckoutSession) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutSession.ProtoReflect.Descriptor instead.
+func (*CheckoutSession) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckoutSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutSession) GetStatus() CheckoutSessionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CheckoutSessionStatus_SESSION_OPEN
+}
+
+func (x *CheckoutSession) GetLines() []*CheckoutLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetShippingOption() *ShippingOption {
+	if x != nil {
+		return x.ShippingOption
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CheckoutSession) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetShipping() *Money {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetGrandTotal() *Money {
+	if x != nil {
+		return x.GrandTotal
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CheckoutSession) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type Coupon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type          CouponType             `protobuf:"varint,2,opt,name=type,proto3,enum=order.CouponType" json:"type,omitempty"`
+	PercentOff    int32                  `protobuf:"varint,3,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	AmountOff     *Money                 `protobuf:"bytes,4,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	MinBasket     *Money                 `protobuf:"bytes,5,opt,name=min_basket,json=minBasket,proto3" json:"min_basket,omitempty"`
+	BuySku        string                 `protobuf:"bytes,6,opt,name=buy_sku,json=buySku,proto3" json:"buy_sku,omitempty"`
+	BuyQuantity   int32                  `protobuf:"varint,7,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity   int32                  `protobuf:"varint,8,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	UsageLimit    int32                  `protobuf:"varint,9,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserLimit  int32                  `protobuf:"varint,10,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	TimesRedeemed int32                  `protobuf:"varint,11,opt,name=times_redeemed,json=timesRedeemed,proto3" json:"times_redeemed,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_orders_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coupon) GetType() CouponType {
+	if x != nil {
+		return x.Type
+	}
+	return CouponType_PERCENTAGE
+}
+
+func (x *Coupon) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *Coupon) GetAmountOff() *Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *Coupon) GetMinBasket() *Money {
+	if x != nil {
+		return x.MinBasket
+	}
+	return nil
+}
+
+func (x *Coupon) GetBuySku() string {
+	if x != nil {
+		return x.BuySku
+	}
+	return ""
+}
+
+func (x *Coupon) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Coupon) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Coupon) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetTimesRedeemed() int32 {
+	if x != nil {
+		return x.TimesRedeemed
+	}
+	return 0
+}
+
+func (x *Coupon) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Coupon) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type CreateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_orders_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateCouponRequest) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type GetCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponRequest) Reset() {
+	*x = GetCouponRequest{}
+	mi := &file_orders_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponRequest) ProtoMessage() {}
+
+func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetCouponResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Coupon          *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	UserRedemptions int32                  `protobuf:"varint,2,opt,name=user_redemptions,json=userRedemptions,proto3" json:"user_redemptions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCouponResponse) Reset() {
+	*x = GetCouponResponse{}
+	mi := &file_orders_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponResponse) ProtoMessage() {}
+
+func (x *GetCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponResponse.ProtoReflect.Descriptor instead.
+func (*GetCouponResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *GetCouponResponse) GetUserRedemptions() int32 {
+	if x != nil {
+		return x.UserRedemptions
+	}
+	return 0
+}
+
+type SubscriptionItem struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sku      string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// The product name when the subscription was created; ignored in requests.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionItem) Reset() {
+	*x = SubscriptionItem{}
+	mi := &file_orders_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionItem) ProtoMessage() {}
+
+func (x *SubscriptionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionItem.ProtoReflect.Descriptor instead.
+func (*SubscriptionItem) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SubscriptionItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SubscriptionItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateSubscriptionRequest needs a card saved with the payment service for CARD; each
+// order is charged to it.
+type CreateSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*SubscriptionItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Between 1 and 365.
+	IntervalDays int32    `protobuf:"varint,2,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`
+	Address      *Address `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Empty selects the cheapest method available for the address.
+	ShippingMethod        string        `protobuf:"bytes,4,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	PaymentMethod         PaymentMethod `protobuf:"varint,5,opt,name=payment_method,json=paymentMethod,proto3,enum=order.PaymentMethod" json:"payment_method,omitempty"`
+	StoredPaymentMethodId string        `protobuf:"bytes,6,opt,name=stored_payment_method_id,json=storedPaymentMethodId,proto3" json:"stored_payment_method_id,omitempty"`
+	// Unset places the first order right away.
+	FirstOrderAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_order_at,json=firstOrderAt,proto3" json:"first_order_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_orders_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateSubscriptionRequest) GetItems() []*SubscriptionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *CreateSubscriptionRequest) GetStoredPaymentMethodId() string {
+	if x != nil {
+		return x.StoredPaymentMethodId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetFirstOrderAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstOrderAt
+	}
+	return nil
+}
+
+type Subscription struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status                SubscriptionStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=order.SubscriptionStatus" json:"status,omitempty"`
+	Items                 []*SubscriptionItem    `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	IntervalDays          int32                  `protobuf:"varint,4,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`
+	Address               *Address               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	ShippingMethod        string                 `protobuf:"bytes,6,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	PaymentMethod         PaymentMethod          `protobuf:"varint,7,opt,name=payment_method,json=paymentMethod,proto3,enum=order.PaymentMethod" json:"payment_method,omitempty"`
+	StoredPaymentMethodId string                 `protobuf:"bytes,8,opt,name=stored_payment_method_id,json=storedPaymentMethodId,proto3" json:"stored_payment_method_id,omitempty"`
+	// When the next order is due; a failed order is retried before it moves on.
+	NextOrderAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_order_at,json=nextOrderAt,proto3" json:"next_order_at,omitempty"`
+	// Set while a failed order waits to be retried.
+	RetryAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+	// Failed attempts at the order now due.
+	FailedAttempts int32  `protobuf:"varint,11,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	LastFailure    string `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	// The order placed for the delivery now due, while it goes through payment and stock
+	// reservation.
+	PendingOrderId int64                  `protobuf:"varint,13,opt,name=pending_order_id,json=pendingOrderId,proto3" json:"pending_order_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CancelledAt    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_orders_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*Subscription) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[0]
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,137 +3703,137 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *OrderItem) GetProductId() int64 {
+func (x *Subscription) GetId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *OrderItem) GetQuantity() int64 {
+func (x *Subscription) GetStatus() SubscriptionStatus {
 	if x != nil {
-		return x.Quantity
+		return x.Status
 	}
-	return 0
+	return SubscriptionStatus_SUBSCRIPTION_ACTIVE
 }
 
-func (x *OrderItem) GetPrice() float64 {
+func (x *Subscription) GetItems() []*SubscriptionItem {
 	if x != nil {
-		return x.Price
+		return x.Items
 	}
-	return 0
-}
-
-type CreateOrderRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ShippingAddress string                 `protobuf:"bytes,1,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	PaymentMethod   PaymentMethod          `protobuf:"varint,2,opt,name=payment_method,json=paymentMethod,proto3,enum=order.PaymentMethod" json:"payment_method,omitempty"`
-	// Types that are valid to be assigned to PaymentInfo:
-	//
-	//	*CreateOrderRequest_PaymentIntentId
-	PaymentInfo   isCreateOrderRequest_PaymentInfo `protobuf_oneof:"payment_info"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
-	mi := &file_orders_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Subscription) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
 }
 
-func (x *CreateOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Subscription) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
-
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[1]
+func (x *Subscription) GetShippingMethod() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ShippingMethod
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{1}
+func (x *Subscription) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
-func (x *CreateOrderRequest) GetShippingAddress() string {
+func (x *Subscription) GetStoredPaymentMethodId() string {
 	if x != nil {
-		return x.ShippingAddress
+		return x.StoredPaymentMethodId
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetPaymentMethod() PaymentMethod {
+func (x *Subscription) GetNextOrderAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PaymentMethod
+		return x.NextOrderAt
 	}
-	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateOrderRequest) GetPaymentInfo() isCreateOrderRequest_PaymentInfo {
+func (x *Subscription) GetRetryAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PaymentInfo
+		return x.RetryAt
 	}
 	return nil
 }
 
-func (x *CreateOrderRequest) GetPaymentIntentId() string {
+func (x *Subscription) GetFailedAttempts() int32 {
 	if x != nil {
-		if x, ok := x.PaymentInfo.(*CreateOrderRequest_PaymentIntentId); ok {
-			return x.PaymentIntentId
-		}
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *Subscription) GetLastFailure() string {
+	if x != nil {
+		return x.LastFailure
 	}
 	return ""
 }
 
-type isCreateOrderRequest_PaymentInfo interface {
-	isCreateOrderRequest_PaymentInfo()
+func (x *Subscription) GetPendingOrderId() int64 {
+	if x != nil {
+		return x.PendingOrderId
+	}
+	return 0
 }
 
-type CreateOrderRequest_PaymentIntentId struct {
-	PaymentIntentId string `protobuf:"bytes,3,opt,name=payment_intent_id,json=paymentIntentId,proto3,oneof"`
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (*CreateOrderRequest_PaymentIntentId) isCreateOrderRequest_PaymentInfo() {}
+func (x *Subscription) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
 
-type CreateOrderResponse struct {
+type ListSubscriptionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
-	mi := &file_orders_proto_msgTypes[2]
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_orders_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *ListSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*ListSubscriptionsRequest) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[2]
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,52 +3844,77 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateOrderResponse) GetId() int64 {
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_orders_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[47]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOrderResponse) GetStatus() string {
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
 	if x != nil {
-		return x.Status
+		return x.Subscriptions
 	}
-	return ""
+	return nil
 }
 
-type Order struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status          Status                 `protobuf:"varint,3,opt,name=status,proto3,enum=order.Status" json:"status,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice      float64                `protobuf:"fixed64,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,6,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_orders_proto_msgTypes[3]
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_orders_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *GetSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*GetSubscriptionRequest) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[3]
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,75 +3925,84 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Order) GetId() int64 {
+func (x *GetSubscriptionRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Order) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+type PauseSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) GetStatus() Status {
-	if x != nil {
-		return x.Status
-	}
-	return Status_PENDING
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_orders_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) GetItems() []*OrderItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Order) GetTotalPrice() float64 {
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[49]
 	if x != nil {
-		return x.TotalPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetShippingAddress() string {
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PauseSubscriptionRequest) GetId() int64 {
 	if x != nil {
-		return x.ShippingAddress
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type GetOrderRequest struct {
+type ResumeSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_orders_proto_msgTypes[4]
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_orders_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *ResumeSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[4]
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,39 +4013,40 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetOrderRequest) GetId() int64 {
+func (x *ResumeSubscriptionRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ListOrdersRequest struct {
+type SkipSubscriptionDeliveryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_orders_proto_msgTypes[5]
+func (x *SkipSubscriptionDeliveryRequest) Reset() {
+	*x = SkipSubscriptionDeliveryRequest{}
+	mi := &file_orders_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *SkipSubscriptionDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*SkipSubscriptionDeliveryRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[5]
+func (x *SkipSubscriptionDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,33 +4057,40 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SkipSubscriptionDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*SkipSubscriptionDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{51}
 }
 
-type ListOrdersResponse struct {
+func (x *SkipSubscriptionDeliveryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_orders_proto_msgTypes[6]
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_orders_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *CancelSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*CancelSubscriptionRequest) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[6]
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,62 +4101,392 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *CancelSubscriptionRequest) GetId() int64 {
 	if x != nil {
-		return x.Orders
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
 var File_orders_proto protoreflect.FileDescriptor
 
 const file_orders_proto_rawDesc = "" +
 	"\n" +
-	"\forders.proto\x12\x05order\x1a\x1cgoogle/api/annotations.proto\"\\\n" +
-	"\tOrderItem\x12\x1d\n" +
+	"\forders.proto\x12\x05order\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vmoney.proto\"\xe0\x01\n" +
+	"\tOrderItem\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\"\n" +
+	"\x05price\x18\x03 \x01(\v2\f.money.MoneyR\x05price\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12+\n" +
+	"\n" +
+	"line_total\x18\b \x01(\v2\f.money.MoneyR\tlineTotalJ\x04\b\x01\x10\x02\"\xa7\x01\n" +
+	"\fStatusChange\x12%\n" +
+	"\x06status\x18\x01 \x01(\x0e2\r.order.StatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\"\xba\x01\n" +
-	"\x12CreateOrderRequest\x12)\n" +
-	"\x10shipping_address\x18\x01 \x01(\tR\x0fshippingAddress\x12;\n" +
+	"changed_by\x18\x04 \x01(\x03R\tchangedBy\"\xfd\x01\n" +
+	"\x12CreateOrderRequest\x12;\n" +
 	"\x0epayment_method\x18\x02 \x01(\x0e2\x14.order.PaymentMethodR\rpaymentMethod\x12,\n" +
-	"\x11payment_intent_id\x18\x03 \x01(\tH\x00R\x0fpaymentIntentIdB\x0e\n" +
-	"\fpayment_info\"=\n" +
+	"\x11payment_intent_id\x18\x03 \x01(\tH\x00R\x0fpaymentIntentId\x12.\n" +
+	"\x13checkout_session_id\x18\x06 \x01(\tR\x11checkoutSessionIdB\x0e\n" +
+	"\fpayment_infoJ\x04\b\x01\x10\x02J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\x10shipping_addressR\aaddressR\x0fshipping_method\"=\n" +
 	"\x13CreateOrderResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"\xcb\x01\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xf9\x05\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12%\n" +
 	"\x06status\x18\x03 \x01(\x0e2\r.order.StatusR\x06status\x12&\n" +
-	"\x05items\x18\x04 \x03(\v2\x10.order.OrderItemR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x05 \x01(\x01R\n" +
+	"\x05items\x18\x04 \x03(\v2\x10.order.OrderItemR\x05items\x12-\n" +
+	"\vtotal_price\x18\x05 \x01(\v2\f.money.MoneyR\n" +
 	"totalPrice\x12)\n" +
-	"\x10shipping_address\x18\x06 \x01(\tR\x0fshippingAddress\"!\n" +
+	"\x10shipping_address\x18\x06 \x01(\tR\x0fshippingAddress\x12(\n" +
+	"\bdiscount\x18\a \x01(\v2\f.money.MoneyR\bdiscount\x12\x1f\n" +
+	"\vcoupon_code\x18\b \x01(\tR\n" +
+	"couponCode\x12(\n" +
+	"\bsubtotal\x18\t \x01(\v2\f.money.MoneyR\bsubtotal\x12(\n" +
+	"\bshipping\x18\n" +
+	" \x01(\v2\f.money.MoneyR\bshipping\x12\x1e\n" +
+	"\x03tax\x18\v \x01(\v2\f.money.MoneyR\x03tax\x12'\n" +
+	"\x0fshipping_method\x18\f \x01(\tR\x0eshippingMethod\x12(\n" +
+	"\aaddress\x18\r \x01(\v2\x0e.order.AddressR\aaddress\x12/\n" +
+	"\x13cancellation_reason\x18\x0e \x01(\tR\x12cancellationReason\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\x0epayment_method\x18\x10 \x01(\x0e2\x14.order.PaymentMethodR\rpaymentMethod\x12:\n" +
+	"\x0estatus_history\x18\x11 \x03(\v2\x13.order.StatusChangeR\rstatusHistory\x12'\n" +
+	"\x0fsubscription_id\x18\x12 \x01(\x03R\x0esubscriptionId\"!\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x13\n" +
-	"\x11ListOrdersRequest\":\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"<\n" +
+	"\x12CancelOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xa4\x02\n" +
+	"\x11ListOrdersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12)\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\r.order.StatusR\bstatuses\x12?\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12$\n" +
+	"\x04sort\x18\x06 \x01(\x0e2\x10.order.OrderSortR\x04sort\"q\n" +
+	"\n" +
+	"ReturnLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"photo_refs\x18\x04 \x03(\tR\tphotoRefs\"Z\n" +
+	"\x14RequestReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12'\n" +
+	"\x05lines\x18\x02 \x03(\v2\x11.order.ReturnLineR\x05lines\"\xf5\x01\n" +
+	"\n" +
+	"ReturnItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12+\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\f.money.MoneyR\tunitPrice\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"photo_refs\x18\x06 \x03(\tR\tphotoRefs\x12+\n" +
+	"\x11accepted_quantity\x18\a \x01(\x03R\x10acceptedQuantity\x12\x18\n" +
+	"\arestock\x18\b \x01(\bR\arestock\"\xd1\x02\n" +
+	"\x06Return\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12+\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.order.ReturnStatusR\x06status\x12'\n" +
+	"\x05items\x18\x04 \x03(\v2\x11.order.ReturnItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"staff_note\x18\x05 \x01(\tR\tstaffNote\x121\n" +
+	"\rrefund_amount\x18\x06 \x01(\v2\f.money.MoneyR\frefundAmount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"4\n" +
+	"\x17ListOrderReturnsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"C\n" +
+	"\x18ListOrderReturnsResponse\x12'\n" +
+	"\areturns\x18\x01 \x03(\v2\r.order.ReturnR\areturns\"\"\n" +
+	"\x10GetReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"S\n" +
+	"\x13ReviewReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"o\n" +
+	"\rInspectedItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12+\n" +
+	"\x11accepted_quantity\x18\x02 \x01(\x03R\x10acceptedQuantity\x12\x18\n" +
+	"\arestock\x18\x03 \x01(\bR\arestock\"f\n" +
+	"\x14ReceiveReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.order.InspectedItemR\x05items\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"<\n" +
+	"\fShipmentLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"w\n" +
+	"\x15CreateShipmentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12)\n" +
+	"\x05lines\x18\x03 \x03(\v2\x13.order.ShipmentLineR\x05lines\"\xb9\x01\n" +
+	"\rTrackingEvent\x12-\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x15.order.ShipmentStatusR\x06status\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xc5\x03\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x12-\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.order.ShipmentStatusR\x06status\x12)\n" +
+	"\x05items\x18\x06 \x03(\v2\x13.order.ShipmentLineR\x05items\x12,\n" +
+	"\x06events\x18\a \x03(\v2\x14.order.TrackingEventR\x06events\x12I\n" +
+	"\x12estimated_delivery\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x11estimatedDelivery\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"6\n" +
+	"\x19ListOrderShipmentsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"K\n" +
+	"\x1aListOrderShipmentsResponse\x12-\n" +
+	"\tshipments\x18\x01 \x03(\v2\x0f.order.ShipmentR\tshipments\" \n" +
+	"\x0eReorderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xe5\x01\n" +
+	"\vReorderLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12,\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x14.order.ReorderStatusR\x06status\x123\n" +
+	"\x0eoriginal_price\x18\x05 \x01(\v2\f.money.MoneyR\roriginalPrice\x121\n" +
+	"\rcurrent_price\x18\x06 \x01(\v2\f.money.MoneyR\fcurrentPrice\";\n" +
+	"\x0fReorderResponse\x12(\n" +
+	"\x05lines\x18\x01 \x03(\v2\x12.order.ReorderLineR\x05lines\".\n" +
+	"\x11GetInvoiceRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"\x83\x01\n" +
 	"\x12ListOrdersResponse\x12$\n" +
-	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders*=\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"'\n" +
+	"\x13HasPurchasedRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"4\n" +
+	"\x14HasPurchasedResponse\x12\x1c\n" +
+	"\tpurchased\x18\x01 \x01(\bR\tpurchased\"\xb9\x01\n" +
+	"\aAddress\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\"\x98\x01\n" +
+	"\x0eShippingOption\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.money.MoneyR\x06amount\x12\x19\n" +
+	"\bmin_days\x18\x04 \x01(\x05R\aminDays\x12\x19\n" +
+	"\bmax_days\x18\x05 \x01(\x05R\amaxDays\"l\n" +
+	"\x17GetCheckoutQuoteRequest\x12(\n" +
+	"\aaddress\x18\x01 \x01(\v2\x0e.order.AddressR\aaddress\x12'\n" +
+	"\x0fshipping_method\x18\x02 \x01(\tR\x0eshippingMethod\"\xec\x02\n" +
+	"\rCheckoutQuote\x12(\n" +
+	"\bsubtotal\x18\x01 \x01(\v2\f.money.MoneyR\bsubtotal\x12(\n" +
+	"\bdiscount\x18\x02 \x01(\v2\f.money.MoneyR\bdiscount\x12@\n" +
+	"\x10shipping_options\x18\x03 \x03(\v2\x15.order.ShippingOptionR\x0fshippingOptions\x12'\n" +
+	"\x0fshipping_method\x18\x04 \x01(\tR\x0eshippingMethod\x12(\n" +
+	"\bshipping\x18\x05 \x01(\v2\f.money.MoneyR\bshipping\x12\x1e\n" +
+	"\x03tax\x18\x06 \x01(\v2\f.money.MoneyR\x03tax\x12-\n" +
+	"\vgrand_total\x18\a \x01(\v2\f.money.MoneyR\n" +
+	"grandTotal\x12#\n" +
+	"\rfree_shipping\x18\b \x01(\bR\ffreeShipping\"q\n" +
+	"\x1cCreateCheckoutSessionRequest\x12(\n" +
+	"\aaddress\x18\x01 \x01(\v2\x0e.order.AddressR\aaddress\x12'\n" +
+	"\x0fshipping_method\x18\x02 \x01(\tR\x0eshippingMethod\"+\n" +
+	"\x19GetCheckoutSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbe\x01\n" +
+	"\fCheckoutLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.money.MoneyR\x05price\x12+\n" +
+	"\n" +
+	"line_total\x18\x06 \x01(\v2\f.money.MoneyR\tlineTotal\"\xb0\x04\n" +
+	"\x0fCheckoutSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.order.CheckoutSessionStatusR\x06status\x12)\n" +
+	"\x05lines\x18\x03 \x03(\v2\x13.order.CheckoutLineR\x05lines\x12(\n" +
+	"\aaddress\x18\x04 \x01(\v2\x0e.order.AddressR\aaddress\x12>\n" +
+	"\x0fshipping_option\x18\x05 \x01(\v2\x15.order.ShippingOptionR\x0eshippingOption\x12\x1f\n" +
+	"\vcoupon_code\x18\x06 \x01(\tR\n" +
+	"couponCode\x12(\n" +
+	"\bsubtotal\x18\a \x01(\v2\f.money.MoneyR\bsubtotal\x12(\n" +
+	"\bdiscount\x18\b \x01(\v2\f.money.MoneyR\bdiscount\x12(\n" +
+	"\bshipping\x18\t \x01(\v2\f.money.MoneyR\bshipping\x12\x1e\n" +
+	"\x03tax\x18\n" +
+	" \x01(\v2\f.money.MoneyR\x03tax\x12-\n" +
+	"\vgrand_total\x18\v \x01(\v2\f.money.MoneyR\n" +
+	"grandTotal\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
+	"\border_id\x18\r \x01(\x03R\aorderId\"\xf9\x03\n" +
+	"\x06Coupon\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12%\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x11.order.CouponTypeR\x04type\x12\x1f\n" +
+	"\vpercent_off\x18\x03 \x01(\x05R\n" +
+	"percentOff\x12+\n" +
+	"\n" +
+	"amount_off\x18\x04 \x01(\v2\f.money.MoneyR\tamountOff\x12+\n" +
+	"\n" +
+	"min_basket\x18\x05 \x01(\v2\f.money.MoneyR\tminBasket\x12\x17\n" +
+	"\abuy_sku\x18\x06 \x01(\tR\x06buySku\x12!\n" +
+	"\fbuy_quantity\x18\a \x01(\x05R\vbuyQuantity\x12!\n" +
+	"\fget_quantity\x18\b \x01(\x05R\vgetQuantity\x12\x1f\n" +
+	"\vusage_limit\x18\t \x01(\x05R\n" +
+	"usageLimit\x12$\n" +
+	"\x0eper_user_limit\x18\n" +
+	" \x01(\x05R\fperUserLimit\x12%\n" +
+	"\x0etimes_redeemed\x18\v \x01(\x05R\rtimesRedeemed\x127\n" +
+	"\tstarts_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"<\n" +
+	"\x13CreateCouponRequest\x12%\n" +
+	"\x06coupon\x18\x01 \x01(\v2\r.order.CouponR\x06coupon\"&\n" +
+	"\x10GetCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"e\n" +
+	"\x11GetCouponResponse\x12%\n" +
+	"\x06coupon\x18\x01 \x01(\v2\r.order.CouponR\x06coupon\x12)\n" +
+	"\x10user_redemptions\x18\x02 \x01(\x05R\x0fuserRedemptions\"T\n" +
+	"\x10SubscriptionItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xfa\x02\n" +
+	"\x19CreateSubscriptionRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.order.SubscriptionItemR\x05items\x12#\n" +
+	"\rinterval_days\x18\x02 \x01(\x05R\fintervalDays\x12(\n" +
+	"\aaddress\x18\x03 \x01(\v2\x0e.order.AddressR\aaddress\x12'\n" +
+	"\x0fshipping_method\x18\x04 \x01(\tR\x0eshippingMethod\x12;\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x14.order.PaymentMethodR\rpaymentMethod\x127\n" +
+	"\x18stored_payment_method_id\x18\x06 \x01(\tR\x15storedPaymentMethodId\x12@\n" +
+	"\x0efirst_order_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ffirstOrderAt\"\xd5\x05\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.order.SubscriptionStatusR\x06status\x12-\n" +
+	"\x05items\x18\x03 \x03(\v2\x17.order.SubscriptionItemR\x05items\x12#\n" +
+	"\rinterval_days\x18\x04 \x01(\x05R\fintervalDays\x12(\n" +
+	"\aaddress\x18\x05 \x01(\v2\x0e.order.AddressR\aaddress\x12'\n" +
+	"\x0fshipping_method\x18\x06 \x01(\tR\x0eshippingMethod\x12;\n" +
+	"\x0epayment_method\x18\a \x01(\x0e2\x14.order.PaymentMethodR\rpaymentMethod\x127\n" +
+	"\x18stored_payment_method_id\x18\b \x01(\tR\x15storedPaymentMethodId\x12>\n" +
+	"\rnext_order_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vnextOrderAt\x125\n" +
+	"\bretry_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x12'\n" +
+	"\x0ffailed_attempts\x18\v \x01(\x05R\x0efailedAttempts\x12!\n" +
+	"\flast_failure\x18\f \x01(\tR\vlastFailure\x12(\n" +
+	"\x10pending_order_id\x18\r \x01(\x03R\x0ependingOrderId\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcancelled_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"\x1a\n" +
+	"\x18ListSubscriptionsRequest\"V\n" +
+	"\x19ListSubscriptionsResponse\x129\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x13.order.SubscriptionR\rsubscriptions\"(\n" +
+	"\x16GetSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"*\n" +
+	"\x18PauseSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"+\n" +
+	"\x19ResumeSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x1fSkipSubscriptionDeliveryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"+\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id*/\n" +
+	"\tOrderSort\x12\x10\n" +
+	"\fNEWEST_FIRST\x10\x00\x12\x10\n" +
+	"\fOLDEST_FIRST\x10\x01*x\n" +
+	"\fReturnStatus\x12\x14\n" +
+	"\x10RETURN_REQUESTED\x10\x00\x12\x13\n" +
+	"\x0fRETURN_APPROVED\x10\x01\x12\x13\n" +
+	"\x0fRETURN_REJECTED\x10\x02\x12\x13\n" +
+	"\x0fRETURN_RECEIVED\x10\x03\x12\x13\n" +
+	"\x0fRETURN_REFUNDED\x10\x04*\x94\x01\n" +
+	"\x0eShipmentStatus\x12\x1a\n" +
+	"\x16SHIPMENT_LABEL_CREATED\x10\x00\x12\x17\n" +
+	"\x13SHIPMENT_IN_TRANSIT\x10\x01\x12\x1d\n" +
+	"\x19SHIPMENT_OUT_FOR_DELIVERY\x10\x02\x12\x16\n" +
+	"\x12SHIPMENT_DELIVERED\x10\x03\x12\x16\n" +
+	"\x12SHIPMENT_EXCEPTION\x10\x04*\x8b\x01\n" +
+	"\rReorderStatus\x12\x11\n" +
+	"\rREORDER_ADDED\x10\x00\x12\x19\n" +
+	"\x15REORDER_PRICE_CHANGED\x10\x01\x12\x18\n" +
+	"\x14REORDER_DISCONTINUED\x10\x02\x12\x1e\n" +
+	"\x1aREORDER_INSUFFICIENT_STOCK\x10\x03\x12\x12\n" +
+	"\x0eREORDER_FAILED\x10\x04*U\n" +
+	"\x15CheckoutSessionStatus\x12\x10\n" +
+	"\fSESSION_OPEN\x10\x00\x12\x15\n" +
+	"\x11SESSION_COMPLETED\x10\x01\x12\x13\n" +
+	"\x0fSESSION_EXPIRED\x10\x02*R\n" +
+	"\n" +
+	"CouponType\x12\x0e\n" +
+	"\n" +
+	"PERCENTAGE\x10\x00\x12\x10\n" +
+	"\fFIXED_AMOUNT\x10\x01\x12\x0f\n" +
+	"\vBUY_X_GET_Y\x10\x02\x12\x11\n" +
+	"\rFREE_SHIPPING\x10\x03*p\n" +
 	"\x06Status\x12\v\n" +
 	"\aPENDING\x10\x00\x12\b\n" +
 	"\x04PAID\x10\x01\x12\r\n" +
 	"\tCONFIRMED\x10\x02\x12\r\n" +
-	"\tCANCELLED\x10\x03*J\n" +
+	"\tCANCELLED\x10\x03\x12\r\n" +
+	"\tDELIVERED\x10\x04\x12\v\n" +
+	"\aSHIPPED\x10\x05\x12\x15\n" +
+	"\x11PARTIALLY_SHIPPED\x10\x06*J\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04CARD\x10\x01\x12\x0f\n" +
-	"\vON_DELIVERY\x10\x022\x9d\x02\n" +
+	"\vON_DELIVERY\x10\x02*b\n" +
+	"\x12SubscriptionStatus\x12\x17\n" +
+	"\x13SUBSCRIPTION_ACTIVE\x10\x00\x12\x17\n" +
+	"\x13SUBSCRIPTION_PAUSED\x10\x01\x12\x1a\n" +
+	"\x16SUBSCRIPTION_CANCELLED\x10\x022\xce\x15\n" +
 	"\fOrderService\x12_\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12M\n" +
 	"\bGetOrder\x12\x16.order.GetOrderRequest\x1a\f.order.Order\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/orders/{id}\x12]\n" +
-	"\x0eListUserOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/ordersB\vZ\t/protobufb\x06proto3"
+	"\x0eListUserOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/orders\x12]\n" +
+	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\f.order.Order\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/orders/{id}/cancel\x12i\n" +
+	"\rRequestReturn\x12\x1b.order.RequestReturnRequest\x1a\r.order.Return\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/orders/{order_id}/returns\x12~\n" +
+	"\x10ListOrderReturns\x12\x1e.order.ListOrderReturnsRequest\x1a\x1f.order.ListOrderReturnsResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/orders/{order_id}/returns\x12Q\n" +
+	"\tGetReturn\x12\x17.order.GetReturnRequest\x1a\r.order.Return\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/returns/{id}\x12a\n" +
+	"\fReviewReturn\x12\x1a.order.ReviewReturnRequest\x1a\r.order.Return\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/returns/{id}/review\x12d\n" +
+	"\rReceiveReturn\x12\x1b.order.ReceiveReturnRequest\x1a\r.order.Return\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/returns/{id}/receive\x12o\n" +
+	"\x0eCreateShipment\x12\x1c.order.CreateShipmentRequest\x1a\x0f.order.Shipment\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/orders/{order_id}/shipments\x12\x86\x01\n" +
+	"\x12ListOrderShipments\x12 .order.ListOrderShipmentsRequest\x1a!.order.ListOrderShipmentsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/orders/{order_id}/shipments\x12`\n" +
+	"\aReorder\x12\x15.order.ReorderRequest\x1a\x16.order.ReorderResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/orders/{id}/reorder\x12g\n" +
+	"\n" +
+	"GetInvoice\x12\x18.order.GetInvoiceRequest\x1a\x14.google.api.HttpBody\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/orders/{order_id}/invoice\x12G\n" +
+	"\fHasPurchased\x12\x1a.order.HasPurchasedRequest\x1a\x1b.order.HasPurchasedResponse\x12U\n" +
+	"\fCreateCoupon\x12\x1a.order.CreateCouponRequest\x1a\r.order.Coupon\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/coupons\x12>\n" +
+	"\tGetCoupon\x12\x17.order.GetCouponRequest\x1a\x18.order.GetCouponResponse\x12k\n" +
+	"\x10GetCheckoutQuote\x12\x1e.order.GetCheckoutQuoteRequest\x1a\x14.order.CheckoutQuote\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/checkout/quote\x12z\n" +
+	"\x15CreateCheckoutSession\x12#.order.CreateCheckoutSessionRequest\x1a\x16.order.CheckoutSession\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/checkout/sessions\x12v\n" +
+	"\x12GetCheckoutSession\x12 .order.GetCheckoutSessionRequest\x1a\x16.order.CheckoutSession\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/checkout/sessions/{id}\x12m\n" +
+	"\x12CreateSubscription\x12 .order.CreateSubscriptionRequest\x1a\x13.order.Subscription\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/subscriptions\x12u\n" +
+	"\x11ListSubscriptions\x12\x1f.order.ListSubscriptionsRequest\x1a .order.ListSubscriptionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/subscriptions\x12i\n" +
+	"\x0fGetSubscription\x12\x1d.order.GetSubscriptionRequest\x1a\x13.order.Subscription\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/subscriptions/{id}\x12v\n" +
+	"\x11PauseSubscription\x12\x1f.order.PauseSubscriptionRequest\x1a\x13.order.Subscription\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/subscriptions/{id}/pause\x12y\n" +
+	"\x12ResumeSubscription\x12 .order.ResumeSubscriptionRequest\x1a\x13.order.Subscription\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/subscriptions/{id}/resume\x12\x83\x01\n" +
+	"\x18SkipSubscriptionDelivery\x12&.order.SkipSubscriptionDeliveryRequest\x1a\x13.order.Subscription\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/subscriptions/{id}/skip\x12y\n" +
+	"\x12CancelSubscription\x12 .order.CancelSubscriptionRequest\x1a\x13.order.Subscription\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/subscriptions/{id}/cancelB\vZ\t/protobufb\x06proto3"
 
 var (
 	file_orders_proto_rawDescOnce sync.Once
@@ -582,35 +4500,218 @@ func file_orders_proto_rawDescGZIP() []byte {
 	return file_orders_proto_rawDescData
 }
 
-var file_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_orders_proto_goTypes = []any{
-	(Status)(0),                 // 0: order.Status
-	(PaymentMethod)(0),          // 1: order.PaymentMethod
-	(*OrderItem)(nil),           // 2: order.OrderItem
-	(*CreateOrderRequest)(nil),  // 3: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil), // 4: order.CreateOrderResponse
-	(*Order)(nil),               // 5: order.Order
-	(*GetOrderRequest)(nil),     // 6: order.GetOrderRequest
-	(*ListOrdersRequest)(nil),   // 7: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),  // 8: order.ListOrdersResponse
+	(OrderSort)(0),                          // 0: order.OrderSort
+	(ReturnStatus)(0),                       // 1: order.ReturnStatus
+	(ShipmentStatus)(0),                     // 2: order.ShipmentStatus
+	(ReorderStatus)(0),                      // 3: order.ReorderStatus
+	(CheckoutSessionStatus)(0),              // 4: order.CheckoutSessionStatus
+	(CouponType)(0),                         // 5: order.CouponType
+	(Status)(0),                             // 6: order.Status
+	(PaymentMethod)(0),                      // 7: order.PaymentMethod
+	(SubscriptionStatus)(0),                 // 8: order.SubscriptionStatus
+	(*OrderItem)(nil),                       // 9: order.OrderItem
+	(*StatusChange)(nil),                    // 10: order.StatusChange
+	(*CreateOrderRequest)(nil),              // 11: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),             // 12: order.CreateOrderResponse
+	(*Order)(nil),                           // 13: order.Order
+	(*GetOrderRequest)(nil),                 // 14: order.GetOrderRequest
+	(*CancelOrderRequest)(nil),              // 15: order.CancelOrderRequest
+	(*ListOrdersRequest)(nil),               // 16: order.ListOrdersRequest
+	(*ReturnLine)(nil),                      // 17: order.ReturnLine
+	(*RequestReturnRequest)(nil),            // 18: order.RequestReturnRequest
+	(*ReturnItem)(nil),                      // 19: order.ReturnItem
+	(*Return)(nil),                          // 20: order.Return
+	(*ListOrderReturnsRequest)(nil),         // 21: order.ListOrderReturnsRequest
+	(*ListOrderReturnsResponse)(nil),        // 22: order.ListOrderReturnsResponse
+	(*GetReturnRequest)(nil),                // 23: order.GetReturnRequest
+	(*ReviewReturnRequest)(nil),             // 24: order.ReviewReturnRequest
+	(*InspectedItem)(nil),                   // 25: order.InspectedItem
+	(*ReceiveReturnRequest)(nil),            // 26: order.ReceiveReturnRequest
+	(*ShipmentLine)(nil),                    // 27: order.ShipmentLine
+	(*CreateShipmentRequest)(nil),           // 28: order.CreateShipmentRequest
+	(*TrackingEvent)(nil),                   // 29: order.TrackingEvent
+	(*Shipment)(nil),                        // 30: order.Shipment
+	(*ListOrderShipmentsRequest)(nil),       // 31: order.ListOrderShipmentsRequest
+	(*ListOrderShipmentsResponse)(nil),      // 32: order.ListOrderShipmentsResponse
+	(*ReorderRequest)(nil),                  // 33: order.ReorderRequest
+	(*ReorderLine)(nil),                     // 34: order.ReorderLine
+	(*ReorderResponse)(nil),                 // 35: order.ReorderResponse
+	(*GetInvoiceRequest)(nil),               // 36: order.GetInvoiceRequest
+	(*ListOrdersResponse)(nil),              // 37: order.ListOrdersResponse
+	(*HasPurchasedRequest)(nil),             // 38: order.HasPurchasedRequest
+	(*HasPurchasedResponse)(nil),            // 39: order.HasPurchasedResponse
+	(*Address)(nil),                         // 40: order.Address
+	(*ShippingOption)(nil),                  // 41: order.ShippingOption
+	(*GetCheckoutQuoteRequest)(nil),         // 42: order.GetCheckoutQuoteRequest
+	(*CheckoutQuote)(nil),                   // 43: order.CheckoutQuote
+	(*CreateCheckoutSessionRequest)(nil),    // 44: order.CreateCheckoutSessionRequest
+	(*GetCheckoutSessionRequest)(nil),       // 45: order.GetCheckoutSessionRequest
+	(*CheckoutLine)(nil),                    // 46: order.CheckoutLine
+	(*CheckoutSession)(nil),                 // 47: order.CheckoutSession
+	(*Coupon)(nil),                          // 48: order.Coupon
+	(*CreateCouponRequest)(nil),             // 49: order.CreateCouponRequest
+	(*GetCouponRequest)(nil),                // 50: order.GetCouponRequest
+	(*GetCouponResponse)(nil),               // 51: order.GetCouponResponse
+	(*SubscriptionItem)(nil),                // 52: order.SubscriptionItem
+	(*CreateSubscriptionRequest)(nil),       // 53: order.CreateSubscriptionRequest
+	(*Subscription)(nil),                    // 54: order.Subscription
+	(*ListSubscriptionsRequest)(nil),        // 55: order.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),       // 56: order.ListSubscriptionsResponse
+	(*GetSubscriptionRequest)(nil),          // 57: order.GetSubscriptionRequest
+	(*PauseSubscriptionRequest)(nil),        // 58: order.PauseSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil),       // 59: order.ResumeSubscriptionRequest
+	(*SkipSubscriptionDeliveryRequest)(nil), // 60: order.SkipSubscriptionDeliveryRequest
+	(*CancelSubscriptionRequest)(nil),       // 61: order.CancelSubscriptionRequest
+	(*Money)(nil),                           // 62: money.Money
+	(*timestamppb.Timestamp)(nil),           // 63: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),               // 64: google.api.HttpBody
 }
 var file_orders_proto_depIdxs = []int32{
-	1, // 0: order.CreateOrderRequest.payment_method:type_name -> order.PaymentMethod
-	0, // 1: order.Order.status:type_name -> order.Status
-	2, // 2: order.Order.items:type_name -> order.OrderItem
-	5, // 3: order.ListOrdersResponse.orders:type_name -> order.Order
-	3, // 4: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	6, // 5: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
-	7, // 6: order.OrderService.ListUserOrders:input_type -> order.ListOrdersRequest
-	4, // 7: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	5, // 8: order.OrderService.GetOrder:output_type -> order.Order
-	8, // 9: order.OrderService.ListUserOrders:output_type -> order.ListOrdersResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	62,  // 0: order.OrderItem.price:type_name -> money.Money
+	62,  // 1: order.OrderItem.line_total:type_name -> money.Money
+	6,   // 2: order.StatusChange.status:type_name -> order.Status
+	63,  // 3: order.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	7,   // 4: order.CreateOrderRequest.payment_method:type_name -> order.PaymentMethod
+	6,   // 5: order.Order.status:type_name -> order.Status
+	9,   // 6: order.Order.items:type_name -> order.OrderItem
+	62,  // 7: order.Order.total_price:type_name -> money.Money
+	62,  // 8: order.Order.discount:type_name -> money.Money
+	62,  // 9: order.Order.subtotal:type_name -> money.Money
+	62,  // 10: order.Order.shipping:type_name -> money.Money
+	62,  // 11: order.Order.tax:type_name -> money.Money
+	40,  // 12: order.Order.address:type_name -> order.Address
+	63,  // 13: order.Order.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 14: order.Order.payment_method:type_name -> order.PaymentMethod
+	10,  // 15: order.Order.status_history:type_name -> order.StatusChange
+	6,   // 16: order.ListOrdersRequest.statuses:type_name -> order.Status
+	63,  // 17: order.ListOrdersRequest.created_after:type_name -> google.protobuf.Timestamp
+	63,  // 18: order.ListOrdersRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,   // 19: order.ListOrdersRequest.sort:type_name -> order.OrderSort
+	17,  // 20: order.RequestReturnRequest.lines:type_name -> order.ReturnLine
+	62,  // 21: order.ReturnItem.unit_price:type_name -> money.Money
+	1,   // 22: order.Return.status:type_name -> order.ReturnStatus
+	19,  // 23: order.Return.items:type_name -> order.ReturnItem
+	62,  // 24: order.Return.refund_amount:type_name -> money.Money
+	63,  // 25: order.Return.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 26: order.Return.updated_at:type_name -> google.protobuf.Timestamp
+	20,  // 27: order.ListOrderReturnsResponse.returns:type_name -> order.Return
+	25,  // 28: order.ReceiveReturnRequest.items:type_name -> order.InspectedItem
+	27,  // 29: order.CreateShipmentRequest.lines:type_name -> order.ShipmentLine
+	2,   // 30: order.TrackingEvent.status:type_name -> order.ShipmentStatus
+	63,  // 31: order.TrackingEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2,   // 32: order.Shipment.status:type_name -> order.ShipmentStatus
+	27,  // 33: order.Shipment.items:type_name -> order.ShipmentLine
+	29,  // 34: order.Shipment.events:type_name -> order.TrackingEvent
+	63,  // 35: order.Shipment.estimated_delivery:type_name -> google.protobuf.Timestamp
+	63,  // 36: order.Shipment.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 37: order.Shipment.delivered_at:type_name -> google.protobuf.Timestamp
+	30,  // 38: order.ListOrderShipmentsResponse.shipments:type_name -> order.Shipment
+	3,   // 39: order.ReorderLine.status:type_name -> order.ReorderStatus
+	62,  // 40: order.ReorderLine.original_price:type_name -> money.Money
+	62,  // 41: order.ReorderLine.current_price:type_name -> money.Money
+	34,  // 42: order.ReorderResponse.lines:type_name -> order.ReorderLine
+	13,  // 43: order.ListOrdersResponse.orders:type_name -> order.Order
+	62,  // 44: order.ShippingOption.amount:type_name -> money.Money
+	40,  // 45: order.GetCheckoutQuoteRequest.address:type_name -> order.Address
+	62,  // 46: order.CheckoutQuote.subtotal:type_name -> money.Money
+	62,  // 47: order.CheckoutQuote.discount:type_name -> money.Money
+	41,  // 48: order.CheckoutQuote.shipping_options:type_name -> order.ShippingOption
+	62,  // 49: order.CheckoutQuote.shipping:type_name -> money.Money
+	62,  // 50: order.CheckoutQuote.tax:type_name -> money.Money
+	62,  // 51: order.CheckoutQuote.grand_total:type_name -> money.Money
+	40,  // 52: order.CreateCheckoutSessionRequest.address:type_name -> order.Address
+	62,  // 53: order.CheckoutLine.price:type_name -> money.Money
+	62,  // 54: order.CheckoutLine.line_total:type_name -> money.Money
+	4,   // 55: order.CheckoutSession.status:type_name -> order.CheckoutSessionStatus
+	46,  // 56: order.CheckoutSession.lines:type_name -> order.CheckoutLine
+	40,  // 57: order.CheckoutSession.address:type_name -> order.Address
+	41,  // 58: order.CheckoutSession.shipping_option:type_name -> order.ShippingOption
+	62,  // 59: order.CheckoutSession.subtotal:type_name -> money.Money
+	62,  // 60: order.CheckoutSession.discount:type_name -> money.Money
+	62,  // 61: order.CheckoutSession.shipping:type_name -> money.Money
+	62,  // 62: order.CheckoutSession.tax:type_name -> money.Money
+	62,  // 63: order.CheckoutSession.grand_total:type_name -> money.Money
+	63,  // 64: order.CheckoutSession.expires_at:type_name -> google.protobuf.Timestamp
+	5,   // 65: order.Coupon.type:type_name -> order.CouponType
+	62,  // 66: order.Coupon.amount_off:type_name -> money.Money
+	62,  // 67: order.Coupon.min_basket:type_name -> money.Money
+	63,  // 68: order.Coupon.starts_at:type_name -> google.protobuf.Timestamp
+	63,  // 69: order.Coupon.ends_at:type_name -> google.protobuf.Timestamp
+	48,  // 70: order.CreateCouponRequest.coupon:type_name -> order.Coupon
+	48,  // 71: order.GetCouponResponse.coupon:type_name -> order.Coupon
+	52,  // 72: order.CreateSubscriptionRequest.items:type_name -> order.SubscriptionItem
+	40,  // 73: order.CreateSubscriptionRequest.address:type_name -> order.Address
+	7,   // 74: order.CreateSubscriptionRequest.payment_method:type_name -> order.PaymentMethod
+	63,  // 75: order.CreateSubscriptionRequest.first_order_at:type_name -> google.protobuf.Timestamp
+	8,   // 76: order.Subscription.status:type_name -> order.SubscriptionStatus
+	52,  // 77: order.Subscription.items:type_name -> order.SubscriptionItem
+	40,  // 78: order.Subscription.address:type_name -> order.Address
+	7,   // 79: order.Subscription.payment_method:type_name -> order.PaymentMethod
+	63,  // 80: order.Subscription.next_order_at:type_name -> google.protobuf.Timestamp
+	63,  // 81: order.Subscription.retry_at:type_name -> google.protobuf.Timestamp
+	63,  // 82: order.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 83: order.Subscription.cancelled_at:type_name -> google.protobuf.Timestamp
+	54,  // 84: order.ListSubscriptionsResponse.subscriptions:type_name -> order.Subscription
+	11,  // 85: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	14,  // 86: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
+	16,  // 87: order.OrderService.ListUserOrders:input_type -> order.ListOrdersRequest
+	15,  // 88: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	18,  // 89: order.OrderService.RequestReturn:input_type -> order.RequestReturnRequest
+	21,  // 90: order.OrderService.ListOrderReturns:input_type -> order.ListOrderReturnsRequest
+	23,  // 91: order.OrderService.GetReturn:input_type -> order.GetReturnRequest
+	24,  // 92: order.OrderService.ReviewReturn:input_type -> order.ReviewReturnRequest
+	26,  // 93: order.OrderService.ReceiveReturn:input_type -> order.ReceiveReturnRequest
+	28,  // 94: order.OrderService.CreateShipment:input_type -> order.CreateShipmentRequest
+	31,  // 95: order.OrderService.ListOrderShipments:input_type -> order.ListOrderShipmentsRequest
+	33,  // 96: order.OrderService.Reorder:input_type -> order.ReorderRequest
+	36,  // 97: order.OrderService.GetInvoice:input_type -> order.GetInvoiceRequest
+	38,  // 98: order.OrderService.HasPurchased:input_type -> order.HasPurchasedRequest
+	49,  // 99: order.OrderService.CreateCoupon:input_type -> order.CreateCouponRequest
+	50,  // 100: order.OrderService.GetCoupon:input_type -> order.GetCouponRequest
+	42,  // 101: order.OrderService.GetCheckoutQuote:input_type -> order.GetCheckoutQuoteRequest
+	44,  // 102: order.OrderService.CreateCheckoutSession:input_type -> order.CreateCheckoutSessionRequest
+	45,  // 103: order.OrderService.GetCheckoutSession:input_type -> order.GetCheckoutSessionRequest
+	53,  // 104: order.OrderService.CreateSubscription:input_type -> order.CreateSubscriptionRequest
+	55,  // 105: order.OrderService.ListSubscriptions:input_type -> order.ListSubscriptionsRequest
+	57,  // 106: order.OrderService.GetSubscription:input_type -> order.GetSubscriptionRequest
+	58,  // 107: order.OrderService.PauseSubscription:input_type -> order.PauseSubscriptionRequest
+	59,  // 108: order.OrderService.ResumeSubscription:input_type -> order.ResumeSubscriptionRequest
+	60,  // 109: order.OrderService.SkipSubscriptionDelivery:input_type -> order.SkipSubscriptionDeliveryRequest
+	61,  // 110: order.OrderService.CancelSubscription:input_type -> order.CancelSubscriptionRequest
+	12,  // 111: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	13,  // 112: order.OrderService.GetOrder:output_type -> order.Order
+	37,  // 113: order.OrderService.ListUserOrders:output_type -> order.ListOrdersResponse
+	13,  // 114: order.OrderService.CancelOrder:output_type -> order.Order
+	20,  // 115: order.OrderService.RequestReturn:output_type -> order.Return
+	22,  // 116: order.OrderService.ListOrderReturns:output_type -> order.ListOrderReturnsResponse
+	20,  // 117: order.OrderService.GetReturn:output_type -> order.Return
+	20,  // 118: order.OrderService.ReviewReturn:output_type -> order.Return
+	20,  // 119: order.OrderService.ReceiveReturn:output_type -> order.Return
+	30,  // 120: order.OrderService.CreateShipment:output_type -> order.Shipment
+	32,  // 121: order.OrderService.ListOrderShipments:output_type -> order.ListOrderShipmentsResponse
+	35,  // 122: order.OrderService.Reorder:output_type -> order.ReorderResponse
+	64,  // 123: order.OrderService.GetInvoice:output_type -> google.api.HttpBody
+	39,  // 124: order.OrderService.HasPurchased:output_type -> order.HasPurchasedResponse
+	48,  // 125: order.OrderService.CreateCoupon:output_type -> order.Coupon
+	51,  // 126: order.OrderService.GetCoupon:output_type -> order.GetCouponResponse
+	43,  // 127: order.OrderService.GetCheckoutQuote:output_type -> order.CheckoutQuote
+	47,  // 128: order.OrderService.CreateCheckoutSession:output_type -> order.CheckoutSession
+	47,  // 129: order.OrderService.GetCheckoutSession:output_type -> order.CheckoutSession
+	54,  // 130: order.OrderService.CreateSubscription:output_type -> order.Subscription
+	56,  // 131: order.OrderService.ListSubscriptions:output_type -> order.ListSubscriptionsResponse
+	54,  // 132: order.OrderService.GetSubscription:output_type -> order.Subscription
+	54,  // 133: order.OrderService.PauseSubscription:output_type -> order.Subscription
+	54,  // 134: order.OrderService.ResumeSubscription:output_type -> order.Subscription
+	54,  // 135: order.OrderService.SkipSubscriptionDelivery:output_type -> order.Subscription
+	54,  // 136: order.OrderService.CancelSubscription:output_type -> order.Subscription
+	111, // [111:137] is the sub-list for method output_type
+	85,  // [85:111] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
 }
 
 func init() { file_orders_proto_init() }
@@ -618,7 +4719,8 @@ func file_orders_proto_init() {
 	if File_orders_proto != nil {
 		return
 	}
-	file_orders_proto_msgTypes[1].OneofWrappers = []any{
+	file_money_proto_init()
+	file_orders_proto_msgTypes[2].OneofWrappers = []any{
 		(*CreateOrderRequest_PaymentIntentId)(nil),
 	}
 	type x struct{}
@@ -626,8 +4728,8 @@ func file_orders_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_proto_rawDesc), len(file_orders_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      9,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
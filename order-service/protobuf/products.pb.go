@@ -0,0 +1,3375 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: products.proto
+
+package protobuf
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PriceSource int32
+
+const (
+	PriceSource_BASE       PriceSource = 0
+	PriceSource_SALE       PriceSource = 1
+	PriceSource_PRICE_LIST PriceSource = 2
+)
+
+// Enum value maps for PriceSource.
+var (
+	PriceSource_name = map[int32]string{
+		0: "BASE",
+		1: "SALE",
+		2: "PRICE_LIST",
+	}
+	PriceSource_value = map[string]int32{
+		"BASE":       0,
+		"SALE":       1,
+		"PRICE_LIST": 2,
+	}
+)
+
+func (x PriceSource) Enum() *PriceSource {
+	p := new(PriceSource)
+	*p = x
+	return p
+}
+
+func (x PriceSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_products_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceSource) Type() protoreflect.EnumType {
+	return &file_products_proto_enumTypes[0]
+}
+
+func (x PriceSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceSource.Descriptor instead.
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{0}
+}
+
+type ProductType int32
+
+const (
+	ProductType_SIMPLE ProductType = 0
+	ProductType_BUNDLE ProductType = 1
+)
+
+// Enum value maps for ProductType.
+var (
+	ProductType_name = map[int32]string{
+		0: "SIMPLE",
+		1: "BUNDLE",
+	}
+	ProductType_value = map[string]int32{
+		"SIMPLE": 0,
+		"BUNDLE": 1,
+	}
+)
+
+func (x ProductType) Enum() *ProductType {
+	p := new(ProductType)
+	*p = x
+	return p
+}
+
+func (x ProductType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductType) Descriptor() protoreflect.EnumDescriptor {
+	return file_products_proto_enumTypes[1].Descriptor()
+}
+
+func (ProductType) Type() protoreflect.EnumType {
+	return &file_products_proto_enumTypes[1]
+}
+
+func (x ProductType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductType.Descriptor instead.
+func (ProductType) EnumDescriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{1}
+}
+
+type ProductSort int32
+
+const (
+	ProductSort_RELEVANCE ProductSort = 0
+	ProductSort_RATING    ProductSort = 1
+)
+
+// Enum value maps for ProductSort.
+var (
+	ProductSort_name = map[int32]string{
+		0: "RELEVANCE",
+		1: "RATING",
+	}
+	ProductSort_value = map[string]int32{
+		"RELEVANCE": 0,
+		"RATING":    1,
+	}
+)
+
+func (x ProductSort) Enum() *ProductSort {
+	p := new(ProductSort)
+	*p = x
+	return p
+}
+
+func (x ProductSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_products_proto_enumTypes[2].Descriptor()
+}
+
+func (ProductSort) Type() protoreflect.EnumType {
+	return &file_products_proto_enumTypes[2]
+}
+
+func (x ProductSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductSort.Descriptor instead.
+func (ProductSort) EnumDescriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{2}
+}
+
+type ReviewStatus int32
+
+const (
+	ReviewStatus_APPROVED ReviewStatus = 0
+	ReviewStatus_PENDING  ReviewStatus = 1
+	ReviewStatus_REJECTED ReviewStatus = 2
+)
+
+// Enum value maps for ReviewStatus.
+var (
+	ReviewStatus_name = map[int32]string{
+		0: "APPROVED",
+		1: "PENDING",
+		2: "REJECTED",
+	}
+	ReviewStatus_value = map[string]int32{
+		"APPROVED": 0,
+		"PENDING":  1,
+		"REJECTED": 2,
+	}
+)
+
+func (x ReviewStatus) Enum() *ReviewStatus {
+	p := new(ReviewStatus)
+	*p = x
+	return p
+}
+
+func (x ReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_products_proto_enumTypes[3].Descriptor()
+}
+
+func (ReviewStatus) Type() protoreflect.EnumType {
+	return &file_products_proto_enumTypes[3]
+}
+
+func (x ReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewStatus.Descriptor instead.
+func (ReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{3}
+}
+
+type RecommendationSource int32
+
+const (
+	RecommendationSource_FREQUENTLY_BOUGHT_TOGETHER RecommendationSource = 0
+	RecommendationSource_SIMILAR_PRODUCT            RecommendationSource = 1
+)
+
+// Enum value maps for RecommendationSource.
+var (
+	RecommendationSource_name = map[int32]string{
+		0: "FREQUENTLY_BOUGHT_TOGETHER",
+		1: "SIMILAR_PRODUCT",
+	}
+	RecommendationSource_value = map[string]int32{
+		"FREQUENTLY_BOUGHT_TOGETHER": 0,
+		"SIMILAR_PRODUCT":            1,
+	}
+)
+
+func (x RecommendationSource) Enum() *RecommendationSource {
+	p := new(RecommendationSource)
+	*p = x
+	return p
+}
+
+func (x RecommendationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_products_proto_enumTypes[4].Descriptor()
+}
+
+func (RecommendationSource) Type() protoreflect.EnumType {
+	return &file_products_proto_enumTypes[4]
+}
+
+func (x RecommendationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationSource.Descriptor instead.
+func (RecommendationSource) EnumDescriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{4}
+}
+
+type Product struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku             string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price           *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	StockQuantity   int32                  `protobuf:"varint,7,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category        string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,9,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Attributes      map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IsActive        bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Images          []*ProductImage        `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
+	RegularPrice    *Money                 `protobuf:"bytes,13,opt,name=regular_price,json=regularPrice,proto3" json:"regular_price,omitempty"`
+	PriceSource     PriceSource            `protobuf:"varint,14,opt,name=price_source,json=priceSource,proto3,enum=product.PriceSource" json:"price_source,omitempty"`
+	PriceValidUntil *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=price_valid_until,json=priceValidUntil,proto3" json:"price_valid_until,omitempty"`
+	AverageRating   float64                `protobuf:"fixed64,16,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	ReviewCount     int32                  `protobuf:"varint,17,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Type            ProductType            `protobuf:"varint,18,opt,name=type,proto3,enum=product.ProductType" json:"type,omitempty"`
+	Components      []*BundleComponent     `protobuf:"bytes,19,rep,name=components,proto3" json:"components,omitempty"`
+	// SKUs of the product's variants; each can have its own image gallery.
+	VariantSkus   []string `protobuf:"bytes,20,rep,name=variant_skus,json=variantSkus,proto3" json:"variant_skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_products_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Product) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Product) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Product) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Product) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Product) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Product) GetRegularPrice() *Money {
+	if x != nil {
+		return x.RegularPrice
+	}
+	return nil
+}
+
+func (x *Product) GetPriceSource() PriceSource {
+	if x != nil {
+		return x.PriceSource
+	}
+	return PriceSource_BASE
+}
+
+func (x *Product) GetPriceValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceValidUntil
+	}
+	return nil
+}
+
+func (x *Product) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *Product) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *Product) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_SIMPLE
+}
+
+func (x *Product) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Product) GetVariantSkus() []string {
+	if x != nil {
+		return x.VariantSkus
+	}
+	return nil
+}
+
+type BundleComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_products_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BundleComponent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ProductImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Thumbnails    map[string]string      `protobuf:"bytes,3,rep,name=thumbnails,proto3" json:"thumbnails,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	VariantSku    string                 `protobuf:"bytes,5,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	ContentType   string                 `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_products_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImage) ProtoMessage() {}
+
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductImage) GetThumbnails() map[string]string {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+func (x *ProductImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductImage) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *ProductImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// customer_group lets staff look at another group's prices. Everybody else gets the
+	// prices of the group in their token, or the default prices when signed out.
+	CustomerGroup string `protobuf:"bytes,2,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_products_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_products_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Query    string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page     int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Staff only, as in GetProductRequest.
+	CustomerGroup string      `protobuf:"bytes,4,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	MinRating     float64     `protobuf:"fixed64,5,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	Sort          ProductSort `protobuf:"varint,6,opt,name=sort,proto3,enum=product.ProductSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_products_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSort() ProductSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ProductSort_RELEVANCE
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_products_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	StockQuantity int32                  `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type          ProductType            `protobuf:"varint,10,opt,name=type,proto3,enum=product.ProductType" json:"type,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,11,rep,name=components,proto3" json:"components,omitempty"`
+	VariantSkus   []string               `protobuf:"bytes,12,rep,name=variant_skus,json=variantSkus,proto3" json:"variant_skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_products_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_SIMPLE
+}
+
+func (x *CreateProductRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetVariantSkus() []string {
+	if x != nil {
+		return x.VariantSkus
+	}
+	return nil
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_products_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	StockQuantity int32                  `protobuf:"varint,7,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,9,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type          ProductType            `protobuf:"varint,11,opt,name=type,proto3,enum=product.ProductType" json:"type,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,12,rep,name=components,proto3" json:"components,omitempty"`
+	VariantSkus   []string               `protobuf:"bytes,13,rep,name=variant_skus,json=variantSkus,proto3" json:"variant_skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_products_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_SIMPLE
+}
+
+func (x *UpdateProductRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetVariantSkus() []string {
+	if x != nil {
+		return x.VariantSkus
+	}
+	return nil
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_products_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_products_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_products_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{12}
+}
+
+type GetProductBySKURequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Staff only, as in GetProductRequest.
+	CustomerGroup string `protobuf:"bytes,2,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySKURequest) Reset() {
+	*x = GetProductBySKURequest{}
+	mi := &file_products_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySKURequest) ProtoMessage() {}
+
+func (x *GetProductBySKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySKURequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySKURequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetProductBySKURequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetProductBySKURequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type GetProductBySKUResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySKUResponse) Reset() {
+	*x = GetProductBySKUResponse{}
+	mi := &file_products_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySKUResponse) ProtoMessage() {}
+
+func (x *GetProductBySKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySKUResponse.ProtoReflect.Descriptor instead.
+func (*GetProductBySKUResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetProductBySKUResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UploadProductImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadProductImageRequest_Metadata
+	//	*UploadProductImageRequest_Chunk
+	Data          isUploadProductImageRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProductImageRequest) Reset() {
+	*x = UploadProductImageRequest{}
+	mi := &file_products_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProductImageRequest) ProtoMessage() {}
+
+func (x *UploadProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UploadProductImageRequest) GetData() isUploadProductImageRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadProductImageRequest) GetMetadata() *ImageMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadProductImageRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadProductImageRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadProductImageRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadProductImageRequest_Data interface {
+	isUploadProductImageRequest_Data()
+}
+
+type UploadProductImageRequest_Metadata struct {
+	Metadata *ImageMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadProductImageRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadProductImageRequest_Metadata) isUploadProductImageRequest_Data() {}
+
+func (*UploadProductImageRequest_Chunk) isUploadProductImageRequest_Data() {}
+
+type ImageMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantSku    string                 `protobuf:"bytes,2,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageMetadata) Reset() {
+	*x = ImageMetadata{}
+	mi := &file_products_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageMetadata) ProtoMessage() {}
+
+func (x *ImageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageMetadata.ProtoReflect.Descriptor instead.
+func (*ImageMetadata) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImageMetadata) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ImageMetadata) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+type UploadProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProductImageResponse) Reset() {
+	*x = UploadProductImageResponse{}
+	mi := &file_products_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProductImageResponse) ProtoMessage() {}
+
+func (x *UploadProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProductImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UploadProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ReorderProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantSku    string                 `protobuf:"bytes,2,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,3,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_products_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReorderProductImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReorderProductImagesRequest) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *ReorderProductImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type ReorderProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_products_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesResponse) ProtoMessage() {}
+
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReorderProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type DeleteProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageId       string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageRequest) Reset() {
+	*x = DeleteProductImageRequest{}
+	mi := &file_products_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageRequest) ProtoMessage() {}
+
+func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type DeleteProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageResponse) Reset() {
+	*x = DeleteProductImageResponse{}
+	mi := &file_products_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageResponse) ProtoMessage() {}
+
+func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{21}
+}
+
+type ScheduledPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,5,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledPrice) Reset() {
+	*x = ScheduledPrice{}
+	mi := &file_products_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPrice) ProtoMessage() {}
+
+func (x *ScheduledPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPrice.ProtoReflect.Descriptor instead.
+func (*ScheduledPrice) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScheduledPrice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledPrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ScheduledPrice) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduledPrice) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ScheduledPrice) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type SchedulePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,5,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceRequest) Reset() {
+	*x = SchedulePriceRequest{}
+	mi := &file_products_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceRequest) ProtoMessage() {}
+
+func (x *SchedulePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SchedulePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SchedulePriceRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type SchedulePriceResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledPrice *ScheduledPrice        `protobuf:"bytes,1,opt,name=scheduled_price,json=scheduledPrice,proto3" json:"scheduled_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SchedulePriceResponse) Reset() {
+	*x = SchedulePriceResponse{}
+	mi := &file_products_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceResponse) ProtoMessage() {}
+
+func (x *SchedulePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SchedulePriceResponse) GetScheduledPrice() *ScheduledPrice {
+	if x != nil {
+		return x.ScheduledPrice
+	}
+	return nil
+}
+
+type CancelScheduledPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledPriceRequest) Reset() {
+	*x = CancelScheduledPriceRequest{}
+	mi := &file_products_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPriceRequest) ProtoMessage() {}
+
+func (x *CancelScheduledPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPriceRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPriceRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelScheduledPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CancelScheduledPriceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelScheduledPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledPriceResponse) Reset() {
+	*x = CancelScheduledPriceResponse{}
+	mi := &file_products_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPriceResponse) ProtoMessage() {}
+
+func (x *CancelScheduledPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPriceResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPriceResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{26}
+}
+
+type PriceListEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceListEntry) Reset() {
+	*x = PriceListEntry{}
+	mi := &file_products_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListEntry) ProtoMessage() {}
+
+func (x *PriceListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListEntry.ProtoReflect.Descriptor instead.
+func (*PriceListEntry) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PriceListEntry) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PriceListEntry) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type UpsertPriceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerGroup string                 `protobuf:"bytes,1,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Entries       []*PriceListEntry      `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPriceListRequest) Reset() {
+	*x = UpsertPriceListRequest{}
+	mi := &file_products_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPriceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPriceListRequest) ProtoMessage() {}
+
+func (x *UpsertPriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPriceListRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPriceListRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpsertPriceListRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *UpsertPriceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertPriceListRequest) GetEntries() []*PriceListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type UpsertPriceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPriceListResponse) Reset() {
+	*x = UpsertPriceListResponse{}
+	mi := &file_products_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPriceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPriceListResponse) ProtoMessage() {}
+
+func (x *UpsertPriceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPriceListResponse.ProtoReflect.Descriptor instead.
+func (*UpsertPriceListResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{29}
+}
+
+type PriceHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPrice      *Money                 `protobuf:"bytes,1,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      *Money                 `protobuf:"bytes,2,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,3,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	mi := &file_products_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PriceHistoryEntry) GetOldPrice() *Money {
+	if x != nil {
+		return x.OldPrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_products_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PriceHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_products_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPriceHistoryResponse) GetEntries() []*PriceHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Review struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating           int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title            string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Body             string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	VerifiedPurchase bool                   `protobuf:"varint,7,opt,name=verified_purchase,json=verifiedPurchase,proto3" json:"verified_purchase,omitempty"`
+	Status           ReviewStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=product.ReviewStatus" json:"status,omitempty"`
+	ModerationNote   string                 `protobuf:"bytes,9,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_products_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Review) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Review) GetVerifiedPurchase() bool {
+	if x != nil {
+		return x.VerifiedPurchase
+	}
+	return false
+}
+
+func (x *Review) GetStatus() ReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReviewStatus_APPROVED
+}
+
+func (x *Review) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_products_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_products_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        ReviewStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=product.ReviewStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_products_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsRequest) ProtoMessage() {}
+
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListReviewsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetStatus() ReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReviewStatus_APPROVED
+}
+
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_products_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ModerateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        ReviewStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=product.ReviewStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_products_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ModerateReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetStatus() ReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReviewStatus_APPROVED
+}
+
+func (x *ModerateReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ModerateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewResponse) Reset() {
+	*x = ModerateReviewResponse{}
+	mi := &file_products_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewResponse) ProtoMessage() {}
+
+func (x *ModerateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewResponse.ProtoReflect.Descriptor instead.
+func (*ModerateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ModerateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Source        RecommendationSource   `protobuf:"varint,2,opt,name=source,proto3,enum=product.RecommendationSource" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_products_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Recommendation) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *Recommendation) GetSource() RecommendationSource {
+	if x != nil {
+		return x.Source
+	}
+	return RecommendationSource_FREQUENTLY_BOUGHT_TOGETHER
+}
+
+type GetRecommendationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Staff only, as in GetProductRequest.
+	CustomerGroup string `protobuf:"bytes,3,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_products_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetRecommendationsRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type GetRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_products_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetRecommendationsResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+var File_products_proto protoreflect.FileDescriptor
+
+const file_products_proto_rawDesc = "" +
+	"\n" +
+	"\x0eproducts.proto\x12\aproduct\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vmoney.proto\"\xbd\x06\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.money.MoneyR\x05price\x12%\n" +
+	"\x0estock_quantity\x18\a \x01(\x05R\rstockQuantity\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x1b\n" +
+	"\timage_url\x18\t \x01(\tR\bimageUrl\x12@\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2 .product.Product.AttributesEntryR\n" +
+	"attributes\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x12-\n" +
+	"\x06images\x18\f \x03(\v2\x15.product.ProductImageR\x06images\x121\n" +
+	"\rregular_price\x18\r \x01(\v2\f.money.MoneyR\fregularPrice\x127\n" +
+	"\fprice_source\x18\x0e \x01(\x0e2\x14.product.PriceSourceR\vpriceSource\x12F\n" +
+	"\x11price_valid_until\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0fpriceValidUntil\x12%\n" +
+	"\x0eaverage_rating\x18\x10 \x01(\x01R\raverageRating\x12!\n" +
+	"\freview_count\x18\x11 \x01(\x05R\vreviewCount\x12(\n" +
+	"\x04type\x18\x12 \x01(\x0e2\x14.product.ProductTypeR\x04type\x128\n" +
+	"\n" +
+	"components\x18\x13 \x03(\v2\x18.product.BundleComponentR\n" +
+	"components\x12!\n" +
+	"\fvariant_skus\x18\x14 \x03(\tR\vvariantSkus\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x06\x10\a\"?\n" +
+	"\x0fBundleComponent\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x96\x02\n" +
+	"\fProductImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12E\n" +
+	"\n" +
+	"thumbnails\x18\x03 \x03(\v2%.product.ProductImage.ThumbnailsEntryR\n" +
+	"thumbnails\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1f\n" +
+	"\vvariant_sku\x18\x05 \x01(\tR\n" +
+	"variantSku\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x1a=\n" +
+	"\x0fThumbnailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ecustomer_group\x18\x02 \x01(\tR\rcustomerGroup\"@\n" +
+	"\x12GetProductResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"\xcc\x01\n" +
+	"\x13ListProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12%\n" +
+	"\x0ecustomer_group\x18\x04 \x01(\tR\rcustomerGroup\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\x05 \x01(\x01R\tminRating\x12(\n" +
+	"\x04sort\x18\x06 \x01(\x0e2\x14.product.ProductSortR\x04sort\"D\n" +
+	"\x14ListProductsResponse\x12,\n" +
+	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\"\xfd\x03\n" +
+	"\x14CreateProductRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05price\x12%\n" +
+	"\x0estock_quantity\x18\x06 \x01(\x05R\rstockQuantity\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\x12M\n" +
+	"\n" +
+	"attributes\x18\t \x03(\v2-.product.CreateProductRequest.AttributesEntryR\n" +
+	"attributes\x12(\n" +
+	"\x04type\x18\n" +
+	" \x01(\x0e2\x14.product.ProductTypeR\x04type\x128\n" +
+	"\n" +
+	"components\x18\v \x03(\v2\x18.product.BundleComponentR\n" +
+	"components\x12!\n" +
+	"\fvariant_skus\x18\f \x03(\tR\vvariantSkus\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06\"'\n" +
+	"\x15CreateProductResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8d\x04\n" +
+	"\x14UpdateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.money.MoneyR\x05price\x12%\n" +
+	"\x0estock_quantity\x18\a \x01(\x05R\rstockQuantity\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x1b\n" +
+	"\timage_url\x18\t \x01(\tR\bimageUrl\x12M\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2-.product.UpdateProductRequest.AttributesEntryR\n" +
+	"attributes\x12(\n" +
+	"\x04type\x18\v \x01(\x0e2\x14.product.ProductTypeR\x04type\x128\n" +
+	"\n" +
+	"components\x18\f \x03(\v2\x18.product.BundleComponentR\n" +
+	"components\x12!\n" +
+	"\fvariant_skus\x18\r \x03(\tR\vvariantSkus\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x06\x10\a\"C\n" +
+	"\x15UpdateProductResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteProductResponse\"Q\n" +
+	"\x16GetProductBySKURequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12%\n" +
+	"\x0ecustomer_group\x18\x02 \x01(\tR\rcustomerGroup\"E\n" +
+	"\x17GetProductBySKUResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"q\n" +
+	"\x19UploadProductImageRequest\x124\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x16.product.ImageMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"O\n" +
+	"\rImageMetadata\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vvariant_sku\x18\x02 \x01(\tR\n" +
+	"variantSku\"I\n" +
+	"\x1aUploadProductImageResponse\x12+\n" +
+	"\x05image\x18\x01 \x01(\v2\x15.product.ProductImageR\x05image\"z\n" +
+	"\x1bReorderProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vvariant_sku\x18\x02 \x01(\tR\n" +
+	"variantSku\x12\x1b\n" +
+	"\timage_ids\x18\x03 \x03(\tR\bimageIds\"M\n" +
+	"\x1cReorderProductImagesResponse\x12-\n" +
+	"\x06images\x18\x01 \x03(\v2\x15.product.ProductImageR\x06images\"U\n" +
+	"\x19DeleteProductImageRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x19\n" +
+	"\bimage_id\x18\x02 \x01(\tR\aimageId\"\x1c\n" +
+	"\x1aDeleteProductImageResponse\"\xd9\x01\n" +
+	"\x0eScheduledPrice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\x05price\x18\x02 \x01(\v2\f.money.MoneyR\x05price\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12%\n" +
+	"\x0ecustomer_group\x18\x05 \x01(\tR\rcustomerGroup\"\xee\x01\n" +
+	"\x14SchedulePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\"\n" +
+	"\x05price\x18\x02 \x01(\v2\f.money.MoneyR\x05price\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12%\n" +
+	"\x0ecustomer_group\x18\x05 \x01(\tR\rcustomerGroup\"Y\n" +
+	"\x15SchedulePriceResponse\x12@\n" +
+	"\x0fscheduled_price\x18\x01 \x01(\v2\x17.product.ScheduledPriceR\x0escheduledPrice\"L\n" +
+	"\x1bCancelScheduledPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cCancelScheduledPriceResponse\"F\n" +
+	"\x0ePriceListEntry\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\"\n" +
+	"\x05price\x18\x02 \x01(\v2\f.money.MoneyR\x05price\"\x86\x01\n" +
+	"\x16UpsertPriceListRequest\x12%\n" +
+	"\x0ecustomer_group\x18\x01 \x01(\tR\rcustomerGroup\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\aentries\x18\x03 \x03(\v2\x17.product.PriceListEntryR\aentries\"\x19\n" +
+	"\x17UpsertPriceListResponse\"\xe3\x01\n" +
+	"\x11PriceHistoryEntry\x12)\n" +
+	"\told_price\x18\x01 \x01(\v2\f.money.MoneyR\boldPrice\x12)\n" +
+	"\tnew_price\x18\x02 \x01(\v2\f.money.MoneyR\bnewPrice\x12%\n" +
+	"\x0ecustomer_group\x18\x03 \x01(\tR\rcustomerGroup\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"M\n" +
+	"\x16GetPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"O\n" +
+	"\x17GetPriceHistoryResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.product.PriceHistoryEntryR\aentries\"\xd2\x02\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12+\n" +
+	"\x11verified_purchase\x18\a \x01(\bR\x10verifiedPurchase\x12-\n" +
+	"\x06status\x18\b \x01(\x0e2\x15.product.ReviewStatusR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\t \x01(\tR\x0emoderationNote\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"v\n" +
+	"\x13CreateReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"?\n" +
+	"\x14CreateReviewResponse\x12'\n" +
+	"\x06review\x18\x01 \x01(\v2\x0f.product.ReviewR\x06review\"\x93\x01\n" +
+	"\x12ListReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.product.ReviewStatusR\x06status\"V\n" +
+	"\x13ListReviewsResponse\x12)\n" +
+	"\areviews\x18\x01 \x03(\v2\x0f.product.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"j\n" +
+	"\x15ModerateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.product.ReviewStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"A\n" +
+	"\x16ModerateReviewResponse\x12'\n" +
+	"\x06review\x18\x01 \x01(\v2\x0f.product.ReviewR\x06review\"s\n" +
+	"\x0eRecommendation\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\x125\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x1d.product.RecommendationSourceR\x06source\"j\n" +
+	"\x19GetRecommendationsRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12%\n" +
+	"\x0ecustomer_group\x18\x03 \x01(\tR\rcustomerGroup\"_\n" +
+	"\x1aGetRecommendationsResponse\x12A\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x17.product.RecommendationR\x0frecommendations*1\n" +
+	"\vPriceSource\x12\b\n" +
+	"\x04BASE\x10\x00\x12\b\n" +
+	"\x04SALE\x10\x01\x12\x0e\n" +
+	"\n" +
+	"PRICE_LIST\x10\x02*%\n" +
+	"\vProductType\x12\n" +
+	"\n" +
+	"\x06SIMPLE\x10\x00\x12\n" +
+	"\n" +
+	"\x06BUNDLE\x10\x01*(\n" +
+	"\vProductSort\x12\r\n" +
+	"\tRELEVANCE\x10\x00\x12\n" +
+	"\n" +
+	"\x06RATING\x10\x01*7\n" +
+	"\fReviewStatus\x12\f\n" +
+	"\bAPPROVED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bREJECTED\x10\x02*K\n" +
+	"\x14RecommendationSource\x12\x1e\n" +
+	"\x1aFREQUENTLY_BOUGHT_TOGETHER\x10\x00\x12\x13\n" +
+	"\x0fSIMILAR_PRODUCT\x10\x012\xc6\x10\n" +
+	"\x15ProductCatalogService\x12d\n" +
+	"\n" +
+	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/products/{id}\x12e\n" +
+	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/products\x12k\n" +
+	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/products\x12p\n" +
+	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/api/v1/products/{id}\x12m\n" +
+	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/products/{id}\x12T\n" +
+	"\x0fGetProductBySKU\x12\x1f.product.GetProductBySKURequest\x1a .product.GetProductBySKUResponse\x12_\n" +
+	"\x12UploadProductImage\x12\".product.UploadProductImageRequest\x1a#.product.UploadProductImageResponse(\x01\x12\x94\x01\n" +
+	"\x14ReorderProductImages\x12$.product.ReorderProductImagesRequest\x1a%.product.ReorderProductImagesResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/products/{product_id}/images\x12\x96\x01\n" +
+	"\x12DeleteProductImage\x12\".product.DeleteProductImageRequest\x1a#.product.DeleteProductImageResponse\"7\x82\xd3\xe4\x93\x021*//api/v1/products/{product_id}/images/{image_id}\x12\x7f\n" +
+	"\rSchedulePrice\x12\x1d.product.SchedulePriceRequest\x1a\x1e.product.SchedulePriceResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/products/{product_id}/prices\x12\x96\x01\n" +
+	"\x14CancelScheduledPrice\x12$.product.CancelScheduledPriceRequest\x1a%.product.CancelScheduledPriceResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/products/{product_id}/prices/{id}\x12\x85\x01\n" +
+	"\x0fUpsertPriceList\x12\x1f.product.UpsertPriceListRequest\x1a .product.UpsertPriceListResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/price-lists/{customer_group}\x12\x8a\x01\n" +
+	"\x0fGetPriceHistory\x12\x1f.product.GetPriceHistoryRequest\x1a .product.GetPriceHistoryResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/products/{product_id}/prices/history\x12}\n" +
+	"\fCreateReview\x12\x1c.product.CreateReviewRequest\x1a\x1d.product.CreateReviewResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/products/{product_id}/reviews\x12w\n" +
+	"\vListReviews\x12\x1b.product.ListReviewsRequest\x1a\x1c.product.ListReviewsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/products/{product_id}/reviews\x12}\n" +
+	"\x0eModerateReview\x12\x1e.product.ModerateReviewRequest\x1a\x1f.product.ModerateReviewResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/reviews/{id}/moderation\x12\x84\x01\n" +
+	"\x12GetRecommendations\x12\".product.GetRecommendationsRequest\x1a#.product.GetRecommendationsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/recommendations/{sku}B\vZ\t/protobufb\x06proto3"
+
+var (
+	file_products_proto_rawDescOnce sync.Once
+	file_products_proto_rawDescData []byte
+)
+
+func file_products_proto_rawDescGZIP() []byte {
+	file_products_proto_rawDescOnce.Do(func() {
+		file_products_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_products_proto_rawDesc), len(file_products_proto_rawDesc)))
+	})
+	return file_products_proto_rawDescData
+}
+
+var file_products_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_products_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_products_proto_goTypes = []any{
+	(PriceSource)(0),                     // 0: product.PriceSource
+	(ProductType)(0),                     // 1: product.ProductType
+	(ProductSort)(0),                     // 2: product.ProductSort
+	(ReviewStatus)(0),                    // 3: product.ReviewStatus
+	(RecommendationSource)(0),            // 4: product.RecommendationSource
+	(*Product)(nil),                      // 5: product.Product
+	(*BundleComponent)(nil),              // 6: product.BundleComponent
+	(*ProductImage)(nil),                 // 7: product.ProductImage
+	(*GetProductRequest)(nil),            // 8: product.GetProductRequest
+	(*GetProductResponse)(nil),           // 9: product.GetProductResponse
+	(*ListProductsRequest)(nil),          // 10: product.ListProductsRequest
+	(*ListProductsResponse)(nil),         // 11: product.ListProductsResponse
+	(*CreateProductRequest)(nil),         // 12: product.CreateProductRequest
+	(*CreateProductResponse)(nil),        // 13: product.CreateProductResponse
+	(*UpdateProductRequest)(nil),         // 14: product.UpdateProductRequest
+	(*UpdateProductResponse)(nil),        // 15: product.UpdateProductResponse
+	(*DeleteProductRequest)(nil),         // 16: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),        // 17: product.DeleteProductResponse
+	(*GetProductBySKURequest)(nil),       // 18: product.GetProductBySKURequest
+	(*GetProductBySKUResponse)(nil),      // 19: product.GetProductBySKUResponse
+	(*UploadProductImageRequest)(nil),    // 20: product.UploadProductImageRequest
+	(*ImageMetadata)(nil),                // 21: product.ImageMetadata
+	(*UploadProductImageResponse)(nil),   // 22: product.UploadProductImageResponse
+	(*ReorderProductImagesRequest)(nil),  // 23: product.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil), // 24: product.ReorderProductImagesResponse
+	(*DeleteProductImageRequest)(nil),    // 25: product.DeleteProductImageRequest
+	(*DeleteProductImageResponse)(nil),   // 26: product.DeleteProductImageResponse
+	(*ScheduledPrice)(nil),               // 27: product.ScheduledPrice
+	(*SchedulePriceRequest)(nil),         // 28: product.SchedulePriceRequest
+	(*SchedulePriceResponse)(nil),        // 29: product.SchedulePriceResponse
+	(*CancelScheduledPriceRequest)(nil),  // 30: product.CancelScheduledPriceRequest
+	(*CancelScheduledPriceResponse)(nil), // 31: product.CancelScheduledPriceResponse
+	(*PriceListEntry)(nil),               // 32: product.PriceListEntry
+	(*UpsertPriceListRequest)(nil),       // 33: product.UpsertPriceListRequest
+	(*UpsertPriceListResponse)(nil),      // 34: product.UpsertPriceListResponse
+	(*PriceHistoryEntry)(nil),            // 35: product.PriceHistoryEntry
+	(*GetPriceHistoryRequest)(nil),       // 36: product.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),      // 37: product.GetPriceHistoryResponse
+	(*Review)(nil),                       // 38: product.Review
+	(*CreateReviewRequest)(nil),          // 39: product.CreateReviewRequest
+	(*CreateReviewResponse)(nil),         // 40: product.CreateReviewResponse
+	(*ListReviewsRequest)(nil),           // 41: product.ListReviewsRequest
+	(*ListReviewsResponse)(nil),          // 42: product.ListReviewsResponse
+	(*ModerateReviewRequest)(nil),        // 43: product.ModerateReviewRequest
+	(*ModerateReviewResponse)(nil),       // 44: product.ModerateReviewResponse
+	(*Recommendation)(nil),               // 45: product.Recommendation
+	(*GetRecommendationsRequest)(nil),    // 46: product.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil),   // 47: product.GetRecommendationsResponse
+	nil,                                  // 48: product.Product.AttributesEntry
+	nil,                                  // 49: product.ProductImage.ThumbnailsEntry
+	nil,                                  // 50: product.CreateProductRequest.AttributesEntry
+	nil,                                  // 51: product.UpdateProductRequest.AttributesEntry
+	(*Money)(nil),                        // 52: money.Money
+	(*timestamppb.Timestamp)(nil),        // 53: google.protobuf.Timestamp
+}
+var file_products_proto_depIdxs = []int32{
+	52, // 0: product.Product.price:type_name -> money.Money
+	48, // 1: product.Product.attributes:type_name -> product.Product.AttributesEntry
+	7,  // 2: product.Product.images:type_name -> product.ProductImage
+	52, // 3: product.Product.regular_price:type_name -> money.Money
+	0,  // 4: product.Product.price_source:type_name -> product.PriceSource
+	53, // 5: product.Product.price_valid_until:type_name -> google.protobuf.Timestamp
+	1,  // 6: product.Product.type:type_name -> product.ProductType
+	6,  // 7: product.Product.components:type_name -> product.BundleComponent
+	49, // 8: product.ProductImage.thumbnails:type_name -> product.ProductImage.ThumbnailsEntry
+	5,  // 9: product.GetProductResponse.product:type_name -> product.Product
+	2,  // 10: product.ListProductsRequest.sort:type_name -> product.ProductSort
+	5,  // 11: product.ListProductsResponse.products:type_name -> product.Product
+	52, // 12: product.CreateProductRequest.price:type_name -> money.Money
+	50, // 13: product.CreateProductRequest.attributes:type_name -> product.CreateProductRequest.AttributesEntry
+	1,  // 14: product.CreateProductRequest.type:type_name -> product.ProductType
+	6,  // 15: product.CreateProductRequest.components:type_name -> product.BundleComponent
+	52, // 16: product.UpdateProductRequest.price:type_name -> money.Money
+	51, // 17: product.UpdateProductRequest.attributes:type_name -> product.UpdateProductRequest.AttributesEntry
+	1,  // 18: product.UpdateProductRequest.type:type_name -> product.ProductType
+	6,  // 19: product.UpdateProductRequest.components:type_name -> product.BundleComponent
+	5,  // 20: product.UpdateProductResponse.product:type_name -> product.Product
+	5,  // 21: product.GetProductBySKUResponse.product:type_name -> product.Product
+	21, // 22: product.UploadProductImageRequest.metadata:type_name -> product.ImageMetadata
+	7,  // 23: product.UploadProductImageResponse.image:type_name -> product.ProductImage
+	7,  // 24: product.ReorderProductImagesResponse.images:type_name -> product.ProductImage
+	52, // 25: product.ScheduledPrice.price:type_name -> money.Money
+	53, // 26: product.ScheduledPrice.starts_at:type_name -> google.protobuf.Timestamp
+	53, // 27: product.ScheduledPrice.ends_at:type_name -> google.protobuf.Timestamp
+	52, // 28: product.SchedulePriceRequest.price:type_name -> money.Money
+	53, // 29: product.SchedulePriceRequest.starts_at:type_name -> google.protobuf.Timestamp
+	53, // 30: product.SchedulePriceRequest.ends_at:type_name -> google.protobuf.Timestamp
+	27, // 31: product.SchedulePriceResponse.scheduled_price:type_name -> product.ScheduledPrice
+	52, // 32: product.PriceListEntry.price:type_name -> money.Money
+	32, // 33: product.UpsertPriceListRequest.entries:type_name -> product.PriceListEntry
+	52, // 34: product.PriceHistoryEntry.old_price:type_name -> money.Money
+	52, // 35: product.PriceHistoryEntry.new_price:type_name -> money.Money
+	53, // 36: product.PriceHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	35, // 37: product.GetPriceHistoryResponse.entries:type_name -> product.PriceHistoryEntry
+	3,  // 38: product.Review.status:type_name -> product.ReviewStatus
+	53, // 39: product.Review.created_at:type_name -> google.protobuf.Timestamp
+	38, // 40: product.CreateReviewResponse.review:type_name -> product.Review
+	3,  // 41: product.ListReviewsRequest.status:type_name -> product.ReviewStatus
+	38, // 42: product.ListReviewsResponse.reviews:type_name -> product.Review
+	3,  // 43: product.ModerateReviewRequest.status:type_name -> product.ReviewStatus
+	38, // 44: product.ModerateReviewResponse.review:type_name -> product.Review
+	5,  // 45: product.Recommendation.product:type_name -> product.Product
+	4,  // 46: product.Recommendation.source:type_name -> product.RecommendationSource
+	45, // 47: product.GetRecommendationsResponse.recommendations:type_name -> product.Recommendation
+	8,  // 48: product.ProductCatalogService.GetProduct:input_type -> product.GetProductRequest
+	10, // 49: product.ProductCatalogService.ListProducts:input_type -> product.ListProductsRequest
+	12, // 50: product.ProductCatalogService.CreateProduct:input_type -> product.CreateProductRequest
+	14, // 51: product.ProductCatalogService.UpdateProduct:input_type -> product.UpdateProductRequest
+	16, // 52: product.ProductCatalogService.DeleteProduct:input_type -> product.DeleteProductRequest
+	18, // 53: product.ProductCatalogService.GetProductBySKU:input_type -> product.GetProductBySKURequest
+	20, // 54: product.ProductCatalogService.UploadProductImage:input_type -> product.UploadProductImageRequest
+	23, // 55: product.ProductCatalogService.ReorderProductImages:input_type -> product.ReorderProductImagesRequest
+	25, // 56: product.ProductCatalogService.DeleteProductImage:input_type -> product.DeleteProductImageRequest
+	28, // 57: product.ProductCatalogService.SchedulePrice:input_type -> product.SchedulePriceRequest
+	30, // 58: product.ProductCatalogService.CancelScheduledPrice:input_type -> product.CancelScheduledPriceRequest
+	33, // 59: product.ProductCatalogService.UpsertPriceList:input_type -> product.UpsertPriceListRequest
+	36, // 60: product.ProductCatalogService.GetPriceHistory:input_type -> product.GetPriceHistoryRequest
+	39, // 61: product.ProductCatalogService.CreateReview:input_type -> product.CreateReviewRequest
+	41, // 62: product.ProductCatalogService.ListReviews:input_type -> product.ListReviewsRequest
+	43, // 63: product.ProductCatalogService.ModerateReview:input_type -> product.ModerateReviewRequest
+	46, // 64: product.ProductCatalogService.GetRecommendations:input_type -> product.GetRecommendationsRequest
+	9,  // 65: product.ProductCatalogService.GetProduct:output_type -> product.GetProductResponse
+	11, // 66: product.ProductCatalogService.ListProducts:output_type -> product.ListProductsResponse
+	13, // 67: product.ProductCatalogService.CreateProduct:output_type -> product.CreateProductResponse
+	15, // 68: product.ProductCatalogService.UpdateProduct:output_type -> product.UpdateProductResponse
+	17, // 69: product.ProductCatalogService.DeleteProduct:output_type -> product.DeleteProductResponse
+	19, // 70: product.ProductCatalogService.GetProductBySKU:output_type -> product.GetProductBySKUResponse
+	22, // 71: product.ProductCatalogService.UploadProductImage:output_type -> product.UploadProductImageResponse
+	24, // 72: product.ProductCatalogService.ReorderProductImages:output_type -> product.ReorderProductImagesResponse
+	26, // 73: product.ProductCatalogService.DeleteProductImage:output_type -> product.DeleteProductImageResponse
+	29, // 74: product.ProductCatalogService.SchedulePrice:output_type -> product.SchedulePriceResponse
+	31, // 75: product.ProductCatalogService.CancelScheduledPrice:output_type -> product.CancelScheduledPriceResponse
+	34, // 76: product.ProductCatalogService.UpsertPriceList:output_type -> product.UpsertPriceListResponse
+	37, // 77: product.ProductCatalogService.GetPriceHistory:output_type -> product.GetPriceHistoryResponse
+	40, // 78: product.ProductCatalogService.CreateReview:output_type -> product.CreateReviewResponse
+	42, // 79: product.ProductCatalogService.ListReviews:output_type -> product.ListReviewsResponse
+	44, // 80: product.ProductCatalogService.ModerateReview:output_type -> product.ModerateReviewResponse
+	47, // 81: product.ProductCatalogService.GetRecommendations:output_type -> product.GetRecommendationsResponse
+	65, // [65:82] is the sub-list for method output_type
+	48, // [48:65] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_products_proto_init() }
+func file_products_proto_init() {
+	if File_products_proto != nil {
+		return
+	}
+	file_money_proto_init()
+	file_products_proto_msgTypes[15].OneofWrappers = []any{
+		(*UploadProductImageRequest_Metadata)(nil),
+		(*UploadProductImageRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_products_proto_rawDesc), len(file_products_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   47,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_products_proto_goTypes,
+		DependencyIndexes: file_products_proto_depIdxs,
+		EnumInfos:         file_products_proto_enumTypes,
+		MessageInfos:      file_products_proto_msgTypes,
+	}.Build()
+	File_products_proto = out.File
+	file_products_proto_goTypes = nil
+	file_products_proto_depIdxs = nil
+}
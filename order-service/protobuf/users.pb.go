@@ -342,6 +342,94 @@ func (x *GetUserResponse) GetLastName() string {
 	return ""
 }
 
+type GetUserByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIDRequest) Reset() {
+	*x = GetUserByIDRequest{}
+	mi := &file_users_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIDRequest) ProtoMessage() {}
+
+func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByIDRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserByIDRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByEmailRequest) Reset() {
+	*x = GetUserByEmailRequest{}
+	mi := &file_users_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByEmailRequest) ProtoMessage() {}
+
+func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -353,7 +441,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_users_proto_msgTypes[6]
+	mi := &file_users_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +453,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[6]
+	mi := &file_users_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +466,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{6}
+	return file_users_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateUserRequest) GetEmail() string {
@@ -410,7 +498,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_users_proto_msgTypes[7]
+	mi := &file_users_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -422,7 +510,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[7]
+	mi := &file_users_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +523,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{7}
+	return file_users_proto_rawDescGZIP(), []int{9}
 }
 
 var File_users_proto protoreflect.FileDescriptor
@@ -463,19 +551,25 @@ const file_users_proto_rawDesc = "" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
-	"\tlast_name\x18\x04 \x01(\tR\blastName\"e\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\"-\n" +
+	"\x12GetUserByIDRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"-\n" +
+	"\x15GetUserByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"e\n" +
 	"\x11UpdateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\"\x14\n" +
-	"\x12UpdateUserResponse2\x80\x03\n" +
+	"\x12UpdateUserResponse2\x86\x04\n" +
 	"\vUserService\x12T\n" +
 	"\fAuthenticate\x12\x11.user.AuthRequest\x1a\x12.user.AuthResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12[\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/register\x12X\n" +
 	"\n" +
 	"GetProfile\x12\x14.user.GetUserRequest\x1a\x15.user.GetUserResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/users/profile\x12d\n" +
-	"\rUpdateProfile\x12\x17.user.UpdateUserRequest\x1a\x18.user.UpdateUserResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/v1/users/profileB\vZ\t/protobufb\x06proto3"
+	"\rUpdateProfile\x12\x17.user.UpdateUserRequest\x1a\x18.user.UpdateUserResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/v1/users/profile\x12>\n" +
+	"\vGetUserByID\x12\x18.user.GetUserByIDRequest\x1a\x15.user.GetUserResponse\x12D\n" +
+	"\x0eGetUserByEmail\x12\x1b.user.GetUserByEmailRequest\x1a\x15.user.GetUserResponseB\vZ\t/protobufb\x06proto3"
 
 var (
 	file_users_proto_rawDescOnce sync.Once
@@ -489,28 +583,34 @@ func file_users_proto_rawDescGZIP() []byte {
 	return file_users_proto_rawDescData
 }
 
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_users_proto_goTypes = []any{
-	(*RegisterRequest)(nil),    // 0: user.RegisterRequest
-	(*RegisterResponse)(nil),   // 1: user.RegisterResponse
-	(*AuthRequest)(nil),        // 2: user.AuthRequest
-	(*AuthResponse)(nil),       // 3: user.AuthResponse
-	(*GetUserRequest)(nil),     // 4: user.GetUserRequest
-	(*GetUserResponse)(nil),    // 5: user.GetUserResponse
-	(*UpdateUserRequest)(nil),  // 6: user.UpdateUserRequest
-	(*UpdateUserResponse)(nil), // 7: user.UpdateUserResponse
+	(*RegisterRequest)(nil),       // 0: user.RegisterRequest
+	(*RegisterResponse)(nil),      // 1: user.RegisterResponse
+	(*AuthRequest)(nil),           // 2: user.AuthRequest
+	(*AuthResponse)(nil),          // 3: user.AuthResponse
+	(*GetUserRequest)(nil),        // 4: user.GetUserRequest
+	(*GetUserResponse)(nil),       // 5: user.GetUserResponse
+	(*GetUserByIDRequest)(nil),    // 6: user.GetUserByIDRequest
+	(*GetUserByEmailRequest)(nil), // 7: user.GetUserByEmailRequest
+	(*UpdateUserRequest)(nil),     // 8: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),    // 9: user.UpdateUserResponse
 }
 var file_users_proto_depIdxs = []int32{
 	2, // 0: user.UserService.Authenticate:input_type -> user.AuthRequest
 	0, // 1: user.UserService.Register:input_type -> user.RegisterRequest
 	4, // 2: user.UserService.GetProfile:input_type -> user.GetUserRequest
-	6, // 3: user.UserService.UpdateProfile:input_type -> user.UpdateUserRequest
-	3, // 4: user.UserService.Authenticate:output_type -> user.AuthResponse
-	1, // 5: user.UserService.Register:output_type -> user.RegisterResponse
-	5, // 6: user.UserService.GetProfile:output_type -> user.GetUserResponse
-	7, // 7: user.UserService.UpdateProfile:output_type -> user.UpdateUserResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	8, // 3: user.UserService.UpdateProfile:input_type -> user.UpdateUserRequest
+	6, // 4: user.UserService.GetUserByID:input_type -> user.GetUserByIDRequest
+	7, // 5: user.UserService.GetUserByEmail:input_type -> user.GetUserByEmailRequest
+	3, // 6: user.UserService.Authenticate:output_type -> user.AuthResponse
+	1, // 7: user.UserService.Register:output_type -> user.RegisterResponse
+	5, // 8: user.UserService.GetProfile:output_type -> user.GetUserResponse
+	9, // 9: user.UserService.UpdateProfile:output_type -> user.UpdateUserResponse
+	5, // 10: user.UserService.GetUserByID:output_type -> user.GetUserResponse
+	5, // 11: user.UserService.GetUserByEmail:output_type -> user.GetUserResponse
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -527,7 +627,7 @@ func file_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_proto_rawDesc), len(file_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,7 @@ import (
 	"payment-service/internal/server"
 	"payment-service/internal/service"
 	pb "payment-service/protobuf"
+	"shared/idempotency"
 	"strings"
 	"syscall"
 	"time"
@@ -52,6 +53,10 @@ func run() error {
 
 	stripe.Key = cfg.Stripe.SecretKey
 
+	if cfg.Idempotency.KeyTTL <= 0 {
+		return errors.New("IDEMPOTENCY_KEY_TTL must be a positive duration")
+	}
+
 	// Database connection
 	addr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
 		cfg.DB.User, cfg.DB.Password, cfg.DB.Host,
@@ -69,15 +74,15 @@ func run() error {
 		return err
 	}
 
-	// gRPC client to Shopping Cart Service
-	cartAddr := fmt.Sprintf("%s:%s", cfg.CartClient.Host, cfg.CartClient.Port)
-	cartClient, err := grpc.NewClient(cartAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// gRPC client to Order Service, which quotes the amount to charge
+	orderAddr := fmt.Sprintf("%s:%s", cfg.OrderClient.Host, cfg.OrderClient.Port)
+	orderConn, err := grpc.NewClient(orderAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return err
 	}
-	defer cartClient.Close()
+	defer orderConn.Close()
 
-	client := pb.NewShoppingCartServiceClient(cartClient)
+	client := pb.NewOrderServiceClient(orderConn)
 
 	// Repository
 	repo := repository.New(conn)
@@ -100,15 +105,24 @@ func run() error {
 	}
 	defer paymentSucceedWriter.Close()
 
+	returnRefundedWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "returns.refunded",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer returnRefundedWriter.Close()
+
 	// Service
-	svc := service.New(repo, client, paymentFailedWriter, paymentSucceedWriter)
+	svc := service.New(repo, client, paymentFailedWriter, paymentSucceedWriter, returnRefundedWriter)
 
 	// Kafka consumer
 	cons := consumer.New(svc, cfg)
 	cons.Start()
 
+	idempotent := idempotency.New(repo, cfg.Idempotency.KeyTTL, pb.PaymentService_ProcessPayment_FullMethodName)
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(AuthInterceptor),
+		grpc.ChainUnaryInterceptor(AuthInterceptor, idempotent.Unary),
 	)
 
 	pb.RegisterPaymentServiceServer(s, &server.Server{
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type Config struct {
@@ -16,9 +17,12 @@ type Config struct {
 	Server struct {
 		Port string `env:"SERVER_PORT" envDefault:":8080"`
 	}
-	CartClient struct {
-		Host string `env:"CART_HOST" envDefault:"shopping-cart-service"`
-		Port string `env:"CART_PORT" envDefault:"8080"`
+	OrderClient struct {
+		Host string `env:"ORDER_HOST" envDefault:"order-service"`
+		Port string `env:"ORDER_PORT" envDefault:"8080"`
+	}
+	Idempotency struct {
+		KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
 	}
 	Kafka struct {
 		Host string `env:"KAFKA_HOST" envDefault:"kafka"`
@@ -35,6 +35,9 @@ func (c *Consumer) Start() {
 	listeners := map[string]HandlerFunc{
 		"orders.created":           c.handleOrderCreated,
 		"stock.reservation.failed": c.handleStockReservationFailed,
+		"checkout.expired":         c.handleCheckoutExpired,
+		"orders.cancelled":         c.handleOrderCancelled,
+		"returns.received":         c.handleReturnReceived,
 	}
 
 	for topic, handler := range listeners {
@@ -106,3 +109,39 @@ func (c *Consumer) handleStockReservationFailed(ctx context.Context, m *kafka.Me
 
 	return err
 }
+
+func (c *Consumer) handleCheckoutExpired(ctx context.Context, m *kafka.Message) error {
+	var event service.CheckoutEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.ReleaseCheckoutPayment(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleOrderCancelled(ctx context.Context, m *kafka.Message) error {
+	var event service.OrderCreatedEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.RefundOrder(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleReturnReceived(ctx context.Context, m *kafka.Message) error {
+	var event service.ReturnEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.RefundReturn(ctx, event.Data)
+
+	return err
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"shared/money"
 	"time"
 )
 
@@ -20,20 +21,23 @@ const (
 	OnDelivery PaymentMethod = "ON_DELIVERY"
 )
 
-type Currency string
-
-const (
-	EUR Currency = "EUR"
-	USD Currency = "USD"
-)
-
 type Transaction struct {
 	ID                   int64         `json:"id"`
 	OrderID              *int64        `json:"order_id"`
-	Amount               float64       `json:"amount"`
-	Currency             Currency      `json:"currency"`
+	Amount               money.Money   `json:"amount"`
 	Status               Status        `json:"Status"`
 	GatewayTransactionID *string       `json:"gateway_transaction_id"`
 	PaymentMethod        PaymentMethod `json:"payment_method"`
+	CheckoutSessionID    *string       `json:"checkout_session_id"`
 	CreatedAt            time.Time     `json:"created_at"`
 }
+
+// Refund is a partial refund of a transaction for a returned part of the order.
+type Refund struct {
+	ID              int64       `json:"id"`
+	TransactionID   int64       `json:"transaction_id"`
+	ReturnID        int64       `json:"return_id"`
+	Amount          money.Money `json:"amount"`
+	GatewayRefundID string      `json:"gateway_refund_id"`
+	CreatedAt       time.Time   `json:"created_at"`
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var ErrCustomerNotFound = errors.New("customer not found")
+
+// GetGatewayCustomerID returns the payment gateway's customer that holds the user's saved
+// cards.
+func (r *Repository) GetGatewayCustomerID(ctx context.Context, userID int64) (string, error) {
+	var customerID string
+	err := r.conn.QueryRowContext(ctx,
+		`SELECT gateway_customer_id FROM payment_customers WHERE user_id = $1`, userID,
+	).Scan(&customerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrCustomerNotFound
+		}
+		return "", err
+	}
+
+	return customerID, nil
+}
+
+// CreateCustomer records the gateway customer of the user and returns the one recorded,
+// which is an earlier one if two requests created a customer at the same time.
+func (r *Repository) CreateCustomer(ctx context.Context, userID int64, customerID string) (string, error) {
+	query := `INSERT INTO payment_customers (user_id, gateway_customer_id) VALUES ($1, $2)
+	ON CONFLICT (user_id) DO NOTHING`
+
+	if _, err := r.conn.ExecContext(ctx, query, userID, customerID); err != nil {
+		return "", err
+	}
+
+	return r.GetGatewayCustomerID(ctx, userID)
+}
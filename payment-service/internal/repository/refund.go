@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"payment-service/internal/model"
+	"shared/money"
+)
+
+// HasReturnRefund reports whether the return was already refunded.
+func (r *Repository) HasReturnRefund(ctx context.Context, returnID int64) (bool, error) {
+	var exists bool
+	err := r.conn.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM refunds WHERE return_id = $1)`, returnID).Scan(&exists)
+	return exists, err
+}
+
+// RefundedAmount is the total of the partial refunds made on a transaction.
+func (r *Repository) RefundedAmount(ctx context.Context, transaction *model.Transaction) (money.Money, error) {
+	var total string
+	err := r.conn.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(amount), 0) FROM refunds WHERE transaction_id = $1`, transaction.ID,
+	).Scan(&total)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return money.Parse(total, transaction.Amount.Currency)
+}
+
+// CreateRefund records a refund, and marks the transaction refunded once it has been paid
+// back in full.
+func (r *Repository) CreateRefund(ctx context.Context, refund *model.Refund, transaction *model.Transaction) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO refunds (transaction_id, return_id, amount, currency, gateway_refund_id, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+
+	err = tx.QueryRowContext(ctx, query,
+		refund.TransactionID,
+		refund.ReturnID,
+		refund.Amount.Decimal(),
+		refund.Amount.Currency,
+		refund.GatewayRefundID,
+		refund.CreatedAt,
+	).Scan(&refund.ID)
+	if err != nil {
+		return err
+	}
+
+	var total string
+	err = tx.QueryRowContext(ctx, `SELECT SUM(amount) FROM refunds WHERE transaction_id = $1`, refund.TransactionID).Scan(&total)
+	if err != nil {
+		return err
+	}
+
+	refunded, err := money.Parse(total, transaction.Amount.Currency)
+	if err != nil {
+		return err
+	}
+
+	if refunded.Amount >= transaction.Amount.Amount {
+		_, err = tx.ExecContext(ctx, `UPDATE transactions SET status = $1 WHERE id = $2`, model.Refunded, transaction.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
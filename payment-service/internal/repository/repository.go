@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"payment-service/internal/model"
+	"shared/money"
 )
 
 type Repository struct {
@@ -15,16 +16,18 @@ func New(conn *sql.DB) *Repository {
 }
 
 func (r *Repository) CreateTransaction(ctx context.Context, transaction *model.Transaction) (int64, error) {
-	query := `INSERT INTO transactions (order_id, amount, currency, status, gateway_transaction_id, payment_method) 
-              VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+	query := `INSERT INTO transactions (order_id, amount, currency, status, gateway_transaction_id, payment_method,
+                          checkout_session_id)
+              VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
 
 	err := r.conn.QueryRowContext(ctx, query,
 		transaction.OrderID,
-		transaction.Amount,
-		transaction.Currency,
+		transaction.Amount.Decimal(),
+		transaction.Amount.Currency,
 		transaction.Status,
 		transaction.GatewayTransactionID,
 		transaction.PaymentMethod,
+		transaction.CheckoutSessionID,
 	).Scan(&transaction.ID)
 	if err != nil {
 		return 0, err
@@ -34,36 +37,102 @@ func (r *Repository) CreateTransaction(ctx context.Context, transaction *model.T
 }
 
 func (r *Repository) GetTransactionByPaymentIntentID(ctx context.Context, paymentIntentID string) (*model.Transaction, error) {
-	query := `SELECT id, order_id, amount, currency, status, gateway_transaction_id, payment_method, created_at 
+	query := `SELECT id, order_id, amount, currency, status, gateway_transaction_id, payment_method,
+			  checkout_session_id, created_at
 			  FROM transactions WHERE gateway_transaction_id = $1`
 
 	row := r.conn.QueryRowContext(ctx, query, paymentIntentID)
 	var transaction model.Transaction
+	var amount, currency string
 	err := row.Scan(
 		&transaction.ID,
 		&transaction.OrderID,
-		&transaction.Amount,
-		&transaction.Currency,
+		&amount,
+		&currency,
 		&transaction.Status,
 		&transaction.GatewayTransactionID,
 		&transaction.PaymentMethod,
+		&transaction.CheckoutSessionID,
 		&transaction.CreatedAt,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	transaction.Amount, err = money.Parse(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+
 	return &transaction, nil
 }
 
+// GetPendingSessionTransactions returns the payments started for a checkout session that
+// haven't been settled yet, newest first.
+func (r *Repository) GetPendingSessionTransactions(ctx context.Context, sessionID string) ([]*model.Transaction, error) {
+	query := `SELECT id, order_id, amount, currency, status, gateway_transaction_id, payment_method,
+			  checkout_session_id, created_at
+			  FROM transactions WHERE checkout_session_id = $1 AND status = $2
+			  ORDER BY created_at DESC`
+
+	return r.queryTransactions(ctx, query, sessionID, model.Pending)
+}
+
+// GetCompletedOrderTransactions returns the payments collected for an order.
+func (r *Repository) GetCompletedOrderTransactions(ctx context.Context, orderID int64) ([]*model.Transaction, error) {
+	query := `SELECT id, order_id, amount, currency, status, gateway_transaction_id, payment_method,
+			  checkout_session_id, created_at
+			  FROM transactions WHERE order_id = $1 AND status = $2
+			  ORDER BY created_at`
+
+	return r.queryTransactions(ctx, query, orderID, model.Completed)
+}
+
+func (r *Repository) queryTransactions(ctx context.Context, query string, args ...any) ([]*model.Transaction, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []*model.Transaction
+	for rows.Next() {
+		var transaction model.Transaction
+		var amount, currency string
+		err = rows.Scan(
+			&transaction.ID,
+			&transaction.OrderID,
+			&amount,
+			&currency,
+			&transaction.Status,
+			&transaction.GatewayTransactionID,
+			&transaction.PaymentMethod,
+			&transaction.CheckoutSessionID,
+			&transaction.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		transaction.Amount, err = money.Parse(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, &transaction)
+	}
+
+	return transactions, rows.Err()
+}
+
 func (r *Repository) UpdateTransaction(ctx context.Context, transaction *model.Transaction) error {
 	query := `UPDATE transactions SET order_id = $1, amount = $2, currency = $3, status = $4, 
 			  gateway_transaction_id = $5, payment_method = $6 WHERE id = $7`
 
 	_, err := r.conn.ExecContext(ctx, query,
 		transaction.OrderID,
-		transaction.Amount,
-		transaction.Currency,
+		transaction.Amount.Decimal(),
+		transaction.Amount.Currency,
 		transaction.Status,
 		transaction.GatewayTransactionID,
 		transaction.PaymentMethod,
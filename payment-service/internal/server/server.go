@@ -8,6 +8,7 @@ import (
 	"log"
 	"payment-service/internal/service"
 	pb "payment-service/protobuf"
+	"strings"
 )
 
 type Server struct {
@@ -15,13 +16,18 @@ type Server struct {
 	Service *service.Service
 }
 
-func (s *Server) ProcessPayment(ctx context.Context, _ *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error) {
-	pi, err := s.Service.ProcessPayment(ctx)
+func (s *Server) ProcessPayment(ctx context.Context, r *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error) {
+	pi, err := s.Service.ProcessPayment(ctx, r.GetCheckoutSessionId())
 	if err != nil {
 		log.Println(err)
+		// The order service reports problems with the checkout session the caller can fix.
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition:
+			return nil, err
+		}
 		switch {
-		case errors.Is(err, service.ErrEmptyCart):
-			return nil, status.Error(codes.FailedPrecondition, "cart is empty")
+		case errors.Is(err, service.ErrCheckoutSessionClosed):
+			return nil, status.Error(codes.FailedPrecondition, "checkout session is no longer open")
 		case errors.Is(err, service.ErrProcessingPayment):
 			return nil, status.Error(codes.Internal, "error processing payment")
 		}
@@ -30,7 +36,53 @@ func (s *Server) ProcessPayment(ctx context.Context, _ *pb.ProcessPaymentRequest
 
 	return &pb.ProcessPaymentResponse{
 		ClientSecret: pi.ClientSecret,
-		Amount:       float32(pi.Amount),
-		Currency:     pi.Currency,
+		Amount: &pb.Money{
+			Amount:   pi.Amount,
+			Currency: strings.ToUpper(string(pi.Currency)),
+		},
 	}, nil
 }
+
+func (s *Server) SetupPaymentMethod(ctx context.Context, r *pb.SetupPaymentMethodRequest) (*pb.SetupPaymentMethodResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	si, err := s.Service.SetupPaymentMethod(ctx, int64(userID))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "error setting up payment method")
+	}
+
+	return &pb.SetupPaymentMethodResponse{ClientSecret: si.ClientSecret}, nil
+}
+
+func (s *Server) ListPaymentMethods(ctx context.Context, r *pb.ListPaymentMethodsRequest) (*pb.ListPaymentMethodsResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	methods, err := s.Service.ListPaymentMethods(ctx, int64(userID))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "error listing payment methods")
+	}
+
+	resp := &pb.ListPaymentMethodsResponse{PaymentMethods: make([]*pb.StoredPaymentMethod, 0, len(methods))}
+	for _, method := range methods {
+		if method.Card == nil {
+			continue
+		}
+		resp.PaymentMethods = append(resp.PaymentMethods, &pb.StoredPaymentMethod{
+			Id:       method.ID,
+			Brand:    string(method.Card.Brand),
+			Last4:    method.Card.Last4,
+			ExpMonth: int32(method.Card.ExpMonth),
+			ExpYear:  int32(method.Card.ExpYear),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/customer"
+	"github.com/stripe/stripe-go/v72/paymentintent"
+	"github.com/stripe/stripe-go/v72/paymentmethod"
+	"github.com/stripe/stripe-go/v72/setupintent"
+	"payment-service/internal/model"
+	"payment-service/internal/repository"
+	"strconv"
+	"strings"
+)
+
+// SetupPaymentMethod opens a setup intent that saves the card the user confirms it with to
+// their gateway customer, so it can be charged later without them.
+func (s *Service) SetupPaymentMethod(ctx context.Context, userID int64) (*stripe.SetupIntent, error) {
+	customerID, err := s.gatewayCustomer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := setupintent.New(&stripe.SetupIntentParams{
+		Customer:           stripe.String(customerID),
+		Usage:              stripe.String(string(stripe.SetupIntentUsageOffSession)),
+		PaymentMethodTypes: stripe.StringSlice([]string{"card"}),
+	})
+	if err != nil {
+		return nil, ErrProcessingPayment
+	}
+
+	return si, nil
+}
+
+// ListPaymentMethods returns the cards the user saved.
+func (s *Service) ListPaymentMethods(ctx context.Context, userID int64) ([]*stripe.PaymentMethod, error) {
+	customerID, err := s.repo.GetGatewayCustomerID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCustomerNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var methods []*stripe.PaymentMethod
+	i := paymentmethod.List(&stripe.PaymentMethodListParams{
+		Customer: stripe.String(customerID),
+		Type:     stripe.String("card"),
+	})
+	for i.Next() {
+		methods = append(methods, i.PaymentMethod())
+	}
+	if i.Err() != nil {
+		return nil, ErrProcessingPayment
+	}
+
+	return methods, nil
+}
+
+func (s *Service) gatewayCustomer(ctx context.Context, userID int64) (string, error) {
+	customerID, err := s.repo.GetGatewayCustomerID(ctx, userID)
+	if err == nil {
+		return customerID, nil
+	}
+	if !errors.Is(err, repository.ErrCustomerNotFound) {
+		return "", err
+	}
+
+	params := &stripe.CustomerParams{}
+	params.AddMetadata("user_id", strconv.FormatInt(userID, 10))
+
+	c, err := customer.New(params)
+	if err != nil {
+		return "", ErrProcessingPayment
+	}
+
+	return s.repo.CreateCustomer(ctx, userID, c.ID)
+}
+
+// chargeStoredPaymentMethod charges an order, such as one placed by a subscription, to a
+// card the user saved, without them being present. The card must be one of the user's.
+func (s *Service) chargeStoredPaymentMethod(ctx context.Context, eventData OrderData) error {
+	customerID, err := s.repo.GetGatewayCustomerID(ctx, eventData.UserID)
+	if errors.Is(err, repository.ErrCustomerNotFound) {
+		eventData.FailureReason = "the saved card is no longer available"
+		if err = s.sendPaymentFailedEvent(ctx, eventData); err != nil {
+			return ErrSendingEvent
+		}
+		return ErrPaymentNotSucceeded
+	}
+	if err != nil {
+		return err
+	}
+
+	params := &stripe.PaymentIntentParams{
+		Amount:        stripe.Int64(eventData.Amount.Amount),
+		Currency:      stripe.String(strings.ToLower(eventData.Amount.Currency)),
+		Customer:      stripe.String(customerID),
+		PaymentMethod: stripe.String(eventData.StoredPaymentMethodID),
+		OffSession:    stripe.Bool(true),
+		Confirm:       stripe.Bool(true),
+	}
+	params.AddMetadata("order_id", strconv.FormatInt(eventData.OrderID, 10))
+	// A redelivered event must not charge the order twice.
+	params.SetIdempotencyKey(fmt.Sprintf("order-%d", eventData.OrderID))
+
+	pi, err := paymentintent.New(params)
+	if err != nil || pi.Status != stripe.PaymentIntentStatusSucceeded {
+		eventData.FailureReason = "the saved card was declined"
+		if err = s.sendPaymentFailedEvent(ctx, eventData); err != nil {
+			return ErrSendingEvent
+		}
+		return ErrPaymentNotSucceeded
+	}
+
+	_, err = s.repo.GetTransactionByPaymentIntentID(ctx, pi.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		orderID := eventData.OrderID
+		_, err = s.repo.CreateTransaction(ctx, &model.Transaction{
+			OrderID:              &orderID,
+			Amount:               eventData.Amount,
+			Status:               model.Completed,
+			GatewayTransactionID: &pi.ID,
+			PaymentMethod:        model.Card,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// Compensation refunds the charge through the payment intent, as for checkout payments.
+	eventData.PaymentIntentID = &pi.ID
+	if err = s.sendPaymentSucceededEvent(ctx, eventData); err != nil {
+		return ErrSendingEvent
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/refund"
+	"log"
+	"payment-service/internal/model"
+	"shared/money"
+	"strconv"
+	"time"
+)
+
+type ReturnEvent struct {
+	EventID   string     `json:"event_id"`
+	EventType string     `json:"event_type"`
+	Timestamp time.Time  `json:"timestamp"`
+	Version   string     `json:"version"`
+	Data      ReturnData `json:"data"`
+}
+
+type ReturnData struct {
+	ReturnID     int64             `json:"return_id"`
+	OrderID      int64             `json:"order_id"`
+	UserID       int64             `json:"user_id"`
+	Status       string            `json:"status"`
+	Items        []*ReturnItemData `json:"items"`
+	RefundAmount money.Money       `json:"refund_amount"`
+	StaffNote    string            `json:"staff_note"`
+}
+
+type ReturnItemData struct {
+	Sku      string `json:"sku"`
+	Quantity int32  `json:"quantity"`
+	Reason   string `json:"reason"`
+	Restock  bool   `json:"restock"`
+}
+
+// RefundReturn pays back the refund of an inspected return from the order's card payment
+// and reports it with a returns.refunded event. Orders paid on delivery have no payment to
+// refund from, so their returns are left for staff to refund by hand.
+func (s *Service) RefundReturn(ctx context.Context, eventData ReturnData) error {
+	done, err := s.repo.HasReturnRefund(ctx, eventData.ReturnID)
+	if err != nil {
+		return err
+	}
+
+	if done || eventData.RefundAmount.Amount == 0 {
+		return s.sendReturnRefundedEvent(ctx, eventData)
+	}
+
+	transactions, err := s.repo.GetCompletedOrderTransactions(ctx, eventData.OrderID)
+	if err != nil {
+		return err
+	}
+
+	var transaction *model.Transaction
+	for _, t := range transactions {
+		if t.GatewayTransactionID != nil {
+			transaction = t
+			break
+		}
+	}
+
+	if transaction == nil {
+		log.Printf("Order %d has no card payment, return %d must be refunded manually\n", eventData.OrderID, eventData.ReturnID)
+		return nil
+	}
+
+	refunded, err := s.repo.RefundedAmount(ctx, transaction)
+	if err != nil {
+		return err
+	}
+
+	if eventData.RefundAmount.Currency != transaction.Amount.Currency ||
+		eventData.RefundAmount.Amount > transaction.Amount.Amount-refunded.Amount {
+		return ErrInvalidAmount
+	}
+
+	params := &stripe.RefundParams{
+		PaymentIntent: transaction.GatewayTransactionID,
+		Amount:        stripe.Int64(eventData.RefundAmount.Amount),
+	}
+	// A redelivered event must not refund the return twice.
+	params.SetIdempotencyKey(fmt.Sprintf("return-%d", eventData.ReturnID))
+
+	r, err := refund.New(params)
+	if err != nil {
+		return ErrProcessingPayment
+	}
+
+	err = s.repo.CreateRefund(ctx, &model.Refund{
+		TransactionID:   transaction.ID,
+		ReturnID:        eventData.ReturnID,
+		Amount:          eventData.RefundAmount,
+		GatewayRefundID: r.ID,
+		CreatedAt:       time.Now(),
+	}, transaction)
+	if err != nil {
+		return err
+	}
+
+	if err = s.sendReturnRefundedEvent(ctx, eventData); err != nil {
+		return ErrSendingEvent
+	}
+
+	return nil
+}
+
+func (s *Service) sendReturnRefundedEvent(ctx context.Context, eventData ReturnData) error {
+	event := ReturnEvent{
+		EventID:   uuid.NewString(),
+		EventType: "returns.refunded",
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data:      eventData,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Key:   []byte(strconv.Itoa(int(eventData.OrderID))),
+		Value: eventBytes,
+	}
+
+	err = s.returnRefundedWriter.WriteMessages(ctx, msg)
+
+	return err
+}
@@ -12,22 +12,24 @@ import (
 	"google.golang.org/grpc/metadata"
 	"html/template"
 	"log"
-	"math"
 	"payment-service/internal/model"
 	"payment-service/internal/repository"
 	pb "payment-service/protobuf"
+	"shared/money"
 	"strconv"
+	"strings"
 	"time"
 )
 
 var (
-	ErrEmptyCart              = errors.New("cart is empty")
 	ErrProcessingPayment      = errors.New("error processing payment")
 	ErrInvalidAmount          = errors.New("invalid amount")
 	ErrSendingEvent           = errors.New("error sending event")
 	ErrPaymentNotSucceeded    = errors.New("payment not succeeded")
 	ErrMissingPaymentIntentID = errors.New("missing payment intent ID")
 	ErrMissingMetadata        = errors.New("missing metadata in context")
+	ErrCheckoutSessionClosed  = errors.New("checkout session is no longer open")
+	ErrSessionMismatch        = errors.New("payment intent belongs to another checkout session")
 )
 
 type OrderCreatedEvent struct {
@@ -48,82 +50,129 @@ type OrderData struct {
 	PaymentIntentID   *string          `json:"payment_intent_id"`
 	UserID            int64            `json:"user_id"`
 	Items             []*OrderItemData `json:"items"`
-	Amount            float64          `json:"amount"`
+	Amount            money.Money      `json:"amount"`
+	Subtotal          money.Money      `json:"subtotal"`
+	Discount          money.Money      `json:"discount"`
+	Shipping          money.Money      `json:"shipping"`
+	Tax               money.Money      `json:"tax"`
+	Currency          string           `json:"currency"`
 	ShippingAddress   string           `json:"shipping_address"`
+	ShippingMethod    string           `json:"shipping_method"`
 	EstimatedDelivery time.Time        `json:"estimated_delivery"`
+	CheckoutSessionID string           `json:"checkout_session_id"`
+	// StoredPaymentMethodID is the saved card a subscription order is charged to.
+	StoredPaymentMethodID string `json:"stored_payment_method_id,omitempty"`
+	// FailureReason is only set on payment.failed events.
+	FailureReason string `json:"failure_reason,omitempty"`
+	// CancellationReason is only set on orders.cancelled events.
+	CancellationReason string `json:"cancellation_reason,omitempty"`
 }
 
 type OrderItemData struct {
 	Quantity       int32        `json:"quantity"`
-	Price          float64      `json:"price"`
+	Price          money.Money  `json:"price"`
 	Sku            string       `json:"sku"`
 	Name           string       `json:"name"`
 	ImageURL       template.URL `json:"image_url"`
-	ItemTotalPrice float64      `json:"item_total_price"`
+	ItemTotalPrice money.Money  `json:"item_total_price"`
+}
+
+type CheckoutEvent struct {
+	EventID   string              `json:"event_id"`
+	EventType string              `json:"event_type"`
+	Timestamp time.Time           `json:"timestamp"`
+	Version   string              `json:"version"`
+	Data      CheckoutSessionData `json:"data"`
+}
+
+type CheckoutSessionData struct {
+	SessionID string    `json:"session_id"`
+	UserID    int64     `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type Service struct {
 	repo                 *repository.Repository
-	cartClient           pb.ShoppingCartServiceClient
+	orderClient          pb.OrderServiceClient
 	paymentFailedWriter  *kafka.Writer
 	paymentSucceedWriter *kafka.Writer
+	returnRefundedWriter *kafka.Writer
 }
 
-func New(repo *repository.Repository, cartClient pb.ShoppingCartServiceClient, paymentFailedWriter, paymentSucceedWriter *kafka.Writer) *Service {
+func New(repo *repository.Repository, orderClient pb.OrderServiceClient, paymentFailedWriter, paymentSucceedWriter, returnRefundedWriter *kafka.Writer) *Service {
 	return &Service{
 		repo:                 repo,
-		cartClient:           cartClient,
+		orderClient:          orderClient,
 		paymentFailedWriter:  paymentFailedWriter,
 		paymentSucceedWriter: paymentSucceedWriter,
+		returnRefundedWriter: returnRefundedWriter,
 	}
 }
 
-func (s *Service) ProcessPayment(ctx context.Context) (*stripe.PaymentIntent, error) {
+// ProcessPayment opens a payment intent for the caller's checkout session. The amount
+// charged is the grand total frozen in the session, so it includes shipping and tax. Asking
+// again for the same session returns the intent already opened for it.
+func (s *Service) ProcessPayment(ctx context.Context, sessionID string) (*stripe.PaymentIntent, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, ErrMissingMetadata
 	}
 
-	ctx = metadata.NewOutgoingContext(ctx, md)
-
-	resp, err := s.cartClient.GetCart(ctx, &pb.GetCartRequest{})
+	session, err := s.orderClient.GetCheckoutSession(metadata.NewOutgoingContext(ctx, md), &pb.GetCheckoutSessionRequest{
+		Id: sessionID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	items := resp.GetItems()
-
-	if len(items) == 0 {
-		return nil, ErrEmptyCart
+	if session.GetStatus() != pb.CheckoutSessionStatus_SESSION_OPEN {
+		return nil, ErrCheckoutSessionClosed
 	}
 
-	var amount float64
-	for _, item := range items {
-		amount += item.GetPrice() * float64(item.GetQuantity())
+	amount := money.New(session.GetGrandTotal().GetAmount(), session.GetGrandTotal().GetCurrency())
+
+	pending, err := s.repo.GetPendingSessionTransactions(ctx, session.GetId())
+	if err != nil {
+		return nil, err
 	}
 
-	amountInCents := int64(math.Round(amount*100) / 100)
+	for _, transaction := range pending {
+		if transaction.GatewayTransactionID == nil || transaction.Amount != amount {
+			continue
+		}
+
+		pi, err := paymentintent.Get(*transaction.GatewayTransactionID, nil)
+		if err != nil {
+			return nil, ErrProcessingPayment
+		}
+
+		if pi.Status != stripe.PaymentIntentStatusCanceled {
+			return pi, nil
+		}
+	}
 
 	params := &stripe.PaymentIntentParams{
-		Amount:   stripe.Int64(amountInCents),
-		Currency: stripe.String(string(stripe.CurrencyUSD)),
+		Amount:   stripe.Int64(amount.Amount),
+		Currency: stripe.String(strings.ToLower(amount.Currency)),
 		AutomaticPaymentMethods: &stripe.PaymentIntentAutomaticPaymentMethodsParams{
 			Enabled: stripe.Bool(true),
 		},
 	}
+	params.AddMetadata("checkout_session_id", session.GetId())
 
 	pi, err := paymentintent.New(params)
 	if err != nil {
 		return nil, ErrProcessingPayment
 	}
 
+	id := session.GetId()
 	transaction := model.Transaction{
 		OrderID:              nil,
 		Amount:               amount,
-		Currency:             model.USD,
 		Status:               model.Pending,
 		GatewayTransactionID: &pi.ID,
 		PaymentMethod:        model.Card,
+		CheckoutSessionID:    &id,
 	}
 
 	_, err = s.repo.CreateTransaction(ctx, &transaction)
@@ -134,6 +183,49 @@ func (s *Service) ProcessPayment(ctx context.Context) (*stripe.PaymentIntent, er
 	return pi, nil
 }
 
+// ReleaseCheckoutPayment settles the payments left pending by an expired checkout session:
+// intents the customer already paid are refunded, the others are canceled.
+func (s *Service) ReleaseCheckoutPayment(ctx context.Context, eventData CheckoutSessionData) error {
+	pending, err := s.repo.GetPendingSessionTransactions(ctx, eventData.SessionID)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range pending {
+		if transaction.GatewayTransactionID == nil {
+			continue
+		}
+		paymentIntentID := *transaction.GatewayTransactionID
+
+		pi, err := paymentintent.Get(paymentIntentID, nil)
+		if err != nil {
+			return ErrProcessingPayment
+		}
+
+		status := model.Failed
+		switch pi.Status {
+		case stripe.PaymentIntentStatusSucceeded:
+			r, err := refund.New(&stripe.RefundParams{PaymentIntent: stripe.String(paymentIntentID)})
+			if err != nil {
+				return ErrProcessingPayment
+			}
+			log.Printf("Refunded payment %s of expired checkout session %s: %s\n", paymentIntentID, eventData.SessionID, r.ID)
+			status = model.Refunded
+		case stripe.PaymentIntentStatusCanceled:
+		default:
+			if _, err = paymentintent.Cancel(paymentIntentID, nil); err != nil {
+				return ErrProcessingPayment
+			}
+		}
+
+		if err = s.repo.UpdateTransactionStatus(ctx, paymentIntentID, status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) ConfirmOrderPayment(ctx context.Context, eventData OrderData) error {
 	if eventData.PaymentMethod == "ON_DELIVERY" {
 		if err := s.sendPaymentSucceededEvent(ctx, eventData); err != nil {
@@ -142,7 +234,12 @@ func (s *Service) ConfirmOrderPayment(ctx context.Context, eventData OrderData)
 		return nil
 	}
 
+	if eventData.StoredPaymentMethodID != "" {
+		return s.chargeStoredPaymentMethod(ctx, eventData)
+	}
+
 	if eventData.PaymentIntentID == nil {
+		eventData.FailureReason = "the payment could not be verified"
 		if err := s.sendPaymentFailedEvent(ctx, eventData); err != nil {
 			return ErrSendingEvent
 		}
@@ -151,14 +248,23 @@ func (s *Service) ConfirmOrderPayment(ctx context.Context, eventData OrderData)
 
 	pi, err := paymentintent.Get(*eventData.PaymentIntentID, nil)
 	if err != nil {
+		eventData.FailureReason = "the payment could not be verified"
 		if err = s.sendPaymentFailedEvent(ctx, eventData); err != nil {
 			return ErrSendingEvent
 		}
 		return err
 	}
 
-	orderAmountInCents := int64(math.Round(eventData.Amount*100) / 100)
-	if pi.Amount != orderAmountInCents {
+	if pi.Metadata["checkout_session_id"] != eventData.CheckoutSessionID {
+		eventData.FailureReason = "the payment could not be verified"
+		if err = s.sendPaymentFailedEvent(ctx, eventData); err != nil {
+			return ErrSendingEvent
+		}
+		return ErrSessionMismatch
+	}
+
+	if pi.Amount != eventData.Amount.Amount || !strings.EqualFold(string(pi.Currency), eventData.Amount.Currency) {
+		eventData.FailureReason = "the payment could not be verified"
 		if err = s.sendPaymentFailedEvent(ctx, eventData); err != nil {
 			return ErrSendingEvent
 		}
@@ -179,6 +285,7 @@ func (s *Service) ConfirmOrderPayment(ctx context.Context, eventData OrderData)
 	}
 
 	if status == model.Failed {
+		eventData.FailureReason = "the payment did not succeed"
 		if err = s.sendPaymentFailedEvent(ctx, eventData); err != nil {
 			return ErrSendingEvent
 		}
@@ -193,6 +300,8 @@ func (s *Service) ConfirmOrderPayment(ctx context.Context, eventData OrderData)
 }
 
 func (s *Service) CompensatePayment(ctx context.Context, eventData OrderData) error {
+	eventData.FailureReason = "some items are out of stock"
+
 	if eventData.PaymentMethod == "ON_DELIVERY" {
 		if err := s.sendPaymentFailedEvent(ctx, eventData); err != nil {
 			return ErrSendingEvent
@@ -238,6 +347,35 @@ func (s *Service) CompensatePayment(ctx context.Context, eventData OrderData) er
 	return nil
 }
 
+// RefundOrder refunds what was collected for an order the customer cancelled. Orders paid
+// on delivery have nothing to refund.
+func (s *Service) RefundOrder(ctx context.Context, eventData OrderData) error {
+	transactions, err := s.repo.GetCompletedOrderTransactions(ctx, eventData.OrderID)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		if transaction.GatewayTransactionID == nil {
+			continue
+		}
+		paymentIntentID := *transaction.GatewayTransactionID
+
+		r, err := refund.New(&stripe.RefundParams{PaymentIntent: stripe.String(paymentIntentID)})
+		if err != nil {
+			return ErrProcessingPayment
+		}
+
+		log.Printf("Refunded payment %s of cancelled order %d: %s\n", paymentIntentID, eventData.OrderID, r.ID)
+
+		if err = s.repo.UpdateTransactionStatus(ctx, paymentIntentID, model.Refunded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) sendPaymentFailedEvent(ctx context.Context, eventData OrderData) error {
 	event := OrderCreatedEvent{
 		EventID:   uuid.NewString(),
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_transactions_checkout_session;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS checkout_session_id;
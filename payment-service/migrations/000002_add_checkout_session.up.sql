@@ -0,0 +1,3 @@
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS checkout_session_id VARCHAR(36);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_checkout_session ON transactions (checkout_session_id);
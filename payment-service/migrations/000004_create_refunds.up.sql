@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS refunds (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT NOT NULL REFERENCES transactions(id),
+    return_id BIGINT NOT NULL UNIQUE,
+    amount DECIMAL(10, 2) NOT NULL,
+    currency currency NOT NULL,
+    gateway_refund_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_transaction ON refunds (transaction_id);
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS payment_customers (
+    user_id BIGINT PRIMARY KEY,
+    gateway_customer_id VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
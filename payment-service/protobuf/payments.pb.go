@@ -23,10 +23,13 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProcessPaymentRequest charges the grand total of an open checkout session. Pass the same
+// session to CreateOrder.
 type ProcessPaymentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CheckoutSessionId string                 `protobuf:"bytes,3,opt,name=checkout_session_id,json=checkoutSessionId,proto3" json:"checkout_session_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ProcessPaymentRequest) Reset() {
@@ -59,11 +62,17 @@ func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
 	return file_payments_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *ProcessPaymentRequest) GetCheckoutSessionId() string {
+	if x != nil {
+		return x.CheckoutSessionId
+	}
+	return ""
+}
+
 type ProcessPaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientSecret  string                 `protobuf:"bytes,1,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
-	Amount        float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,25 +114,17 @@ func (x *ProcessPaymentResponse) GetClientSecret() string {
 	return ""
 }
 
-func (x *ProcessPaymentResponse) GetAmount() float32 {
+func (x *ProcessPaymentResponse) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
-}
-
-func (x *ProcessPaymentResponse) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+	return nil
 }
 
 type Payment struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount               float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency             string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	Status               string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	GatewayTransactionId *structpb.Value        `protobuf:"bytes,5,opt,name=gateway_transaction_id,json=gatewayTransactionId,proto3" json:"gateway_transaction_id,omitempty"`
 	PaymentMethod        string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
@@ -169,18 +170,11 @@ func (x *Payment) GetId() int64 {
 	return 0
 }
 
-func (x *Payment) GetAmount() float32 {
+func (x *Payment) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
-}
-
-func (x *Payment) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+	return nil
 }
 
 func (x *Payment) GetStatus() string {
@@ -291,30 +285,281 @@ func (x *GetPaymentsResponse) GetPayments() []*Payment {
 	return nil
 }
 
+type SetupPaymentMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupPaymentMethodRequest) Reset() {
+	*x = SetupPaymentMethodRequest{}
+	mi := &file_payments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupPaymentMethodRequest) ProtoMessage() {}
+
+func (x *SetupPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*SetupPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{5}
+}
+
+type SetupPaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientSecret  string                 `protobuf:"bytes,1,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupPaymentMethodResponse) Reset() {
+	*x = SetupPaymentMethodResponse{}
+	mi := &file_payments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupPaymentMethodResponse) ProtoMessage() {}
+
+func (x *SetupPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*SetupPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetupPaymentMethodResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+// StoredPaymentMethod is a card saved for the user. Its id is what subscriptions are
+// charged to.
+type StoredPaymentMethod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Brand         string                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	Last4         string                 `protobuf:"bytes,3,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpMonth      int32                  `protobuf:"varint,4,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"`
+	ExpYear       int32                  `protobuf:"varint,5,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoredPaymentMethod) Reset() {
+	*x = StoredPaymentMethod{}
+	mi := &file_payments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredPaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredPaymentMethod) ProtoMessage() {}
+
+func (x *StoredPaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredPaymentMethod.ProtoReflect.Descriptor instead.
+func (*StoredPaymentMethod) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StoredPaymentMethod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StoredPaymentMethod) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *StoredPaymentMethod) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *StoredPaymentMethod) GetExpMonth() int32 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *StoredPaymentMethod) GetExpYear() int32 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+type ListPaymentMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsRequest) Reset() {
+	*x = ListPaymentMethodsRequest{}
+	mi := &file_payments_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *ListPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{8}
+}
+
+type ListPaymentMethodsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethods []*StoredPaymentMethod `protobuf:"bytes,1,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsResponse) Reset() {
+	*x = ListPaymentMethodsResponse{}
+	mi := &file_payments_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsResponse) ProtoMessage() {}
+
+func (x *ListPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPaymentMethodsResponse) GetPaymentMethods() []*StoredPaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
 var File_payments_proto protoreflect.FileDescriptor
 
 const file_payments_proto_rawDesc = "" +
 	"\n" +
-	"\x0epayments.proto\x12\apayment\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x17\n" +
-	"\x15ProcessPaymentRequest\"q\n" +
+	"\x0epayments.proto\x12\apayment\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\vmoney.proto\"m\n" +
+	"\x15ProcessPaymentRequest\x12.\n" +
+	"\x13checkout_session_id\x18\x03 \x01(\tR\x11checkoutSessionIdJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\aaddressR\x0fshipping_method\"i\n" +
 	"\x16ProcessPaymentResponse\x12#\n" +
-	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x02R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xf5\x01\n" +
+	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.money.MoneyR\x06amountJ\x04\b\x03\x10\x04\"\xed\x01\n" +
 	"\aPayment\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x02R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.money.MoneyR\x06amount\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12L\n" +
 	"\x16gateway_transaction_id\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\x14gatewayTransactionId\x12%\n" +
 	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12\x19\n" +
-	"\border_id\x18\a \x01(\x03R\aorderId\"\x14\n" +
+	"\border_id\x18\a \x01(\x03R\aorderIdJ\x04\b\x03\x10\x04\"\x14\n" +
 	"\x12GetPaymentsRequest\"C\n" +
 	"\x13GetPaymentsResponse\x12,\n" +
-	"\bpayments\x18\x01 \x03(\v2\x10.payment.PaymentR\bpayments2\xed\x01\n" +
+	"\bpayments\x18\x01 \x03(\v2\x10.payment.PaymentR\bpayments\"\x1b\n" +
+	"\x19SetupPaymentMethodRequest\"A\n" +
+	"\x1aSetupPaymentMethodResponse\x12#\n" +
+	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\"\x89\x01\n" +
+	"\x13StoredPaymentMethod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05last4\x18\x03 \x01(\tR\x05last4\x12\x1b\n" +
+	"\texp_month\x18\x04 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x05 \x01(\x05R\aexpYear\"\x1b\n" +
+	"\x19ListPaymentMethodsRequest\"c\n" +
+	"\x1aListPaymentMethodsResponse\x12E\n" +
+	"\x0fpayment_methods\x18\x01 \x03(\v2\x1c.payment.StoredPaymentMethodR\x0epaymentMethods2\xf9\x03\n" +
 	"\x0ePaymentService\x12w\n" +
 	"\x0eProcessPayment\x12\x1e.payment.ProcessPaymentRequest\x1a\x1f.payment.ProcessPaymentResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/payments/initiate\x12b\n" +
-	"\vGetPayments\x12\x1b.payment.GetPaymentsRequest\x1a\x1c.payment.GetPaymentsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/paymentsB\vZ\t/protobufb\x06proto3"
+	"\vGetPayments\x12\x1b.payment.GetPaymentsRequest\x1a\x1c.payment.GetPaymentsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/payments\x12\x88\x01\n" +
+	"\x12SetupPaymentMethod\x12\".payment.SetupPaymentMethodRequest\x1a#.payment.SetupPaymentMethodResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/payments/methods/setup\x12\x7f\n" +
+	"\x12ListPaymentMethods\x12\".payment.ListPaymentMethodsRequest\x1a#.payment.ListPaymentMethodsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/payments/methodsB\vZ\t/protobufb\x06proto3"
 
 var (
 	file_payments_proto_rawDescOnce sync.Once
@@ -328,27 +573,40 @@ func file_payments_proto_rawDescGZIP() []byte {
 	return file_payments_proto_rawDescData
 }
 
-var file_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_payments_proto_goTypes = []any{
-	(*ProcessPaymentRequest)(nil),  // 0: payment.ProcessPaymentRequest
-	(*ProcessPaymentResponse)(nil), // 1: payment.ProcessPaymentResponse
-	(*Payment)(nil),                // 2: payment.Payment
-	(*GetPaymentsRequest)(nil),     // 3: payment.GetPaymentsRequest
-	(*GetPaymentsResponse)(nil),    // 4: payment.GetPaymentsResponse
-	(*structpb.Value)(nil),         // 5: google.protobuf.Value
+	(*ProcessPaymentRequest)(nil),      // 0: payment.ProcessPaymentRequest
+	(*ProcessPaymentResponse)(nil),     // 1: payment.ProcessPaymentResponse
+	(*Payment)(nil),                    // 2: payment.Payment
+	(*GetPaymentsRequest)(nil),         // 3: payment.GetPaymentsRequest
+	(*GetPaymentsResponse)(nil),        // 4: payment.GetPaymentsResponse
+	(*SetupPaymentMethodRequest)(nil),  // 5: payment.SetupPaymentMethodRequest
+	(*SetupPaymentMethodResponse)(nil), // 6: payment.SetupPaymentMethodResponse
+	(*StoredPaymentMethod)(nil),        // 7: payment.StoredPaymentMethod
+	(*ListPaymentMethodsRequest)(nil),  // 8: payment.ListPaymentMethodsRequest
+	(*ListPaymentMethodsResponse)(nil), // 9: payment.ListPaymentMethodsResponse
+	(*Money)(nil),                      // 10: money.Money
+	(*structpb.Value)(nil),             // 11: google.protobuf.Value
 }
 var file_payments_proto_depIdxs = []int32{
-	5, // 0: payment.Payment.gateway_transaction_id:type_name -> google.protobuf.Value
-	2, // 1: payment.GetPaymentsResponse.payments:type_name -> payment.Payment
-	0, // 2: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
-	3, // 3: payment.PaymentService.GetPayments:input_type -> payment.GetPaymentsRequest
-	1, // 4: payment.PaymentService.ProcessPayment:output_type -> payment.ProcessPaymentResponse
-	4, // 5: payment.PaymentService.GetPayments:output_type -> payment.GetPaymentsResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: payment.ProcessPaymentResponse.amount:type_name -> money.Money
+	10, // 1: payment.Payment.amount:type_name -> money.Money
+	11, // 2: payment.Payment.gateway_transaction_id:type_name -> google.protobuf.Value
+	2,  // 3: payment.GetPaymentsResponse.payments:type_name -> payment.Payment
+	7,  // 4: payment.ListPaymentMethodsResponse.payment_methods:type_name -> payment.StoredPaymentMethod
+	0,  // 5: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
+	3,  // 6: payment.PaymentService.GetPayments:input_type -> payment.GetPaymentsRequest
+	5,  // 7: payment.PaymentService.SetupPaymentMethod:input_type -> payment.SetupPaymentMethodRequest
+	8,  // 8: payment.PaymentService.ListPaymentMethods:input_type -> payment.ListPaymentMethodsRequest
+	1,  // 9: payment.PaymentService.ProcessPayment:output_type -> payment.ProcessPaymentResponse
+	4,  // 10: payment.PaymentService.GetPayments:output_type -> payment.GetPaymentsResponse
+	6,  // 11: payment.PaymentService.SetupPaymentMethod:output_type -> payment.SetupPaymentMethodResponse
+	9,  // 12: payment.PaymentService.ListPaymentMethods:output_type -> payment.ListPaymentMethodsResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_payments_proto_init() }
@@ -356,13 +614,14 @@ func file_payments_proto_init() {
 	if File_payments_proto != nil {
 		return
 	}
+	file_money_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payments_proto_rawDesc), len(file_payments_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
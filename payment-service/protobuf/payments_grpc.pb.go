@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_ProcessPayment_FullMethodName = "/payment.PaymentService/ProcessPayment"
-	PaymentService_GetPayments_FullMethodName    = "/payment.PaymentService/GetPayments"
+	PaymentService_ProcessPayment_FullMethodName     = "/payment.PaymentService/ProcessPayment"
+	PaymentService_GetPayments_FullMethodName        = "/payment.PaymentService/GetPayments"
+	PaymentService_SetupPaymentMethod_FullMethodName = "/payment.PaymentService/SetupPaymentMethod"
+	PaymentService_ListPaymentMethods_FullMethodName = "/payment.PaymentService/ListPaymentMethods"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -29,6 +31,10 @@ const (
 type PaymentServiceClient interface {
 	ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...grpc.CallOption) (*ProcessPaymentResponse, error)
 	GetPayments(ctx context.Context, in *GetPaymentsRequest, opts ...grpc.CallOption) (*GetPaymentsResponse, error)
+	// SetupPaymentMethod starts saving a card for later payments, such as subscription
+	// orders. The client confirms the returned setup intent with the card details.
+	SetupPaymentMethod(ctx context.Context, in *SetupPaymentMethodRequest, opts ...grpc.CallOption) (*SetupPaymentMethodResponse, error)
+	ListPaymentMethods(ctx context.Context, in *ListPaymentMethodsRequest, opts ...grpc.CallOption) (*ListPaymentMethodsResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -59,12 +65,36 @@ func (c *paymentServiceClient) GetPayments(ctx context.Context, in *GetPaymentsR
 	return out, nil
 }
 
+func (c *paymentServiceClient) SetupPaymentMethod(ctx context.Context, in *SetupPaymentMethodRequest, opts ...grpc.CallOption) (*SetupPaymentMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupPaymentMethodResponse)
+	err := c.cc.Invoke(ctx, PaymentService_SetupPaymentMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPaymentMethods(ctx context.Context, in *ListPaymentMethodsRequest, opts ...grpc.CallOption) (*ListPaymentMethodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentMethodsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPaymentMethods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
 	ProcessPayment(context.Context, *ProcessPaymentRequest) (*ProcessPaymentResponse, error)
 	GetPayments(context.Context, *GetPaymentsRequest) (*GetPaymentsResponse, error)
+	// SetupPaymentMethod starts saving a card for later payments, such as subscription
+	// orders. The client confirms the returned setup intent with the card details.
+	SetupPaymentMethod(context.Context, *SetupPaymentMethodRequest) (*SetupPaymentMethodResponse, error)
+	ListPaymentMethods(context.Context, *ListPaymentMethodsRequest) (*ListPaymentMethodsResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -81,6 +111,12 @@ func (UnimplementedPaymentServiceServer) ProcessPayment(context.Context, *Proces
 func (UnimplementedPaymentServiceServer) GetPayments(context.Context, *GetPaymentsRequest) (*GetPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPayments not implemented")
 }
+func (UnimplementedPaymentServiceServer) SetupPaymentMethod(context.Context, *SetupPaymentMethodRequest) (*SetupPaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupPaymentMethod not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPaymentMethods(context.Context, *ListPaymentMethodsRequest) (*ListPaymentMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentMethods not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +174,42 @@ func _PaymentService_GetPayments_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_SetupPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupPaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SetupPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SetupPaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SetupPaymentMethod(ctx, req.(*SetupPaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPaymentMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPaymentMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPaymentMethods(ctx, req.(*ListPaymentMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +225,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPayments",
 			Handler:    _PaymentService_GetPayments_Handler,
 		},
+		{
+			MethodName: "SetupPaymentMethod",
+			Handler:    _PaymentService_SetupPaymentMethod_Handler,
+		},
+		{
+			MethodName: "ListPaymentMethods",
+			Handler:    _PaymentService_ListPaymentMethods_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payments.proto",
@@ -5,23 +5,55 @@ import (
 	"errors"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/segmentio/kafka-go"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"product-catalog-service/internal/config"
 	"product-catalog-service/internal/consumer"
 	"product-catalog-service/internal/repository"
+	"product-catalog-service/internal/scheduler"
 	"product-catalog-service/internal/server"
 	"product-catalog-service/internal/service"
 	pb "product-catalog-service/protobuf"
+	"shared/blob"
+	"strings"
 	"syscall"
+	"time"
 )
 
+const (
+	secret = "my-secret-key"
+)
+
+// authMethods require a signed-in user. Other methods are public, and only identify the
+// caller when a token comes along, e.g. to price products for the customer's group.
+var authMethods = map[string]bool{
+	pb.ProductCatalogService_CreateReview_FullMethodName: true,
+}
+
+// staffOnlyMethods require a token with the staff role.
+var staffOnlyMethods = map[string]bool{
+	pb.ProductCatalogService_SchedulePrice_FullMethodName:        true,
+	pb.ProductCatalogService_CancelScheduledPrice_FullMethodName: true,
+	pb.ProductCatalogService_UpsertPriceList_FullMethodName:      true,
+	pb.ProductCatalogService_GetPriceHistory_FullMethodName:      true,
+	pb.ProductCatalogService_ModerateReview_FullMethodName:       true,
+	pb.ProductCatalogService_UploadProductImage_FullMethodName:   true,
+	pb.ProductCatalogService_ReorderProductImages_FullMethodName: true,
+	pb.ProductCatalogService_DeleteProductImage_FullMethodName:   true,
+}
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatal(err)
@@ -58,8 +90,34 @@ func run() error {
 	}
 
 	// Repository
-	mongoRepo := repository.NewMongoRepository(mongoClient.Database(cfg.Mongo.DBName).Collection("products"))
-	elasticRepo := repository.NewElasticRepository(elasticClient, "products_idx")
+	db := mongoClient.Database(cfg.Mongo.DBName)
+	mongoRepo := repository.NewMongoRepository(db.Collection("products"))
+	priceRepo := repository.NewPriceRepository(db.Collection("price_lists"), db.Collection("price_history"), db.Collection("scheduler_state"))
+	reviewRepo := repository.NewReviewRepository(db.Collection("reviews"))
+	if err = reviewRepo.EnsureIndexes(context.Background()); err != nil {
+		return err
+	}
+	recommendationRepo := repository.NewRecommendationRepository(db.Collection("co_purchases"), db.Collection("co_purchase_orders"))
+	if err = recommendationRepo.EnsureIndexes(context.Background()); err != nil {
+		return err
+	}
+	reservationRepo := repository.NewReservationRepository(db.Collection("stock_reservations"))
+	elasticRepo := repository.NewElasticRepository(elasticClient, "products_v2")
+
+	// Prices moved from floats to integer minor units, so legacy documents are converted
+	// and a fresh index is built, as the old one maps price as a number.
+	migrated, err := mongoRepo.MigrateLegacyPrices(context.Background())
+	if err != nil {
+		return err
+	}
+	if migrated > 0 {
+		log.Printf("Migrated %d products to integer prices\n", migrated)
+	}
+
+	indexCreated, err := elasticRepo.EnsureIndex(context.Background())
+	if err != nil {
+		return err
+	}
 
 	// Kafka writers
 	kafkaAddr := fmt.Sprintf("%s:%s", cfg.Kafka.Host, cfg.Kafka.Port)
@@ -79,17 +137,54 @@ func run() error {
 	}
 	defer stockFailedWriter.Close()
 
+	priceChangedWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "products.price_changed",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer priceChangedWriter.Close()
+
+	// Blob store for product media
+	blobStore, err := blob.NewLocalStore(cfg.Media.Dir, cfg.Media.BaseURL)
+	if err != nil {
+		return err
+	}
+
+	// gRPC client to Order Service
+	orderAddr := fmt.Sprintf("%s:%s", cfg.OrderClient.Host, cfg.OrderClient.Port)
+	orderConn, err := grpc.NewClient(orderAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer orderConn.Close()
+
+	orderClient := pb.NewOrderServiceClient(orderConn)
+
 	// Service
-	svc := service.New(mongoRepo, elasticRepo, stockReservedWriter, stockFailedWriter)
+	svc := service.New(mongoRepo, elasticRepo, priceRepo, reviewRepo, recommendationRepo, reservationRepo, orderClient, stockReservedWriter, stockFailedWriter, priceChangedWriter, blobStore)
 
-	// gRPC server
-	s := grpc.NewServer()
+	if indexCreated {
+		go func() {
+			if err := svc.ReindexProducts(context.Background()); err != nil {
+				log.Printf("Error reindexing products: %s", err)
+			}
+		}()
+	}
+
+	// gRPC server, reviews are written on behalf of the authenticated user and the catalog
+	// is managed by staff
+	s := grpc.NewServer(grpc.UnaryInterceptor(AuthInterceptor), grpc.StreamInterceptor(StreamAuthInterceptor))
 	pb.RegisterProductCatalogServiceServer(s, server.NewProductCatalogServer(svc))
 
 	// Kafka consumer
 	cons := consumer.New(svc, cfg)
 	cons.Start()
 
+	// Price scheduler
+	priceScheduler := scheduler.NewPriceScheduler(svc, cfg.Pricing.SchedulerInterval)
+	priceScheduler.Start()
+
 	// Serving gRPC server
 	go func() {
 		log.Printf("Starting gRPC user service server on port %s\n", cfg.Server.Port)
@@ -99,6 +194,22 @@ func run() error {
 		}
 	}()
 
+	// Serving product media
+	mux := http.NewServeMux()
+	mux.Handle("/media/", http.StripPrefix("/media/", http.FileServer(http.Dir(cfg.Media.Dir))))
+	mediaServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.Media.HTTPPort),
+		Handler: mux,
+	}
+
+	go func() {
+		log.Printf("Starting media HTTP server on port %s\n", cfg.Media.HTTPPort)
+
+		if err := mediaServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println(err)
+		}
+	}()
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -109,6 +220,14 @@ func run() error {
 
 	s.GracefulStop()
 	cons.Stop()
+	priceScheduler.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err = mediaServer.Shutdown(ctx); err != nil {
+		log.Println(err)
+	}
 
 	log.Println("Application stopped")
 
@@ -149,10 +268,87 @@ func newElasticClient(addr string) (*elasticsearch.Client, error) {
 		return nil, errors.New(res.String())
 	}
 
-	_, err = client.Indices.Create("products_idx")
+	return client, nil
+}
+
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := authenticate(ctx, info.FullMethod)
 	if err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor applies the checks of AuthInterceptor to streaming methods, such as
+// image uploads.
+func StreamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+// authStream is a server stream whose context carries the caller's identity.
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate identifies the caller of method from their bearer token, and returns the
+// context with their user id, role and customer group.
+func authenticate(ctx context.Context, method string) (context.Context, error) {
+	required := authMethods[method] || staffOnlyMethods[method]
+
+	mt, _ := metadata.FromIncomingContext(ctx)
+	bearedToken := mt["authorization"]
+	if len(bearedToken) == 0 {
+		if !required {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "bearer token not found")
+	}
+
+	tokenString := strings.TrimPrefix(bearedToken[0], "Bearer ")
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+
+	userID, ok := claims["user-id"]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user-id not found")
+	}
+
+	userIDFloat, ok := userID.(float64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user-id is not float")
+	}
+
+	userIDInt := int(userIDFloat)
+
+	// Tokens issued before roles existed have no role claim and belong to customers.
+	role, _ := claims["role"].(string)
+	if staffOnlyMethods[method] && role != "staff" {
+		return nil, status.Error(codes.PermissionDenied, "staff role required")
+	}
+
+	customerGroup, _ := claims["customer-group"].(string)
+
+	ctx = context.WithValue(ctx, "user-id", userIDInt)
+	ctx = context.WithValue(ctx, "role", role)
+	ctx = context.WithValue(ctx, "customer-group", customerGroup)
+
+	return ctx, nil
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type Config struct {
@@ -23,6 +24,18 @@ type Config struct {
 		Host string `env:"KAFKA_HOST" envDefault:"kafka"`
 		Port string `env:"KAFKA_PORT" envDefault:"9092"`
 	}
+	OrderClient struct {
+		Host string `env:"ORDER_HOST" envDefault:"order-service"`
+		Port string `env:"ORDER_PORT" envDefault:"8080"`
+	}
+	Media struct {
+		Dir      string `env:"MEDIA_DIR" envDefault:"./media"`
+		BaseURL  string `env:"MEDIA_BASE_URL" envDefault:"http://localhost:8090/media"`
+		HTTPPort string `env:"MEDIA_HTTP_PORT" envDefault:"8090"`
+	}
+	Pricing struct {
+		SchedulerInterval time.Duration `env:"PRICE_SCHEDULER_INTERVAL" envDefault:"1m"`
+	}
 }
 
 func New() (*Config, error) {
@@ -34,6 +34,9 @@ func (c *Consumer) Start() {
 
 	listeners := map[string]HandlerFunc{
 		"payment.succeeded": c.handlePaymentSucceed,
+		"orders.confirmed":  c.handleOrderConfirmed,
+		"orders.cancelled":  c.handleOrderCancelled,
+		"returns.received":  c.handleReturnReceived,
 	}
 
 	for topic, handler := range listeners {
@@ -94,6 +97,42 @@ func (c *Consumer) handlePaymentSucceed(ctx context.Context, m *kafka.Message) e
 	return err
 }
 
+func (c *Consumer) handleOrderConfirmed(ctx context.Context, m *kafka.Message) error {
+	var event service.OrderCreatedEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.RecordCoPurchases(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleOrderCancelled(ctx context.Context, m *kafka.Message) error {
+	var event service.OrderCreatedEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.RestockCancelledOrder(ctx, event.Data)
+
+	return err
+}
+
+func (c *Consumer) handleReturnReceived(ctx context.Context, m *kafka.Message) error {
+	var event service.ReturnEvent
+	err := json.Unmarshal(m.Value, &event)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.RestockReturn(ctx, event.Data)
+
+	return err
+}
+
 func (c *Consumer) handlePaymentFailed(ctx context.Context, m *kafka.Message) error {
 	var event service.OrderCreatedEvent
 	err := json.Unmarshal(m.Value, &event)
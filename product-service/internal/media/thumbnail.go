@@ -0,0 +1,130 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"golang.org/x/image/draw"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image format")
+	ErrImageTooLarge    = errors.New("image dimensions too large")
+)
+
+// MaxPixels is the largest width×height accepted. A decoded image takes about four bytes
+// a pixel, however well the file compressed.
+const MaxPixels = 40_000_000
+
+// ThumbnailSizes maps a thumbnail name to the length of its longest edge in pixels.
+var ThumbnailSizes = map[string]int{
+	"small":  150,
+	"medium": 400,
+	"large":  800,
+}
+
+type Thumbnail struct {
+	Name        string
+	ContentType string
+	Extension   string
+	Data        []byte
+}
+
+// Decode parses the uploaded bytes and returns the image along with its format name.
+// The dimensions are checked from the header first, so a small file claiming a huge image
+// is rejected before its pixels are allocated.
+func Decode(data []byte) (image.Image, string, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedImage
+	}
+
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > MaxPixels {
+		return nil, "", ErrImageTooLarge
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedImage
+	}
+
+	return img, format, nil
+}
+
+// Extension returns the file extension used to store an image of the given format.
+func Extension(format string) string {
+	switch format {
+	case "png":
+		return ".png"
+	case "gif":
+		return ".gif"
+	default:
+		return ".jpg"
+	}
+}
+
+// GenerateThumbnails produces one scaled-down copy of img per entry in ThumbnailSizes.
+// PNG sources keep their format to preserve transparency, everything else is encoded as JPEG.
+func GenerateThumbnails(img image.Image, format string) ([]Thumbnail, error) {
+	thumbnails := make([]Thumbnail, 0, len(ThumbnailSizes))
+
+	for name, size := range ThumbnailSizes {
+		scaled := resize(img, size)
+
+		var buf bytes.Buffer
+		thumbnail := Thumbnail{Name: name}
+
+		if format == "png" {
+			if err := png.Encode(&buf, scaled); err != nil {
+				return nil, err
+			}
+			thumbnail.ContentType = "image/png"
+			thumbnail.Extension = ".png"
+		} else {
+			if err := jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: 85}); err != nil {
+				return nil, err
+			}
+			thumbnail.ContentType = "image/jpeg"
+			thumbnail.Extension = ".jpg"
+		}
+
+		thumbnail.Data = buf.Bytes()
+		thumbnails = append(thumbnails, thumbnail)
+	}
+
+	return thumbnails, nil
+}
+
+// resize scales img so that its longest edge equals maxEdge, keeping the aspect ratio.
+// Images that are already small enough are returned unchanged.
+func resize(img image.Image, maxEdge int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width <= maxEdge && height <= maxEdge {
+		return img
+	}
+
+	if width >= height {
+		height = height * maxEdge / width
+		width = maxEdge
+	} else {
+		width = width * maxEdge / height
+		height = maxEdge
+	}
+
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
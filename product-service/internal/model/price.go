@@ -0,0 +1,65 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"shared/money"
+	"time"
+)
+
+type PriceSource int
+
+const (
+	BasePrice      PriceSource = 0
+	SalePrice      PriceSource = 1
+	PriceListPrice PriceSource = 2
+)
+
+// ScheduledPrice is a sale price that applies between StartsAt and EndsAt.
+// A nil EndsAt keeps the sale open-ended and an empty CustomerGroup applies it to everybody.
+type ScheduledPrice struct {
+	ID            string      `json:"id" bson:"id"`
+	Price         money.Money `json:"price" bson:"price"`
+	StartsAt      time.Time   `json:"starts_at" bson:"starts_at"`
+	EndsAt        *time.Time  `json:"ends_at,omitempty" bson:"ends_at,omitempty"`
+	CustomerGroup string      `json:"customer_group,omitempty" bson:"customer_group"`
+}
+
+func (p ScheduledPrice) ActiveAt(t time.Time) bool {
+	return !t.Before(p.StartsAt) && (p.EndsAt == nil || t.Before(*p.EndsAt))
+}
+
+func (p ScheduledPrice) AppliesTo(customerGroup string) bool {
+	return p.CustomerGroup == "" || p.CustomerGroup == customerGroup
+}
+
+type PriceList struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	CustomerGroup string             `json:"customer_group" bson:"customer_group"`
+	Name          string             `json:"name" bson:"name"`
+	Entries       []PriceListEntry   `json:"entries" bson:"entries"`
+	UpdatedAt     time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+type PriceListEntry struct {
+	Sku   string      `json:"sku" bson:"sku"`
+	Price money.Money `json:"price" bson:"price"`
+}
+
+type PriceHistoryEntry struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	ProductID     primitive.ObjectID `json:"product_id" bson:"product_id"`
+	Sku           string             `json:"sku" bson:"sku"`
+	OldPrice      money.Money        `json:"old_price" bson:"old_price"`
+	NewPrice      money.Money        `json:"new_price" bson:"new_price"`
+	CustomerGroup string             `json:"customer_group,omitempty" bson:"customer_group"`
+	Reason        string             `json:"reason" bson:"reason"`
+	ChangedAt     time.Time          `json:"changed_at" bson:"changed_at"`
+}
+
+// EffectivePrice is the price a customer group pays for a product at a given moment.
+type EffectivePrice struct {
+	Amount       money.Money
+	RegularPrice money.Money
+	Source       PriceSource
+	ValidUntil   *time.Time
+}
@@ -2,31 +2,64 @@ package model
 
 import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"shared/money"
+	"slices"
 	"time"
 )
 
-type Currency int
+type ProductType string
 
 const (
-	EUR Currency = 0
-	USD Currency = 1
+	Simple ProductType = "simple"
+	Bundle ProductType = "bundle"
 )
 
-func (c Currency) String() string {
-	return [...]string{"EUR", "USD"}[c]
-}
-
 type Product struct {
 	ID            primitive.ObjectID `bson:"_id,omitempty"`
 	Sku           string             `json:"sku" bson:"sku"`
 	Name          string             `json:"name" bson:"name"`
 	Description   string             `json:"description" bson:"description"`
-	Price         float64            `json:"price" bson:"price"`
-	Currency      Currency           `json:"currency" bson:"currency"`
+	Price         money.Money        `json:"price" bson:"price"`
 	StockQuantity int32              `json:"stock_quantity" bson:"stock_quantity"`
 	Category      string             `json:"category" bson:"category"`
 	ImageURL      string             `json:"image_url" bson:"image_url"`
+	Images        []Image            `json:"images,omitempty" bson:"images,omitempty"`
+	ImagesVersion int64              `json:"-" bson:"images_version,omitempty"`
+	Sales         []ScheduledPrice   `json:"sales,omitempty" bson:"sales,omitempty"`
 	Attributes    map[string]string  `json:"attributes,omitempty" bson:"attributes"`
 	IsActive      bool               `json:"is_active" bson:"is_active"`
 	CreatedAt     time.Time          `json:"created_at" bson:"created_at"`
+	AverageRating float64            `json:"average_rating" bson:"average_rating"`
+	ReviewCount   int32              `json:"review_count" bson:"review_count"`
+	Type          ProductType        `json:"type,omitempty" bson:"type,omitempty"`
+	Components    []BundleComponent  `json:"components,omitempty" bson:"components,omitempty"`
+	VariantSKUs   []string           `json:"variant_skus,omitempty" bson:"variant_skus,omitempty"`
+}
+
+// BundleComponent is a SKU contained in a bundle. A bundle has no stock of its own;
+// its stock is the number of complete kits the components' stock can make up.
+type BundleComponent struct {
+	Sku      string `json:"sku" bson:"sku"`
+	Quantity int32  `json:"quantity" bson:"quantity"`
+}
+
+func (p *Product) IsBundle() bool {
+	return p.Type == Bundle
+}
+
+// HasVariant reports whether sku is one of the product's variants.
+func (p *Product) HasVariant(sku string) bool {
+	return slices.Contains(p.VariantSKUs, sku)
+}
+
+// Image is a gallery entry of a product. Images with an empty VariantSKU belong
+// to the product itself, others to the variant with that SKU, one of VariantSKUs.
+type Image struct {
+	ID          string            `json:"id" bson:"id"`
+	URL         string            `json:"url" bson:"url"`
+	Thumbnails  map[string]string `json:"thumbnails,omitempty" bson:"thumbnails"`
+	Position    int32             `json:"position" bson:"position"`
+	VariantSKU  string            `json:"variant_sku,omitempty" bson:"variant_sku"`
+	ContentType string            `json:"content_type" bson:"content_type"`
+	BlobKeys    []string          `json:"-" bson:"blob_keys"`
 }
@@ -0,0 +1,20 @@
+package model
+
+type RecommendationSource int
+
+const (
+	FrequentlyBoughtTogether RecommendationSource = iota
+	SimilarProduct
+)
+
+// CoPurchase counts the confirmed orders that contained both Sku and RelatedSku.
+type CoPurchase struct {
+	Sku        string `bson:"sku"`
+	RelatedSku string `bson:"related_sku"`
+	Count      int64  `bson:"count"`
+}
+
+type Recommendation struct {
+	Product *Product
+	Source  RecommendationSource
+}
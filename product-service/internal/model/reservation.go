@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Reservation is the stock taken for an order, per product actually decremented: bundles
+// are recorded as the components they were made of when the order was placed.
+type Reservation struct {
+	OrderID    int64            `bson:"_id"`
+	Quantities map[string]int32 `bson:"quantities"`
+	CreatedAt  time.Time        `bson:"created_at"`
+	ReleasedAt *time.Time       `bson:"released_at,omitempty"`
+}
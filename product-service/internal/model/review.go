@@ -0,0 +1,36 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type ReviewStatus string
+
+const (
+	ReviewPending  ReviewStatus = "pending"
+	ReviewApproved ReviewStatus = "approved"
+	ReviewRejected ReviewStatus = "rejected"
+)
+
+// Review is a customer's rating of a product. Only approved reviews are shown publicly
+// and counted towards the product's rating.
+type Review struct {
+	ID               primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	ProductID        primitive.ObjectID `json:"product_id" bson:"product_id"`
+	Sku              string             `json:"sku" bson:"sku"`
+	UserID           int64              `json:"user_id" bson:"user_id"`
+	Rating           int32              `json:"rating" bson:"rating"`
+	Title            string             `json:"title" bson:"title"`
+	Body             string             `json:"body" bson:"body"`
+	VerifiedPurchase bool               `json:"verified_purchase" bson:"verified_purchase"`
+	Status           ReviewStatus       `json:"status" bson:"status"`
+	ModerationNote   string             `json:"moderation_note,omitempty" bson:"moderation_note,omitempty"`
+	CreatedAt        time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+type RatingSummary struct {
+	Average float64 `bson:"average"`
+	Count   int32   `bson:"count"`
+}
@@ -7,10 +7,25 @@ import (
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8"
 	"log"
+	"net/http"
 	"product-catalog-service/internal/model"
 	"strings"
 )
 
+const (
+	SortRelevance = "relevance"
+	SortRating    = "rating"
+)
+
+// productMapping pins the types of fields whose first indexed value would otherwise be
+// guessed wrongly, e.g. an average rating of 0 being mapped as an integer.
+var productMapping = map[string]interface{}{
+	"properties": map[string]interface{}{
+		"average_rating": map[string]interface{}{"type": "float"},
+		"review_count":   map[string]interface{}{"type": "integer"},
+	},
+}
+
 type ElasticRepository struct {
 	ElasticClient *elasticsearch.Client
 	IndexName     string
@@ -23,14 +38,63 @@ func NewElasticRepository(elasticClient *elasticsearch.Client, indexName string)
 	}
 }
 
-func (r *ElasticRepository) GetProducts(ctx context.Context, searchTerm string, from, size int32) ([]*model.Product, error) {
+// EnsureIndex creates the index if it doesn't exist yet and reports whether it did.
+func (r *ElasticRepository) EnsureIndex(ctx context.Context) (bool, error) {
+	res, err := r.ElasticClient.Indices.Exists([]string{r.IndexName}, r.ElasticClient.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return false, r.putMapping(ctx)
+	}
+
+	res, err = r.ElasticClient.Indices.Create(r.IndexName, r.ElasticClient.Indices.Create.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return false, fmt.Errorf("error creating index: %s", res.String())
+	}
+
+	return true, r.putMapping(ctx)
+}
+
+func (r *ElasticRepository) putMapping(ctx context.Context) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(productMapping); err != nil {
+		return err
+	}
+
+	res, err := r.ElasticClient.Indices.PutMapping(
+		[]string{r.IndexName},
+		&buf,
+		r.ElasticClient.Indices.PutMapping.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error updating mapping: %s", res.String())
+	}
+
+	return nil
+}
+
+func (r *ElasticRepository) GetProducts(ctx context.Context, searchTerm string, minRating float64, sortBy string, from, size int32) ([]*model.Product, error) {
 	query := map[string]interface{}{
 		"from": from,
 		"size": size,
 	}
 
+	var match map[string]interface{}
 	if searchTerm != "" {
-		query["query"] = map[string]interface{}{
+		match = map[string]interface{}{
 			"multi_match": map[string]interface{}{
 				"query":    searchTerm,
 				"fields":   []string{"name^3", "description", "category", "attributes.*"},
@@ -38,11 +102,64 @@ func (r *ElasticRepository) GetProducts(ctx context.Context, searchTerm string,
 			},
 		}
 	} else {
-		query["query"] = map[string]interface{}{
+		match = map[string]interface{}{
 			"match_all": map[string]interface{}{},
 		}
 	}
 
+	if minRating > 0 {
+		query["query"] = map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": match,
+				"filter": map[string]interface{}{
+					"range": map[string]interface{}{
+						"average_rating": map[string]interface{}{"gte": minRating},
+					},
+				},
+			},
+		}
+	} else {
+		query["query"] = match
+	}
+
+	if sortBy == SortRating {
+		query["sort"] = []interface{}{
+			map[string]interface{}{"average_rating": map[string]interface{}{"order": "desc", "unmapped_type": "float"}},
+			map[string]interface{}{"review_count": map[string]interface{}{"order": "desc", "unmapped_type": "integer"}},
+			"_score",
+		}
+	}
+
+	return r.search(ctx, query)
+}
+
+// MoreLikeThis finds in-stock products whose name, description or category resemble the given product.
+func (r *ElasticRepository) MoreLikeThis(ctx context.Context, productID string, size int) ([]*model.Product, error) {
+	query := map[string]interface{}{
+		"size": size,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": map[string]interface{}{
+					"more_like_this": map[string]interface{}{
+						"fields":          []string{"name", "description", "category"},
+						"like":            []map[string]interface{}{{"_index": r.IndexName, "_id": productID}},
+						"min_term_freq":   1,
+						"min_doc_freq":    1,
+						"max_query_terms": 25,
+					},
+				},
+				"filter": []map[string]interface{}{
+					{"range": map[string]interface{}{"stock_quantity": map[string]interface{}{"gt": 0}}},
+					{"term": map[string]interface{}{"is_active": true}},
+				},
+			},
+		},
+	}
+
+	return r.search(ctx, query)
+}
+
+func (r *ElasticRepository) search(ctx context.Context, query map[string]interface{}) ([]*model.Product, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
 		return nil, err
@@ -99,7 +216,7 @@ func (r *ElasticRepository) CreateOrUpdateProduct(ctx context.Context, product *
 		return err
 	}
 	res, err := r.ElasticClient.Index(
-		"products_idx",
+		r.IndexName,
 		strings.NewReader(string(docBytes)),
 		r.ElasticClient.Index.WithDocumentID(product.ID.Hex()),
 		r.ElasticClient.Index.WithRefresh("true"),
@@ -115,7 +232,7 @@ func (r *ElasticRepository) CreateOrUpdateProduct(ctx context.Context, product *
 
 func (r *ElasticRepository) DeleteProduct(ctx context.Context, id string) error {
 	res, err := r.ElasticClient.Delete(
-		"products_idx",
+		r.IndexName,
 		id,
 		r.ElasticClient.Delete.WithRefresh("true"),
 		r.ElasticClient.Delete.WithContext(ctx),
@@ -66,12 +66,14 @@ func (r *MongoRepository) UpdateProduct(ctx context.Context, product *model.Prod
 			"name":           product.Name,
 			"description":    product.Description,
 			"price":          product.Price,
-			"currency":       product.Currency,
 			"stock_quantity": product.StockQuantity,
 			"category":       product.Category,
 			"image_url":      product.ImageURL,
 			"attributes":     product.Attributes,
 			"is_active":      product.IsActive,
+			"type":           product.Type,
+			"components":     product.Components,
+			"variant_skus":   product.VariantSKUs,
 			"updated_at":     time.Now(),
 		},
 	}
@@ -121,3 +123,173 @@ func (r *MongoRepository) BulkGetBySKUs(ctx context.Context, skus []string) ([]*
 
 	return products, nil
 }
+
+// UpdateProductImages saves the gallery of a product whose gallery is still at version, and
+// moves it to the next version. Nothing matches once another update moved it on.
+func (r *MongoRepository) UpdateProductImages(ctx context.Context, id primitive.ObjectID, version int64, images []model.Image, imageURL string) (*mongo.UpdateResult, error) {
+	filter := primitive.M{"_id": id, "images_version": version}
+	if version == 0 {
+		// Galleries that were never saved have no version yet.
+		filter["images_version"] = primitive.M{"$in": primitive.A{0, nil}}
+	}
+
+	update := primitive.M{
+		"$set": primitive.M{
+			"images":     images,
+			"image_url":  imageURL,
+			"updated_at": time.Now(),
+		},
+		"$inc": primitive.M{"images_version": 1},
+	}
+
+	result, err := r.MongoCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *MongoRepository) AddSale(ctx context.Context, id primitive.ObjectID, sale model.ScheduledPrice) (*mongo.UpdateResult, error) {
+	update := primitive.M{
+		"$push": primitive.M{"sales": sale},
+		"$set":  primitive.M{"updated_at": time.Now()},
+	}
+
+	return r.MongoCollection.UpdateByID(ctx, id, update)
+}
+
+func (r *MongoRepository) RemoveSale(ctx context.Context, id primitive.ObjectID, saleID string) (*mongo.UpdateResult, error) {
+	update := primitive.M{
+		"$pull": primitive.M{"sales": primitive.M{"id": saleID}},
+		"$set":  primitive.M{"updated_at": time.Now()},
+	}
+
+	return r.MongoCollection.UpdateByID(ctx, id, update)
+}
+
+// GetProductsWithSaleTransitions returns products that have a sale starting or ending in (from, to].
+func (r *MongoRepository) GetProductsWithSaleTransitions(ctx context.Context, from, to time.Time) ([]*model.Product, error) {
+	window := bson.M{"$gt": from, "$lte": to}
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"sales": bson.M{"$elemMatch": bson.M{"starts_at": window}}},
+			bson.M{"sales": bson.M{"$elemMatch": bson.M{"ends_at": window}}},
+		},
+	}
+
+	cursor, err := r.MongoCollection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var products []*model.Product
+	if err = cursor.All(ctx, &products); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// MigrateLegacyPrices converts products stored with a floating-point price and a numeric
+// currency enum into the Money sub-document. Already migrated documents are left alone.
+func (r *MongoRepository) MigrateLegacyPrices(ctx context.Context) (int64, error) {
+	filter := bson.M{"price": bson.M{"$type": "number"}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"price": bson.M{
+				"amount": bson.M{"$toLong": bson.M{"$round": bson.A{bson.M{"$multiply": bson.A{bson.M{"$toDecimal": "$price"}, 100}}, 0}}},
+				"currency": bson.M{"$arrayElemAt": bson.A{
+					bson.A{"EUR", "USD"},
+					bson.M{"$ifNull": bson.A{"$currency", 0}},
+				}},
+			},
+		}}},
+		{{Key: "$unset", Value: "currency"}},
+	}
+
+	result, err := r.MongoCollection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+func (r *MongoRepository) GetAllProducts(ctx context.Context) ([]*model.Product, error) {
+	cursor, err := r.MongoCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var products []*model.Product
+	if err = cursor.All(ctx, &products); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *MongoRepository) UpdateProductRating(ctx context.Context, id primitive.ObjectID, summary model.RatingSummary) (*mongo.UpdateResult, error) {
+	update := primitive.M{
+		"$set": primitive.M{
+			"average_rating": summary.Average,
+			"review_count":   summary.Count,
+		},
+	}
+
+	result, err := r.MongoCollection.UpdateByID(ctx, id, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AdjustStock changes the stock of a product by delta. A decrease only applies while enough
+// stock is left, otherwise nothing is matched.
+func (r *MongoRepository) AdjustStock(ctx context.Context, id primitive.ObjectID, delta int32) (*mongo.UpdateResult, error) {
+	filter := bson.M{"_id": id}
+	if delta < 0 {
+		filter["stock_quantity"] = bson.M{"$gte": -delta}
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"stock_quantity": bson.M{"$add": bson.A{"$stock_quantity", delta}},
+			"updated_at":     time.Now(),
+		}}},
+		{{Key: "$set", Value: bson.M{"is_active": bson.M{"$gt": bson.A{"$stock_quantity", 0}}}}},
+	}
+
+	return r.MongoCollection.UpdateOne(ctx, filter, update)
+}
+
+func (r *MongoRepository) SetStock(ctx context.Context, id primitive.ObjectID, stock int32) (*mongo.UpdateResult, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"stock_quantity": stock,
+			"is_active":      stock > 0,
+			"updated_at":     time.Now(),
+		},
+	}
+
+	return r.MongoCollection.UpdateByID(ctx, id, update)
+}
+
+func (r *MongoRepository) GetBundlesContaining(ctx context.Context, skus []string) ([]*model.Product, error) {
+	cursor, err := r.MongoCollection.Find(ctx, bson.M{"type": model.Bundle, "components.sku": bson.M{"$in": skus}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var products []*model.Product
+	if err = cursor.All(ctx, &products); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"product-catalog-service/internal/model"
+	"time"
+)
+
+// saleTransitionsID identifies the document recording how far sale transitions were
+// processed.
+const saleTransitionsID = "sale_transitions"
+
+type PriceRepository struct {
+	PriceListCollection      *mongo.Collection
+	PriceHistoryCollection   *mongo.Collection
+	SchedulerStateCollection *mongo.Collection
+}
+
+func NewPriceRepository(priceListCollection, priceHistoryCollection, schedulerStateCollection *mongo.Collection) *PriceRepository {
+	return &PriceRepository{
+		PriceListCollection:      priceListCollection,
+		PriceHistoryCollection:   priceHistoryCollection,
+		SchedulerStateCollection: schedulerStateCollection,
+	}
+}
+
+func (r *PriceRepository) GetPriceList(ctx context.Context, customerGroup string) (*model.PriceList, error) {
+	var priceList model.PriceList
+
+	err := r.PriceListCollection.FindOne(ctx, bson.M{"customer_group": customerGroup}).Decode(&priceList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &priceList, nil
+}
+
+func (r *PriceRepository) UpsertPriceList(ctx context.Context, priceList *model.PriceList) error {
+	update := bson.M{
+		"$set": bson.M{
+			"name":       priceList.Name,
+			"entries":    priceList.Entries,
+			"updated_at": time.Now(),
+		},
+	}
+
+	_, err := r.PriceListCollection.UpdateOne(ctx,
+		bson.M{"customer_group": priceList.CustomerGroup},
+		update,
+		options.Update().SetUpsert(true),
+	)
+
+	return err
+}
+
+func (r *PriceRepository) InsertPriceHistory(ctx context.Context, entry *model.PriceHistoryEntry) error {
+	_, err := r.PriceHistoryCollection.InsertOne(ctx, entry)
+	return err
+}
+
+func (r *PriceRepository) GetPriceHistory(ctx context.Context, sku string, limit int64) ([]*model.PriceHistoryEntry, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "changed_at", Value: -1}}).SetLimit(limit)
+
+	cursor, err := r.PriceHistoryCollection.Find(ctx, bson.M{"sku": sku}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*model.PriceHistoryEntry
+	if err = cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		return []*model.PriceHistoryEntry{}, nil
+	}
+
+	return entries, nil
+}
+
+// GetSaleTransitionsProcessedUntil returns the time up to which sale transitions were
+// processed, or the zero time if they never were.
+func (r *PriceRepository) GetSaleTransitionsProcessedUntil(ctx context.Context) (time.Time, error) {
+	var state struct {
+		ProcessedUntil time.Time `bson:"processed_until"`
+	}
+
+	err := r.SchedulerStateCollection.FindOne(ctx, bson.M{"_id": saleTransitionsID}).Decode(&state)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	return state.ProcessedUntil, nil
+}
+
+func (r *PriceRepository) SetSaleTransitionsProcessedUntil(ctx context.Context, until time.Time) error {
+	_, err := r.SchedulerStateCollection.UpdateByID(ctx, saleTransitionsID,
+		bson.M{"$set": bson.M{"processed_until": until}},
+		options.Update().SetUpsert(true),
+	)
+
+	return err
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"product-catalog-service/internal/model"
+	"time"
+)
+
+type RecommendationRepository struct {
+	CoPurchaseCollection     *mongo.Collection
+	ProcessedOrderCollection *mongo.Collection
+}
+
+func NewRecommendationRepository(coPurchaseCollection, processedOrderCollection *mongo.Collection) *RecommendationRepository {
+	return &RecommendationRepository{
+		CoPurchaseCollection:     coPurchaseCollection,
+		ProcessedOrderCollection: processedOrderCollection,
+	}
+}
+
+func (r *RecommendationRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.CoPurchaseCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "sku", Value: 1}, {Key: "related_sku", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "sku", Value: 1}, {Key: "count", Value: -1}},
+		},
+	})
+
+	return err
+}
+
+// MarkOrderProcessed claims an order for counting and reports false if it was already
+// claimed, so redelivered events don't inflate the counts.
+func (r *RecommendationRepository) MarkOrderProcessed(ctx context.Context, orderID int64) (bool, error) {
+	_, err := r.ProcessedOrderCollection.InsertOne(ctx, bson.M{"_id": orderID, "processed_at": time.Now()})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IncrementCoPurchases adds one to the count of every ordered pair of distinct SKUs.
+func (r *RecommendationRepository) IncrementCoPurchases(ctx context.Context, skus []string) error {
+	var models []mongo.WriteModel
+	for _, sku := range skus {
+		for _, related := range skus {
+			if sku == related {
+				continue
+			}
+
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"sku": sku, "related_sku": related}).
+				SetUpdate(bson.M{"$inc": bson.M{"count": 1}}).
+				SetUpsert(true))
+		}
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err := r.CoPurchaseCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return err
+}
+
+func (r *RecommendationRepository) GetCoPurchases(ctx context.Context, sku string, limit int64) ([]model.CoPurchase, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "count", Value: -1}}).SetLimit(limit)
+
+	cursor, err := r.CoPurchaseCollection.Find(ctx, bson.M{"sku": sku}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var coPurchases []model.CoPurchase
+	if err = cursor.All(ctx, &coPurchases); err != nil {
+		return nil, err
+	}
+
+	return coPurchases, nil
+}
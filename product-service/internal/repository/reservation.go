@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"product-catalog-service/internal/model"
+	"time"
+)
+
+type ReservationRepository struct {
+	ReservationCollection *mongo.Collection
+}
+
+func NewReservationRepository(reservationCollection *mongo.Collection) *ReservationRepository {
+	return &ReservationRepository{
+		ReservationCollection: reservationCollection,
+	}
+}
+
+func (r *ReservationRepository) CreateReservation(ctx context.Context, reservation *model.Reservation) error {
+	_, err := r.ReservationCollection.InsertOne(ctx, reservation)
+
+	return err
+}
+
+func (r *ReservationRepository) GetReservation(ctx context.Context, orderID int64) (*model.Reservation, error) {
+	var reservation model.Reservation
+	err := r.ReservationCollection.FindOne(ctx, bson.M{"_id": orderID}).Decode(&reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+// MarkReservationReleased records that the stock of an order was given back and reports
+// false if it already was, so the stock is only released once.
+func (r *ReservationRepository) MarkReservationReleased(ctx context.Context, orderID int64) (bool, error) {
+	filter := bson.M{"_id": orderID, "released_at": nil}
+	update := bson.M{"$set": bson.M{"released_at": time.Now()}}
+
+	result, err := r.ReservationCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
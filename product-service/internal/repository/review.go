@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"product-catalog-service/internal/model"
+	"time"
+)
+
+type ReviewRepository struct {
+	ReviewCollection *mongo.Collection
+}
+
+func NewReviewRepository(reviewCollection *mongo.Collection) *ReviewRepository {
+	return &ReviewRepository{
+		ReviewCollection: reviewCollection,
+	}
+}
+
+// EnsureIndexes allows a single review per user and product and backs the per-product listing.
+func (r *ReviewRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.ReviewCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "product_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "product_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	})
+
+	return err
+}
+
+func (r *ReviewRepository) CreateReview(ctx context.Context, review *model.Review) error {
+	result, err := r.ReviewCollection.InsertOne(ctx, review)
+	if err != nil {
+		return err
+	}
+
+	review.ID = result.InsertedID.(primitive.ObjectID)
+
+	return nil
+}
+
+func (r *ReviewRepository) GetReviewByID(ctx context.Context, id primitive.ObjectID) (*model.Review, error) {
+	var review model.Review
+
+	err := r.ReviewCollection.FindOne(ctx, bson.M{"_id": id}).Decode(&review)
+	if err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (r *ReviewRepository) ListReviews(ctx context.Context, productID primitive.ObjectID, status model.ReviewStatus, skip, limit int64) ([]*model.Review, int64, error) {
+	filter := bson.M{"product_id": productID, "status": status}
+
+	total, err := r.ReviewCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetSkip(skip).SetLimit(limit)
+	cursor, err := r.ReviewCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var reviews []*model.Review
+	if err = cursor.All(ctx, &reviews); err != nil {
+		return nil, 0, err
+	}
+
+	if reviews == nil {
+		return []*model.Review{}, total, nil
+	}
+
+	return reviews, total, nil
+}
+
+func (r *ReviewRepository) UpdateReviewStatus(ctx context.Context, id primitive.ObjectID, status model.ReviewStatus, note string) (*mongo.UpdateResult, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"status":          status,
+			"moderation_note": note,
+			"updated_at":      time.Now(),
+		},
+	}
+
+	return r.ReviewCollection.UpdateByID(ctx, id, update)
+}
+
+// GetRatingSummary averages the approved reviews of a product.
+func (r *ReviewRepository) GetRatingSummary(ctx context.Context, productID primitive.ObjectID) (model.RatingSummary, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"product_id": productID, "status": model.ReviewApproved}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"average": bson.M{"$avg": "$rating"},
+			"count":   bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.ReviewCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return model.RatingSummary{}, err
+	}
+	defer cursor.Close(ctx)
+
+	var summary model.RatingSummary
+	if cursor.Next(ctx) {
+		if err = cursor.Decode(&summary); err != nil {
+			return model.RatingSummary{}, err
+		}
+	}
+
+	return summary, cursor.Err()
+}
@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"product-catalog-service/internal/service"
+	"sync"
+	"time"
+)
+
+// PriceScheduler periodically announces price changes caused by sales starting or ending.
+// How far transitions were processed is stored, so the ones that happen while the service
+// is down are announced on the first tick after it starts.
+type PriceScheduler struct {
+	service  *service.Service
+	interval time.Duration
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewPriceScheduler(svc *service.Service, interval time.Duration) *PriceScheduler {
+	return &PriceScheduler{
+		service:  svc,
+		interval: interval,
+	}
+}
+
+func (s *PriceScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+}
+
+func (s *PriceScheduler) Stop() {
+	log.Println("Stopping price scheduler...")
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Price scheduler stopped.")
+}
+
+func (s *PriceScheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.service.ProcessPendingSaleTransitions(ctx, now); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
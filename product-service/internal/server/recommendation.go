@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"product-catalog-service/internal/model"
+	"product-catalog-service/internal/service"
+	pb "product-catalog-service/protobuf"
+	"time"
+)
+
+func (s *Server) GetRecommendations(ctx context.Context, r *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResponse, error) {
+	recommendations, err := s.service.GetRecommendations(ctx, r.GetSku(), r.GetLimit())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	products := make([]*model.Product, len(recommendations))
+	for i, recommendation := range recommendations {
+		products[i] = recommendation.Product
+	}
+
+	prices, err := s.service.EffectivePrices(ctx, products, customerGroup(ctx, r.GetCustomerGroup()), time.Now())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	result := make([]*pb.Recommendation, len(recommendations))
+	for i, recommendation := range recommendations {
+		result[i] = &pb.Recommendation{
+			Product: productToPB(recommendation.Product, prices[i]),
+			Source:  pb.RecommendationSource(recommendation.Source),
+		}
+	}
+
+	return &pb.GetRecommendationsResponse{Recommendations: result}, nil
+}
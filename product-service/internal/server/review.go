@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"product-catalog-service/internal/model"
+	"product-catalog-service/internal/service"
+	pb "product-catalog-service/protobuf"
+	"strings"
+)
+
+var reviewStatuses = map[pb.ReviewStatus]model.ReviewStatus{
+	pb.ReviewStatus_APPROVED: model.ReviewApproved,
+	pb.ReviewStatus_PENDING:  model.ReviewPending,
+	pb.ReviewStatus_REJECTED: model.ReviewRejected,
+}
+
+func (s *Server) CreateReview(ctx context.Context, r *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	review, err := s.service.CreateReview(ctx, int64(userID), r.GetProductId(), r.GetRating(), r.GetTitle(), r.GetBody())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		case errors.Is(err, service.ErrInvalidReview):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrReviewExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.CreateReviewResponse{Review: reviewToPB(review)}, nil
+}
+
+func (s *Server) ListReviews(ctx context.Context, r *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	reviewStatus, ok := reviewStatuses[r.GetStatus()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid review status")
+	}
+
+	// Only staff see the reviews that aren't published.
+	if role, _ := ctx.Value("role").(string); reviewStatus != model.ReviewApproved && role != "staff" {
+		return nil, status.Error(codes.PermissionDenied, "staff role required")
+	}
+
+	reviews, total, err := s.service.ListReviews(ctx, r.GetProductId(), reviewStatus, r.GetPage(), r.GetPageSize())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	result := make([]*pb.Review, len(reviews))
+	for i, review := range reviews {
+		result[i] = reviewToPB(review)
+	}
+
+	return &pb.ListReviewsResponse{Reviews: result, Total: total}, nil
+}
+
+func (s *Server) ModerateReview(ctx context.Context, r *pb.ModerateReviewRequest) (*pb.ModerateReviewResponse, error) {
+	reviewStatus, ok := reviewStatuses[r.GetStatus()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid review status")
+	}
+
+	review, err := s.service.ModerateReview(ctx, r.GetId(), reviewStatus, r.GetNote())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("review not found: %v", err))
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.ModerateReviewResponse{Review: reviewToPB(review)}, nil
+}
+
+func reviewToPB(review *model.Review) *pb.Review {
+	return &pb.Review{
+		Id:               review.ID.Hex(),
+		ProductId:        review.ProductID.Hex(),
+		UserId:           review.UserID,
+		Rating:           review.Rating,
+		Title:            review.Title,
+		Body:             review.Body,
+		VerifiedPurchase: review.VerifiedPurchase,
+		Status:           pb.ReviewStatus(pb.ReviewStatus_value[strings.ToUpper(string(review.Status))]),
+		ModerationNote:   review.ModerationNote,
+		CreatedAt:        timestamppb.New(review.CreatedAt),
+	}
+}
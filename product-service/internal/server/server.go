@@ -1,16 +1,26 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"log"
+	"product-catalog-service/internal/model"
+	"product-catalog-service/internal/repository"
 	"product-catalog-service/internal/service"
 	pb "product-catalog-service/protobuf"
+	"shared/money"
+	"time"
 )
 
+const maxImageSize = 10 << 20
+
 type Server struct {
 	pb.UnimplementedProductCatalogServiceServer
 	service *service.Service
@@ -31,19 +41,13 @@ func (s *Server) GetProduct(ctx context.Context, r *pb.GetProductRequest) (*pb.G
 		return nil, err
 	}
 
-	return &pb.GetProductResponse{Product: &pb.Product{
-		Id:            product.ID.Hex(),
-		Sku:           product.Sku,
-		Name:          product.Name,
-		Description:   product.Description,
-		Price:         product.Price,
-		Currency:      pb.Currency(product.Currency),
-		StockQuantity: product.StockQuantity,
-		Category:      product.Category,
-		ImageUrl:      product.ImageURL,
-		IsActive:      product.IsActive,
-		Attributes:    product.Attributes,
-	}}, nil
+	price, err := s.service.EffectivePrice(ctx, product, customerGroup(ctx, r.GetCustomerGroup()), time.Now())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.GetProductResponse{Product: productToPB(product, price)}, nil
 }
 
 func (s *Server) ListProducts(ctx context.Context, r *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
@@ -52,27 +56,26 @@ func (s *Server) ListProducts(ctx context.Context, r *pb.ListProductsRequest) (*
 		query = r.GetQuery()
 	}
 
-	products, err := s.service.ListProducts(ctx, query, r.GetPage(), r.GetPageSize())
+	sortBy := repository.SortRelevance
+	if r.GetSort() == pb.ProductSort_RATING {
+		sortBy = repository.SortRating
+	}
+
+	products, err := s.service.ListProducts(ctx, query, r.GetMinRating(), sortBy, r.GetPage(), r.GetPageSize())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	prices, err := s.service.EffectivePrices(ctx, products, customerGroup(ctx, r.GetCustomerGroup()), time.Now())
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
 	var productList []*pb.Product
-	for _, product := range products {
-		productList = append(productList, &pb.Product{
-			Id:            product.ID.Hex(),
-			Sku:           product.Sku,
-			Name:          product.Name,
-			Description:   product.Description,
-			Price:         product.Price,
-			Currency:      pb.Currency(product.Currency),
-			StockQuantity: product.StockQuantity,
-			Category:      product.Category,
-			ImageUrl:      product.ImageURL,
-			IsActive:      product.IsActive,
-			Attributes:    product.Attributes,
-		})
+	for i, product := range products {
+		productList = append(productList, productToPB(product, prices[i]))
 	}
 
 	return &pb.ListProductsResponse{Products: productList}, nil
@@ -81,6 +84,9 @@ func (s *Server) ListProducts(ctx context.Context, r *pb.ListProductsRequest) (*
 func (s *Server) CreateProduct(ctx context.Context, r *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
 	id, err := s.service.CreateProduct(ctx, r)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidPrice) || errors.Is(err, service.ErrInvalidBundle) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		log.Println(err)
 		return nil, err
 	}
@@ -91,6 +97,12 @@ func (s *Server) CreateProduct(ctx context.Context, r *pb.CreateProductRequest)
 func (s *Server) UpdateProduct(ctx context.Context, r *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
 	err := s.service.UpdateProduct(ctx, r)
 	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		case errors.Is(err, service.ErrInvalidPrice), errors.Is(err, service.ErrInvalidBundle):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		log.Println(err)
 		return nil, err
 	}
@@ -120,17 +132,289 @@ func (s *Server) GetProductBySKU(ctx context.Context, r *pb.GetProductBySKUReque
 		return nil, err
 	}
 
-	return &pb.GetProductBySKUResponse{Product: &pb.Product{
-		Id:            product.ID.Hex(),
-		Sku:           product.Sku,
-		Name:          product.Name,
-		Description:   product.Description,
-		Price:         product.Price,
-		Currency:      pb.Currency(product.Currency),
-		StockQuantity: product.StockQuantity,
-		Category:      product.Category,
-		ImageUrl:      product.ImageURL,
-		IsActive:      product.IsActive,
-		Attributes:    product.Attributes,
-	}}, nil
+	price, err := s.service.EffectivePrice(ctx, product, customerGroup(ctx, r.GetCustomerGroup()), time.Now())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.GetProductBySKUResponse{Product: productToPB(product, price)}, nil
+}
+
+func (s *Server) UploadProductImage(stream grpc.ClientStreamingServer[pb.UploadProductImageRequest, pb.UploadProductImageResponse]) error {
+	r, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "image metadata expected")
+	}
+
+	metadata := r.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain image metadata")
+	}
+
+	var data bytes.Buffer
+	for {
+		r, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk := r.GetChunk()
+		if data.Len()+len(chunk) > maxImageSize {
+			return status.Error(codes.ResourceExhausted, fmt.Sprintf("image exceeds %d bytes", maxImageSize))
+		}
+		data.Write(chunk)
+	}
+
+	if data.Len() == 0 {
+		return status.Error(codes.InvalidArgument, "image is empty")
+	}
+
+	image, err := s.service.UploadProductImage(stream.Context(), metadata.GetProductId(), metadata.GetVariantSku(), data.Bytes())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		case errors.Is(err, service.ErrInvalidImage), errors.Is(err, service.ErrUnknownVariant):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrImagesConflict):
+			return status.Error(codes.Aborted, err.Error())
+		}
+		log.Println(err)
+		return status.Error(codes.Internal, "failed to upload image")
+	}
+
+	return stream.SendAndClose(&pb.UploadProductImageResponse{Image: imageToPB(*image)})
+}
+
+func (s *Server) ReorderProductImages(ctx context.Context, r *pb.ReorderProductImagesRequest) (*pb.ReorderProductImagesResponse, error) {
+	images, err := s.service.ReorderProductImages(ctx, r.GetProductId(), r.GetVariantSku(), r.GetImageIds())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		case errors.Is(err, service.ErrInvalidImageOrder):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrImagesConflict):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.ReorderProductImagesResponse{Images: imagesToPB(images)}, nil
+}
+
+func (s *Server) DeleteProductImage(ctx context.Context, r *pb.DeleteProductImageRequest) (*pb.DeleteProductImageResponse, error) {
+	err := s.service.DeleteProductImage(ctx, r.GetProductId(), r.GetImageId())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("image not found: %v", err))
+		case errors.Is(err, service.ErrImagesConflict):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.DeleteProductImageResponse{}, nil
+}
+
+func productToPB(product *model.Product, price model.EffectivePrice) *pb.Product {
+	var validUntil *timestamppb.Timestamp
+	if price.ValidUntil != nil {
+		validUntil = timestamppb.New(*price.ValidUntil)
+	}
+
+	return &pb.Product{
+		Id:              product.ID.Hex(),
+		Sku:             product.Sku,
+		Name:            product.Name,
+		Description:     product.Description,
+		Price:           moneyToPB(price.Amount),
+		StockQuantity:   product.StockQuantity,
+		Category:        product.Category,
+		ImageUrl:        product.ImageURL,
+		IsActive:        product.IsActive,
+		Attributes:      product.Attributes,
+		Images:          imagesToPB(product.Images),
+		RegularPrice:    moneyToPB(price.RegularPrice),
+		PriceSource:     pb.PriceSource(price.Source),
+		PriceValidUntil: validUntil,
+		AverageRating:   product.AverageRating,
+		ReviewCount:     product.ReviewCount,
+		Type:            productTypeToPB(product.Type),
+		Components:      componentsToPB(product.Components),
+		VariantSkus:     product.VariantSKUs,
+	}
+}
+
+func productTypeToPB(productType model.ProductType) pb.ProductType {
+	if productType == model.Bundle {
+		return pb.ProductType_BUNDLE
+	}
+
+	return pb.ProductType_SIMPLE
+}
+
+func componentsToPB(components []model.BundleComponent) []*pb.BundleComponent {
+	result := make([]*pb.BundleComponent, len(components))
+	for i, component := range components {
+		result[i] = &pb.BundleComponent{
+			Sku:      component.Sku,
+			Quantity: component.Quantity,
+		}
+	}
+
+	return result
+}
+
+func imagesToPB(images []model.Image) []*pb.ProductImage {
+	result := make([]*pb.ProductImage, len(images))
+	for i, image := range images {
+		result[i] = imageToPB(image)
+	}
+
+	return result
+}
+
+func imageToPB(image model.Image) *pb.ProductImage {
+	return &pb.ProductImage{
+		Id:          image.ID,
+		Url:         image.URL,
+		Thumbnails:  image.Thumbnails,
+		Position:    image.Position,
+		VariantSku:  image.VariantSKU,
+		ContentType: image.ContentType,
+	}
+}
+
+func (s *Server) SchedulePrice(ctx context.Context, r *pb.SchedulePriceRequest) (*pb.SchedulePriceResponse, error) {
+	var startsAt time.Time
+	if r.GetStartsAt() != nil {
+		startsAt = r.GetStartsAt().AsTime()
+	}
+
+	var endsAt *time.Time
+	if r.GetEndsAt() != nil {
+		t := r.GetEndsAt().AsTime()
+		endsAt = &t
+	}
+
+	sale, err := s.service.SchedulePrice(ctx, r.GetProductId(), moneyFromPB(r.GetPrice()), startsAt, endsAt, r.GetCustomerGroup())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		case errors.Is(err, service.ErrInvalidSchedule):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.SchedulePriceResponse{ScheduledPrice: scheduledPriceToPB(sale)}, nil
+}
+
+func (s *Server) CancelScheduledPrice(ctx context.Context, r *pb.CancelScheduledPriceRequest) (*pb.CancelScheduledPriceResponse, error) {
+	err := s.service.CancelScheduledPrice(ctx, r.GetProductId(), r.GetId())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("scheduled price not found: %v", err))
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.CancelScheduledPriceResponse{}, nil
+}
+
+func (s *Server) UpsertPriceList(ctx context.Context, r *pb.UpsertPriceListRequest) (*pb.UpsertPriceListResponse, error) {
+	entries := make([]model.PriceListEntry, len(r.GetEntries()))
+	for i, entry := range r.GetEntries() {
+		entries[i] = model.PriceListEntry{
+			Sku:   entry.GetSku(),
+			Price: moneyFromPB(entry.GetPrice()),
+		}
+	}
+
+	err := s.service.UpsertPriceList(ctx, &model.PriceList{
+		CustomerGroup: r.GetCustomerGroup(),
+		Name:          r.GetName(),
+		Entries:       entries,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPriceList) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return &pb.UpsertPriceListResponse{}, nil
+}
+
+func (s *Server) GetPriceHistory(ctx context.Context, r *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryResponse, error) {
+	history, err := s.service.GetPriceHistory(ctx, r.GetProductId(), r.GetLimit())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("product not found: %v", err))
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	entries := make([]*pb.PriceHistoryEntry, len(history))
+	for i, entry := range history {
+		entries[i] = &pb.PriceHistoryEntry{
+			OldPrice:      moneyToPB(entry.OldPrice),
+			NewPrice:      moneyToPB(entry.NewPrice),
+			CustomerGroup: entry.CustomerGroup,
+			Reason:        entry.Reason,
+			ChangedAt:     timestamppb.New(entry.ChangedAt),
+		}
+	}
+
+	return &pb.GetPriceHistoryResponse{Entries: entries}, nil
+}
+
+func scheduledPriceToPB(sale *model.ScheduledPrice) *pb.ScheduledPrice {
+	var endsAt *timestamppb.Timestamp
+	if sale.EndsAt != nil {
+		endsAt = timestamppb.New(*sale.EndsAt)
+	}
+
+	return &pb.ScheduledPrice{
+		Id:            sale.ID,
+		Price:         moneyToPB(sale.Price),
+		StartsAt:      timestamppb.New(sale.StartsAt),
+		EndsAt:        endsAt,
+		CustomerGroup: sale.CustomerGroup,
+	}
+}
+
+// customerGroup is the price list a request is priced from: the group in the caller's
+// token. Staff may ask for another group's prices.
+func customerGroup(ctx context.Context, requested string) string {
+	if role, _ := ctx.Value("role").(string); role == "staff" && requested != "" {
+		return requested
+	}
+
+	group, _ := ctx.Value("customer-group").(string)
+	return group
+}
+
+func moneyToPB(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func moneyFromPB(m *pb.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
 }
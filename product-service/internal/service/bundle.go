@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"product-catalog-service/internal/model"
+	pb "product-catalog-service/protobuf"
+)
+
+func componentsFromPB(productType pb.ProductType, components []*pb.BundleComponent) (model.ProductType, []model.BundleComponent) {
+	if productType != pb.ProductType_BUNDLE {
+		return model.Simple, nil
+	}
+
+	result := make([]model.BundleComponent, len(components))
+	for i, component := range components {
+		result[i] = model.BundleComponent{
+			Sku:      component.GetSku(),
+			Quantity: component.GetQuantity(),
+		}
+	}
+
+	return model.Bundle, result
+}
+
+// prepareBundle checks that the components of a bundle exist and are simple products, and
+// derives the bundle's stock from them.
+func (s *Service) prepareBundle(ctx context.Context, product *model.Product) error {
+	if len(product.Components) == 0 {
+		return ErrInvalidBundle
+	}
+
+	skus := make([]string, len(product.Components))
+	seen := make(map[string]struct{}, len(product.Components))
+	for i, component := range product.Components {
+		if _, ok := seen[component.Sku]; ok || component.Quantity <= 0 || component.Sku == product.Sku {
+			return ErrInvalidBundle
+		}
+		seen[component.Sku] = struct{}{}
+		skus[i] = component.Sku
+	}
+
+	components, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
+	if err != nil {
+		return err
+	}
+
+	productBySku := make(map[string]*model.Product, len(components))
+	for _, component := range components {
+		if component.IsBundle() {
+			return ErrInvalidBundle
+		}
+		productBySku[component.Sku] = component
+	}
+
+	if len(productBySku) != len(skus) {
+		return ErrInvalidBundle
+	}
+
+	product.StockQuantity = bundleStock(product, productBySku)
+	product.IsActive = product.StockQuantity > 0
+
+	return nil
+}
+
+// bundleStock is the number of complete bundles the components' stock makes up.
+func bundleStock(bundle *model.Product, productBySku map[string]*model.Product) int32 {
+	stock := int32(math.MaxInt32)
+	for _, component := range bundle.Components {
+		product, ok := productBySku[component.Sku]
+		if !ok {
+			return 0
+		}
+
+		stock = min(stock, product.StockQuantity/component.Quantity)
+	}
+
+	if stock < 0 || stock == math.MaxInt32 {
+		return 0
+	}
+
+	return stock
+}
+
+// stockRequirements resolves order items into the stock to take from simple products, expanding
+// bundles into their components. The returned map holds every product involved by SKU.
+func (s *Service) stockRequirements(ctx context.Context, items []*OrderItemData) (map[string]int32, map[string]*model.Product, error) {
+	skus := make([]string, len(items))
+	for i, item := range items {
+		skus[i] = item.Sku
+	}
+
+	products, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	productBySku := make(map[string]*model.Product, len(products))
+	var componentSkus []string
+	for _, product := range products {
+		productBySku[product.Sku] = product
+		for _, component := range product.Components {
+			componentSkus = append(componentSkus, component.Sku)
+		}
+	}
+
+	if len(componentSkus) > 0 {
+		components, err := s.mongoRepository.BulkGetBySKUs(ctx, componentSkus)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, component := range components {
+			productBySku[component.Sku] = component
+		}
+	}
+
+	requirements := make(map[string]int32)
+	for _, item := range items {
+		product, ok := productBySku[item.Sku]
+		if !ok {
+			return nil, nil, ErrNotFound
+		}
+
+		if !product.IsBundle() {
+			requirements[item.Sku] += item.Quantity
+			continue
+		}
+
+		for _, component := range product.Components {
+			if _, ok := productBySku[component.Sku]; !ok {
+				return nil, nil, ErrNotFound
+			}
+			requirements[component.Sku] += component.Quantity * item.Quantity
+		}
+	}
+
+	return requirements, productBySku, nil
+}
+
+// stockChanged reindexes products whose stock moved and re-derives the stock of every
+// bundle containing them.
+func (s *Service) stockChanged(ctx context.Context, skus []string) error {
+	products, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		s.reindexProduct(product)
+	}
+
+	return s.refreshBundles(ctx, skus)
+}
+
+func (s *Service) refreshBundles(ctx context.Context, componentSkus []string) error {
+	bundles, err := s.mongoRepository.GetBundlesContaining(ctx, componentSkus)
+	if err != nil || len(bundles) == 0 {
+		return err
+	}
+
+	var skus []string
+	for _, bundle := range bundles {
+		for _, component := range bundle.Components {
+			skus = append(skus, component.Sku)
+		}
+	}
+
+	components, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
+	if err != nil {
+		return err
+	}
+
+	productBySku := make(map[string]*model.Product, len(components))
+	for _, component := range components {
+		productBySku[component.Sku] = component
+	}
+
+	for _, bundle := range bundles {
+		bundle.StockQuantity = bundleStock(bundle, productBySku)
+		bundle.IsActive = bundle.StockQuantity > 0
+
+		if _, err = s.mongoRepository.SetStock(ctx, bundle.ID, bundle.StockQuantity); err != nil {
+			log.Printf("Error updating stock of bundle %s: %s", bundle.Sku, err)
+			continue
+		}
+
+		s.reindexProduct(bundle)
+	}
+
+	return nil
+}
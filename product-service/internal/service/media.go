@@ -0,0 +1,249 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"product-catalog-service/internal/media"
+	"product-catalog-service/internal/model"
+	"sort"
+)
+
+// maxImageUpdateAttempts bounds how often a gallery change is retried against concurrent
+// changes before giving up.
+const maxImageUpdateAttempts = 5
+
+var errImagesChanged = errors.New("product images changed concurrently")
+
+// UploadProductImage adds an image to the gallery of a product, or of one of its variants
+// when variantSKU is set.
+func (s *Service) UploadProductImage(ctx context.Context, productID, variantSKU string, data []byte) (*model.Image, error) {
+	product, err := s.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if variantSKU != "" && !product.HasVariant(variantSKU) {
+		return nil, ErrUnknownVariant
+	}
+
+	img, format, err := media.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	imageID := uuid.NewString()
+	prefix := fmt.Sprintf("products/%s/%s", product.ID.Hex(), imageID)
+	originalKey := prefix + media.Extension(format)
+
+	if err = s.blobStore.Put(ctx, originalKey, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	image := model.Image{
+		ID:          imageID,
+		URL:         s.blobStore.URL(originalKey),
+		Thumbnails:  make(map[string]string, len(media.ThumbnailSizes)),
+		VariantSKU:  variantSKU,
+		ContentType: "image/" + format,
+		BlobKeys:    []string{originalKey},
+	}
+
+	thumbnails, err := media.GenerateThumbnails(img, format)
+	if err != nil {
+		s.deleteBlobs(image.BlobKeys)
+		return nil, err
+	}
+
+	for _, thumbnail := range thumbnails {
+		key := fmt.Sprintf("%s_%s%s", prefix, thumbnail.Name, thumbnail.Extension)
+		if err = s.blobStore.Put(ctx, key, bytes.NewReader(thumbnail.Data)); err != nil {
+			s.deleteBlobs(image.BlobKeys)
+			return nil, err
+		}
+
+		image.Thumbnails[thumbnail.Name] = s.blobStore.URL(key)
+		image.BlobKeys = append(image.BlobKeys, key)
+	}
+
+	_, err = s.updateProductImages(ctx, productID, func(product *model.Product) ([]model.Image, string, error) {
+		image.Position = int32(len(gallery(product.Images, variantSKU)))
+		images := append(product.Images, image)
+		return images, product.ImageURL, nil
+	})
+	if err != nil {
+		s.deleteBlobs(image.BlobKeys)
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+func (s *Service) ReorderProductImages(ctx context.Context, productID, variantSKU string, imageIDs []string) ([]model.Image, error) {
+	positions := make(map[string]int32, len(imageIDs))
+	for i, id := range imageIDs {
+		if _, duplicate := positions[id]; duplicate {
+			return nil, ErrInvalidImageOrder
+		}
+		positions[id] = int32(i)
+	}
+
+	product, err := s.updateProductImages(ctx, productID, func(product *model.Product) ([]model.Image, string, error) {
+		if len(gallery(product.Images, variantSKU)) != len(imageIDs) {
+			return nil, "", ErrInvalidImageOrder
+		}
+
+		images := make([]model.Image, len(product.Images))
+		copy(images, product.Images)
+
+		for i := range images {
+			if images[i].VariantSKU != variantSKU {
+				continue
+			}
+
+			position, ok := positions[images[i].ID]
+			if !ok {
+				return nil, "", ErrInvalidImageOrder
+			}
+			images[i].Position = position
+		}
+
+		return images, product.ImageURL, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gallery(product.Images, variantSKU), nil
+}
+
+func (s *Service) DeleteProductImage(ctx context.Context, productID, imageID string) error {
+	var removed model.Image
+	_, err := s.updateProductImages(ctx, productID, func(product *model.Product) ([]model.Image, string, error) {
+		found := false
+		images := make([]model.Image, 0, len(product.Images))
+		for _, image := range product.Images {
+			if image.ID == imageID {
+				removed, found = image, true
+				continue
+			}
+			images = append(images, image)
+		}
+
+		if !found {
+			return nil, "", ErrNotFound
+		}
+
+		// Close the gap left in the gallery the image belonged to.
+		for i := range images {
+			if images[i].VariantSKU == removed.VariantSKU && images[i].Position > removed.Position {
+				images[i].Position--
+			}
+		}
+
+		fallbackURL := product.ImageURL
+		if fallbackURL == removed.URL {
+			fallbackURL = ""
+		}
+
+		return images, fallbackURL, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(removed.BlobKeys)
+
+	return nil
+}
+
+// updateProductImages applies change to the product's current gallery and saves the images
+// it returns. The save fails if the gallery changed since it was read, so a concurrent
+// upload, reorder or delete isn't overwritten; change is then applied again to the newer
+// gallery.
+func (s *Service) updateProductImages(ctx context.Context, productID string, change func(*model.Product) ([]model.Image, string, error)) (*model.Product, error) {
+	for attempt := 0; attempt < maxImageUpdateAttempts; attempt++ {
+		product, err := s.GetProduct(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+
+		images, fallbackURL, err := change(product)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.saveProductImages(ctx, product, images, fallbackURL)
+		if errors.Is(err, errImagesChanged) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return product, nil
+	}
+
+	return nil, ErrImagesConflict
+}
+
+// saveProductImages persists the gallery and keeps ImageURL pointing at the first
+// product-level image, falling back to fallbackURL when the product has none. It fails
+// with errImagesChanged if the gallery is no longer the one read with the product.
+func (s *Service) saveProductImages(ctx context.Context, product *model.Product, images []model.Image, fallbackURL string) error {
+	sort.SliceStable(images, func(i, j int) bool {
+		if images[i].VariantSKU != images[j].VariantSKU {
+			return images[i].VariantSKU < images[j].VariantSKU
+		}
+		return images[i].Position < images[j].Position
+	})
+
+	imageURL := fallbackURL
+	if primary := gallery(images, ""); len(primary) > 0 {
+		imageURL = primary[0].URL
+	}
+
+	result, err := s.mongoRepository.UpdateProductImages(ctx, product.ID, product.ImagesVersion, images, imageURL)
+	if err != nil {
+		return err
+	}
+
+	// The product was deleted, or its gallery changed, since it was read.
+	if result.MatchedCount == 0 {
+		return errImagesChanged
+	}
+
+	product.Images = images
+	product.ImageURL = imageURL
+	product.ImagesVersion++
+	s.reindexProduct(product)
+
+	return nil
+}
+
+func (s *Service) deleteBlobs(keys []string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(context.Background(), key); err != nil {
+			log.Printf("Error deleting blob %s: %s", key, err)
+		}
+	}
+}
+
+// gallery returns the images of a single product or variant gallery ordered by position.
+func gallery(images []model.Image, variantSKU string) []model.Image {
+	var result []model.Image
+	for _, image := range images {
+		if image.VariantSKU == variantSKU {
+			result = append(result, image)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Position < result[j].Position
+	})
+
+	return result
+}
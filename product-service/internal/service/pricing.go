@@ -0,0 +1,437 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/mongo"
+	"log"
+	"product-catalog-service/internal/model"
+	"shared/money"
+	"time"
+)
+
+const (
+	priceChangeUpdate        = "update"
+	priceChangeSaleStarted   = "sale_started"
+	priceChangeSaleEnded     = "sale_ended"
+	priceChangeSaleCancelled = "sale_cancelled"
+	priceChangePriceList     = "price_list"
+
+	defaultPriceHistoryLimit = 50
+)
+
+type PriceChangedEvent struct {
+	EventID   string           `json:"event_id"`
+	EventType string           `json:"event_type"`
+	Timestamp time.Time        `json:"timestamp"`
+	Version   string           `json:"version"`
+	Data      PriceChangedData `json:"data"`
+}
+
+type PriceChangedData struct {
+	ProductID     string      `json:"product_id"`
+	Sku           string      `json:"sku"`
+	OldPrice      money.Money `json:"old_price"`
+	NewPrice      money.Money `json:"new_price"`
+	CustomerGroup string      `json:"customer_group,omitempty"`
+	Reason        string      `json:"reason"`
+	EffectiveAt   time.Time   `json:"effective_at"`
+}
+
+// EffectivePrices resolves the price each product has for customerGroup at the given time.
+func (s *Service) EffectivePrices(ctx context.Context, products []*model.Product, customerGroup string, at time.Time) ([]model.EffectivePrice, error) {
+	priceList, err := s.getPriceList(ctx, customerGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]model.EffectivePrice, len(products))
+	for i, product := range products {
+		prices[i] = effectivePrice(product, priceList, customerGroup, at)
+	}
+
+	return prices, nil
+}
+
+func (s *Service) EffectivePrice(ctx context.Context, product *model.Product, customerGroup string, at time.Time) (model.EffectivePrice, error) {
+	prices, err := s.EffectivePrices(ctx, []*model.Product{product}, customerGroup, at)
+	if err != nil {
+		return model.EffectivePrice{}, err
+	}
+
+	return prices[0], nil
+}
+
+// SchedulePrice adds a sale to the product. Sales that should already be running are
+// moved to start now, so the price scheduler announces them on its next run. The sale
+// price must be in the product's currency.
+func (s *Service) SchedulePrice(ctx context.Context, productID string, price money.Money, startsAt time.Time, endsAt *time.Time, customerGroup string) (*model.ScheduledPrice, error) {
+	now := time.Now()
+	if startsAt.Before(now) {
+		startsAt = now
+	}
+
+	if price.Amount <= 0 || (endsAt != nil && !endsAt.After(startsAt)) {
+		return nil, ErrInvalidSchedule
+	}
+
+	product, err := s.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if price.Currency != product.Price.Currency {
+		return nil, ErrInvalidSchedule
+	}
+
+	sale := model.ScheduledPrice{
+		ID:            uuid.NewString(),
+		Price:         price,
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+		CustomerGroup: customerGroup,
+	}
+
+	result, err := s.mongoRepository.AddSale(ctx, product.ID, sale)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, ErrNotFound
+	}
+
+	product.Sales = append(product.Sales, sale)
+	s.reindexProduct(product)
+
+	return &sale, nil
+}
+
+func (s *Service) CancelScheduledPrice(ctx context.Context, productID, saleID string) error {
+	product, err := s.GetProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	var cancelled *model.ScheduledPrice
+	sales := make([]model.ScheduledPrice, 0, len(product.Sales))
+	for i := range product.Sales {
+		if product.Sales[i].ID == saleID {
+			cancelled = &product.Sales[i]
+			continue
+		}
+		sales = append(sales, product.Sales[i])
+	}
+
+	if cancelled == nil {
+		return ErrNotFound
+	}
+
+	now := time.Now()
+	before, err := s.EffectivePrice(ctx, product, cancelled.CustomerGroup, now)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.mongoRepository.RemoveSale(ctx, product.ID, saleID)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	product.Sales = sales
+	s.reindexProduct(product)
+
+	after, err := s.EffectivePrice(ctx, product, cancelled.CustomerGroup, now)
+	if err != nil {
+		return err
+	}
+
+	return s.recordPriceChange(ctx, product, before.Amount, after.Amount, cancelled.CustomerGroup, priceChangeSaleCancelled, now)
+}
+
+// UpsertPriceList replaces the price list of a customer group and records a price change
+// for every SKU whose group price differs from the previous list.
+func (s *Service) UpsertPriceList(ctx context.Context, priceList *model.PriceList) error {
+	if priceList.CustomerGroup == "" {
+		return ErrInvalidPriceList
+	}
+
+	for _, entry := range priceList.Entries {
+		if entry.Sku == "" || entry.Price.Amount <= 0 || !money.IsSupported(entry.Price.Currency) {
+			return ErrInvalidPriceList
+		}
+	}
+
+	previous, err := s.getPriceList(ctx, priceList.CustomerGroup)
+	if err != nil {
+		return err
+	}
+
+	skus := make([]string, 0, len(priceList.Entries))
+	for _, entry := range priceList.Entries {
+		skus = append(skus, entry.Sku)
+	}
+	if previous != nil {
+		for _, entry := range previous.Entries {
+			skus = append(skus, entry.Sku)
+		}
+	}
+
+	products, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
+	if err != nil {
+		return err
+	}
+
+	currencies := make(map[string]string, len(products))
+	for _, product := range products {
+		currencies[product.Sku] = product.Price.Currency
+	}
+	for _, entry := range priceList.Entries {
+		if currency, ok := currencies[entry.Sku]; ok && currency != entry.Price.Currency {
+			return ErrInvalidPriceList
+		}
+	}
+
+	now := time.Now()
+	before := make([]model.EffectivePrice, len(products))
+	for i, product := range products {
+		before[i] = effectivePrice(product, previous, priceList.CustomerGroup, now)
+	}
+
+	if err = s.priceRepository.UpsertPriceList(ctx, priceList); err != nil {
+		return err
+	}
+
+	for i, product := range products {
+		after := effectivePrice(product, priceList, priceList.CustomerGroup, now)
+		err = s.recordPriceChange(ctx, product, before[i].Amount, after.Amount, priceList.CustomerGroup, priceChangePriceList, now)
+		if err != nil {
+			log.Printf("Error recording price change for product %s: %s", product.Sku, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) GetPriceHistory(ctx context.Context, productID string, limit int32) ([]*model.PriceHistoryEntry, error) {
+	if limit <= 0 {
+		limit = defaultPriceHistoryLimit
+	}
+
+	product, err := s.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.priceRepository.GetPriceHistory(ctx, product.Sku, int64(limit))
+}
+
+// ProcessPendingSaleTransitions processes the sale transitions since the last run, so the
+// ones that happened while the service was down are announced once it is back. The first
+// run only records where to start from.
+func (s *Service) ProcessPendingSaleTransitions(ctx context.Context, now time.Time) error {
+	// Mongo keeps milliseconds, so round now the same way the stored time will be.
+	now = now.Truncate(time.Millisecond)
+
+	from, err := s.priceRepository.GetSaleTransitionsProcessedUntil(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !from.IsZero() && from.Before(now) {
+		if err = s.ProcessSaleTransitions(ctx, from, now); err != nil {
+			return err
+		}
+	}
+
+	return s.priceRepository.SetSaleTransitionsProcessedUntil(ctx, now)
+}
+
+// ProcessSaleTransitions records and announces price changes caused by sales that
+// started or ended in the (from, to] window.
+func (s *Service) ProcessSaleTransitions(ctx context.Context, from, to time.Time) error {
+	products, err := s.mongoRepository.GetProductsWithSaleTransitions(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	priceLists := make(map[string]*model.PriceList)
+
+	for _, product := range products {
+		groups := make(map[string]struct{})
+		for _, sale := range product.Sales {
+			if inWindow(sale.StartsAt, from, to) || (sale.EndsAt != nil && inWindow(*sale.EndsAt, from, to)) {
+				groups[sale.CustomerGroup] = struct{}{}
+			}
+		}
+
+		for group := range groups {
+			priceList, cached := priceLists[group]
+			if !cached {
+				priceList, err = s.getPriceList(ctx, group)
+				if err != nil {
+					return err
+				}
+				priceLists[group] = priceList
+			}
+
+			before := effectivePrice(product, priceList, group, from)
+			after := effectivePrice(product, priceList, group, to)
+
+			reason := priceChangeSaleEnded
+			if after.Amount.Amount < before.Amount.Amount {
+				reason = priceChangeSaleStarted
+			}
+
+			err = s.recordPriceChange(ctx, product, before.Amount, after.Amount, group, reason, to)
+			if err != nil {
+				log.Printf("Error recording price change for product %s: %s", product.Sku, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getPriceList(ctx context.Context, customerGroup string) (*model.PriceList, error) {
+	if customerGroup == "" {
+		return nil, nil
+	}
+
+	priceList, err := s.priceRepository.GetPriceList(ctx, customerGroup)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+func (s *Service) recordPriceChange(ctx context.Context, product *model.Product, oldPrice, newPrice money.Money, customerGroup, reason string, at time.Time) error {
+	if oldPrice == newPrice {
+		return nil
+	}
+
+	entry := &model.PriceHistoryEntry{
+		ProductID:     product.ID,
+		Sku:           product.Sku,
+		OldPrice:      oldPrice,
+		NewPrice:      newPrice,
+		CustomerGroup: customerGroup,
+		Reason:        reason,
+		ChangedAt:     at,
+	}
+
+	if err := s.priceRepository.InsertPriceHistory(ctx, entry); err != nil {
+		return err
+	}
+
+	data := PriceChangedData{
+		ProductID:     product.ID.Hex(),
+		Sku:           product.Sku,
+		OldPrice:      oldPrice,
+		NewPrice:      newPrice,
+		CustomerGroup: customerGroup,
+		Reason:        reason,
+		EffectiveAt:   at,
+	}
+
+	if err := s.sendPriceChangedEvent(ctx, data); err != nil {
+		return ErrSendingEvent
+	}
+
+	return nil
+}
+
+func (s *Service) sendPriceChangedEvent(ctx context.Context, eventData PriceChangedData) error {
+	event := PriceChangedEvent{
+		EventID:   uuid.NewString(),
+		EventType: "products.price_changed",
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data:      eventData,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Key:   []byte(eventData.Sku),
+		Value: eventBytes,
+	}
+
+	err = s.priceChangedWriter.WriteMessages(ctx, msg)
+
+	return err
+}
+
+// ReindexProducts writes every stored product to the search index.
+func (s *Service) ReindexProducts(ctx context.Context) error {
+	products, err := s.mongoRepository.GetAllProducts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if err = s.elasticRepository.CreateOrUpdateProduct(ctx, product); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) reindexProduct(product *model.Product) {
+	go func() {
+		err := s.elasticRepository.CreateOrUpdateProduct(context.Background(), product)
+		if err != nil {
+			log.Printf("Error indexing product in Elasticsearch: %s", err)
+		}
+	}()
+}
+
+// effectivePrice picks the group's price list price over the base price and applies
+// the cheapest running sale on top of it.
+func effectivePrice(product *model.Product, priceList *model.PriceList, customerGroup string, at time.Time) model.EffectivePrice {
+	price := model.EffectivePrice{
+		Amount:       product.Price,
+		RegularPrice: product.Price,
+		Source:       model.BasePrice,
+	}
+
+	if priceList != nil {
+		for _, entry := range priceList.Entries {
+			if entry.Sku == product.Sku && entry.Price.Currency == product.Price.Currency {
+				price.Amount = entry.Price
+				price.RegularPrice = entry.Price
+				price.Source = model.PriceListPrice
+				break
+			}
+		}
+	}
+
+	for _, sale := range product.Sales {
+		if sale.AppliesTo(customerGroup) && sale.ActiveAt(at) && sale.Price.Currency == price.Amount.Currency && sale.Price.Amount < price.Amount.Amount {
+			price.Amount = sale.Price
+			price.Source = model.SalePrice
+			price.ValidUntil = sale.EndsAt
+		}
+	}
+
+	return price
+}
+
+func inWindow(t, from, to time.Time) bool {
+	return t.After(from) && !t.After(to)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"product-catalog-service/internal/model"
+)
+
+const (
+	defaultRecommendationLimit = 10
+	maxRecommendationLimit     = 50
+)
+
+// RecordCoPurchases counts every pair of SKUs that were bought together in a confirmed order.
+// The order is claimed before its pairs are counted, so that however often its event is
+// delivered, the pairs are counted at most once.
+func (s *Service) RecordCoPurchases(ctx context.Context, eventData OrderData) error {
+	seen := make(map[string]struct{}, len(eventData.Items))
+	skus := make([]string, 0, len(eventData.Items))
+	for _, item := range eventData.Items {
+		if _, ok := seen[item.Sku]; ok {
+			continue
+		}
+		seen[item.Sku] = struct{}{}
+		skus = append(skus, item.Sku)
+	}
+
+	if len(skus) < 2 {
+		return nil
+	}
+
+	claimed, err := s.recommendationRepository.MarkOrderProcessed(ctx, eventData.OrderID)
+	if err != nil || !claimed {
+		return err
+	}
+
+	return s.recommendationRepository.IncrementCoPurchases(ctx, skus)
+}
+
+// GetRecommendations lists products frequently bought together with the SKU, topped up with
+// similar products from the search index. Out-of-stock products are never recommended.
+func (s *Service) GetRecommendations(ctx context.Context, sku string, limit int32) ([]model.Recommendation, error) {
+	if limit <= 0 {
+		limit = defaultRecommendationLimit
+	}
+	if limit > maxRecommendationLimit {
+		limit = maxRecommendationLimit
+	}
+
+	product, err := s.GetProductBySKU(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]model.Recommendation, 0, limit)
+	seen := map[string]struct{}{product.Sku: {}}
+
+	// Over-fetch, as some of the related products may be out of stock.
+	coPurchases, err := s.recommendationRepository.GetCoPurchases(ctx, sku, int64(limit)*2)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(coPurchases) > 0 {
+		relatedSkus := make([]string, len(coPurchases))
+		for i, coPurchase := range coPurchases {
+			relatedSkus[i] = coPurchase.RelatedSku
+		}
+
+		related, err := s.mongoRepository.BulkGetBySKUs(ctx, relatedSkus)
+		if err != nil {
+			return nil, err
+		}
+
+		productBySku := make(map[string]*model.Product, len(related))
+		for _, p := range related {
+			productBySku[p.Sku] = p
+		}
+
+		for _, coPurchase := range coPurchases {
+			p, ok := productBySku[coPurchase.RelatedSku]
+			if !ok || !inStock(p) || len(recommendations) == int(limit) {
+				continue
+			}
+
+			seen[p.Sku] = struct{}{}
+			recommendations = append(recommendations, model.Recommendation{Product: p, Source: model.FrequentlyBoughtTogether})
+		}
+	}
+
+	if len(recommendations) == int(limit) {
+		return recommendations, nil
+	}
+
+	similar, err := s.elasticRepository.MoreLikeThis(ctx, product.ID.Hex(), int(limit)+len(seen))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range similar {
+		if _, ok := seen[p.Sku]; ok || !inStock(p) || len(recommendations) == int(limit) {
+			continue
+		}
+
+		seen[p.Sku] = struct{}{}
+		recommendations = append(recommendations, model.Recommendation{Product: p, Source: model.SimilarProduct})
+	}
+
+	return recommendations, nil
+}
+
+func inStock(product *model.Product) bool {
+	return product.IsActive && product.StockQuantity > 0
+}
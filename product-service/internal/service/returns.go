@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"shared/money"
+	"time"
+)
+
+type ReturnEvent struct {
+	EventID   string     `json:"event_id"`
+	EventType string     `json:"event_type"`
+	Timestamp time.Time  `json:"timestamp"`
+	Version   string     `json:"version"`
+	Data      ReturnData `json:"data"`
+}
+
+type ReturnData struct {
+	ReturnID     int64             `json:"return_id"`
+	OrderID      int64             `json:"order_id"`
+	UserID       int64             `json:"user_id"`
+	Status       string            `json:"status"`
+	Items        []*ReturnItemData `json:"items"`
+	RefundAmount money.Money       `json:"refund_amount"`
+	StaffNote    string            `json:"staff_note"`
+}
+
+type ReturnItemData struct {
+	Sku      string `json:"sku"`
+	Quantity int32  `json:"quantity"`
+	Reason   string `json:"reason"`
+	Restock  bool   `json:"restock"`
+}
+
+// RestockReturn puts the returned units that passed inspection back on sale.
+func (s *Service) RestockReturn(ctx context.Context, eventData ReturnData) error {
+	var items []*OrderItemData
+	for _, item := range eventData.Items {
+		if item.Restock && item.Quantity > 0 {
+			items = append(items, &OrderItemData{
+				Sku:      item.Sku,
+				Quantity: item.Quantity,
+			})
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	return s.restoreStock(ctx, items)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/metadata"
+	"log"
+	"product-catalog-service/internal/model"
+	pb "product-catalog-service/protobuf"
+	"strings"
+	"time"
+)
+
+const (
+	maxReviewTitleLength = 200
+	maxReviewBodyLength  = 5000
+
+	defaultReviewPageSize = 10
+)
+
+// CreateReview stores a review awaiting moderation. The verified-purchase flag is set when
+// the order service knows of a delivered order of the user containing the product.
+func (s *Service) CreateReview(ctx context.Context, userID int64, productID string, rating int32, title, body string) (*model.Review, error) {
+	title = strings.TrimSpace(title)
+	body = strings.TrimSpace(body)
+
+	if rating < 1 || rating > 5 || len(title) > maxReviewTitleLength || len(body) > maxReviewBodyLength {
+		return nil, ErrInvalidReview
+	}
+
+	product, err := s.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	verified, err := s.hasPurchased(ctx, product.Sku)
+	if err != nil {
+		log.Printf("Error verifying purchase of %s by user %d: %s", product.Sku, userID, err)
+	}
+
+	now := time.Now()
+	review := &model.Review{
+		ProductID:        product.ID,
+		Sku:              product.Sku,
+		UserID:           userID,
+		Rating:           rating,
+		Title:            title,
+		Body:             body,
+		VerifiedPurchase: verified,
+		Status:           model.ReviewPending,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if err = s.reviewRepository.CreateReview(ctx, review); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrReviewExists
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *Service) ListReviews(ctx context.Context, productID string, status model.ReviewStatus, page, pageSize int32) ([]*model.Review, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultReviewPageSize
+	}
+
+	id, err := primitive.ObjectIDFromHex(productID)
+	if err != nil {
+		return nil, 0, ErrNotFound
+	}
+
+	skip := int64((page - 1) * pageSize)
+
+	return s.reviewRepository.ListReviews(ctx, id, status, skip, int64(pageSize))
+}
+
+// ModerateReview moves a review to another moderation state and refreshes the rating
+// denormalized into the product document and the search index.
+func (s *Service) ModerateReview(ctx context.Context, reviewID string, status model.ReviewStatus, note string) (*model.Review, error) {
+	id, err := primitive.ObjectIDFromHex(reviewID)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	result, err := s.reviewRepository.UpdateReviewStatus(ctx, id, status, note)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, ErrNotFound
+	}
+
+	review, err := s.reviewRepository.GetReviewByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.refreshRating(ctx, review.ProductID); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *Service) refreshRating(ctx context.Context, productID primitive.ObjectID) error {
+	summary, err := s.reviewRepository.GetRatingSummary(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.mongoRepository.UpdateProductRating(ctx, productID, summary); err != nil {
+		return err
+	}
+
+	product, err := s.mongoRepository.GetProductByID(ctx, productID.Hex())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+
+	s.reindexProduct(product)
+
+	return nil
+}
+
+func (s *Service) hasPurchased(ctx context.Context, sku string) (bool, error) {
+	mt, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(mt.Get("Authorization")) == 0 {
+		return false, ErrMissingMetadata
+	}
+
+	md := metadata.Pairs("Authorization", mt.Get("Authorization")[0])
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	resp, err := s.orderClient.HasPurchased(outgoingCtx, &pb.HasPurchasedRequest{Sku: sku})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.GetPurchased(), nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,6 +13,8 @@ import (
 	"product-catalog-service/internal/model"
 	"product-catalog-service/internal/repository"
 	pb "product-catalog-service/protobuf"
+	"shared/blob"
+	"shared/money"
 	"strconv"
 	"time"
 )
@@ -22,6 +23,17 @@ var (
 	ErrNotFound          = errors.New("err not found")
 	ErrSendingEvent      = errors.New("error sending event")
 	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrInvalidImage      = errors.New("invalid image")
+	ErrUnknownVariant    = errors.New("product has no variant with that SKU")
+	ErrInvalidImageOrder = errors.New("image order does not match gallery")
+	ErrImagesConflict    = errors.New("product images are being changed by another request")
+	ErrInvalidSchedule   = errors.New("invalid price schedule")
+	ErrInvalidPriceList  = errors.New("invalid price list")
+	ErrInvalidPrice      = errors.New("invalid price")
+	ErrInvalidReview     = errors.New("invalid review")
+	ErrReviewExists      = errors.New("product already reviewed by user")
+	ErrMissingMetadata   = errors.New("missing metadata in context")
+	ErrInvalidBundle     = errors.New("invalid bundle")
 )
 
 type OrderCreatedEvent struct {
@@ -42,33 +54,48 @@ type OrderData struct {
 	PaymentIntentID   *string          `json:"payment_intent_id"`
 	UserID            int64            `json:"user_id"`
 	Items             []*OrderItemData `json:"items"`
-	Amount            float64          `json:"amount"`
+	Amount            money.Money      `json:"amount"`
+	Currency          string           `json:"currency"`
 	ShippingAddress   string           `json:"shipping_address"`
 	EstimatedDelivery time.Time        `json:"estimated_delivery"`
 }
 
 type OrderItemData struct {
 	Quantity       int32        `json:"quantity"`
-	Price          float64      `json:"price"`
+	Price          money.Money  `json:"price"`
 	Sku            string       `json:"sku"`
 	Name           string       `json:"name"`
 	ImageURL       template.URL `json:"image_url"`
-	ItemTotalPrice float64      `json:"item_total_price"`
+	ItemTotalPrice money.Money  `json:"item_total_price"`
 }
 
 type Service struct {
-	mongoRepository     *repository.MongoRepository
-	elasticRepository   *repository.ElasticRepository
-	stockReservedWriter *kafka.Writer
-	stockFailedWriter   *kafka.Writer
+	mongoRepository          *repository.MongoRepository
+	elasticRepository        *repository.ElasticRepository
+	priceRepository          *repository.PriceRepository
+	reviewRepository         *repository.ReviewRepository
+	recommendationRepository *repository.RecommendationRepository
+	reservationRepository    *repository.ReservationRepository
+	orderClient              pb.OrderServiceClient
+	stockReservedWriter      *kafka.Writer
+	stockFailedWriter        *kafka.Writer
+	priceChangedWriter       *kafka.Writer
+	blobStore                blob.PublicStore
 }
 
-func New(mongoRepository *repository.MongoRepository, elasticRepository *repository.ElasticRepository, stockReservedWriter, stockFailedWriter *kafka.Writer) *Service {
+func New(mongoRepository *repository.MongoRepository, elasticRepository *repository.ElasticRepository, priceRepository *repository.PriceRepository, reviewRepository *repository.ReviewRepository, recommendationRepository *repository.RecommendationRepository, reservationRepository *repository.ReservationRepository, orderClient pb.OrderServiceClient, stockReservedWriter, stockFailedWriter, priceChangedWriter *kafka.Writer, blobStore blob.PublicStore) *Service {
 	return &Service{
-		mongoRepository:     mongoRepository,
-		elasticRepository:   elasticRepository,
-		stockReservedWriter: stockReservedWriter,
-		stockFailedWriter:   stockFailedWriter,
+		mongoRepository:          mongoRepository,
+		elasticRepository:        elasticRepository,
+		priceRepository:          priceRepository,
+		reviewRepository:         reviewRepository,
+		recommendationRepository: recommendationRepository,
+		reservationRepository:    reservationRepository,
+		orderClient:              orderClient,
+		stockReservedWriter:      stockReservedWriter,
+		stockFailedWriter:        stockFailedWriter,
+		priceChangedWriter:       priceChangedWriter,
+		blobStore:                blobStore,
 	}
 }
 
@@ -83,7 +110,7 @@ func (s *Service) GetProduct(ctx context.Context, id string) (*model.Product, er
 	return product, nil
 }
 
-func (s *Service) ListProducts(ctx context.Context, searchTerm string, page, pageSize int32) ([]*model.Product, error) {
+func (s *Service) ListProducts(ctx context.Context, searchTerm string, minRating float64, sortBy string, page, pageSize int32) ([]*model.Product, error) {
 	if page <= 0 {
 		page = 1
 	}
@@ -93,7 +120,7 @@ func (s *Service) ListProducts(ctx context.Context, searchTerm string, page, pag
 
 	from := (page - 1) * pageSize
 
-	products, err := s.elasticRepository.GetProducts(ctx, searchTerm, from, pageSize)
+	products, err := s.elasticRepository.GetProducts(ctx, searchTerm, minRating, sortBy, from, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -102,21 +129,35 @@ func (s *Service) ListProducts(ctx context.Context, searchTerm string, page, pag
 }
 
 func (s *Service) CreateProduct(ctx context.Context, r *pb.CreateProductRequest) (string, error) {
+	price, err := priceFromPB(r.GetPrice())
+	if err != nil {
+		return "", err
+	}
+
 	isActive := r.GetStockQuantity() > 0
+	productType, components := componentsFromPB(r.GetType(), r.GetComponents())
 
 	product := &model.Product{
 		ID:            primitive.NewObjectID(),
 		Sku:           r.GetSku(),
 		Name:          r.GetName(),
 		Description:   r.GetDescription(),
-		Price:         r.GetPrice(),
-		Currency:      model.Currency(r.GetCurrency()),
+		Price:         price,
 		StockQuantity: r.GetStockQuantity(),
 		Category:      r.GetCategory(),
 		ImageURL:      r.GetImageUrl(),
 		Attributes:    r.GetAttributes(),
 		IsActive:      isActive,
 		CreatedAt:     time.Now(),
+		Type:          productType,
+		Components:    components,
+		VariantSKUs:   r.GetVariantSkus(),
+	}
+
+	if product.IsBundle() {
+		if err = s.prepareBundle(ctx, product); err != nil {
+			return "", err
+		}
 	}
 
 	id, err := s.mongoRepository.CreateProduct(ctx, product)
@@ -140,20 +181,48 @@ func (s *Service) UpdateProduct(ctx context.Context, r *pb.UpdateProductRequest)
 		return err
 	}
 
+	price, err := priceFromPB(r.GetPrice())
+	if err != nil {
+		return err
+	}
+
+	previous, err := s.GetProduct(ctx, r.GetId())
+	if err != nil {
+		return err
+	}
+
 	isActive := r.GetStockQuantity() > 0
+	productType, components := componentsFromPB(r.GetType(), r.GetComponents())
 
 	product := &model.Product{
 		ID:            id,
 		Sku:           r.GetSku(),
 		Name:          r.GetName(),
 		Description:   r.GetDescription(),
-		Price:         r.GetPrice(),
-		Currency:      model.Currency(r.GetCurrency()),
+		Price:         price,
 		StockQuantity: r.GetStockQuantity(),
 		Category:      r.GetCategory(),
 		ImageURL:      r.GetImageUrl(),
 		Attributes:    r.GetAttributes(),
 		IsActive:      isActive,
+		Type:          productType,
+		Components:    components,
+		VariantSKUs:   r.GetVariantSkus(),
+	}
+
+	if product.IsBundle() {
+		// Bundles can't be nested, so a product already used as a component stays simple.
+		containing, err := s.mongoRepository.GetBundlesContaining(ctx, []string{previous.Sku})
+		if err != nil {
+			return err
+		}
+		if len(containing) > 0 {
+			return ErrInvalidBundle
+		}
+
+		if err = s.prepareBundle(ctx, product); err != nil {
+			return err
+		}
 	}
 
 	result, err := s.mongoRepository.UpdateProduct(ctx, product)
@@ -165,14 +234,30 @@ func (s *Service) UpdateProduct(ctx context.Context, r *pb.UpdateProductRequest)
 		return ErrNotFound
 	}
 
+	// Re-read the stored document so the index keeps fields the request doesn't carry, such as the gallery.
+	stored, err := s.mongoRepository.GetProductByID(ctx, r.GetId())
+	if err != nil {
+		return err
+	}
+
 	go func() {
-		err = s.elasticRepository.CreateOrUpdateProduct(context.Background(), product)
+		err := s.elasticRepository.CreateOrUpdateProduct(context.Background(), stored)
 		if err != nil {
 			log.Printf("Error indexing product in Elasticsearch: %s", err)
 		}
 	}()
 
-	return nil
+	if !stored.IsBundle() && stored.StockQuantity != previous.StockQuantity {
+		if err = s.refreshBundles(ctx, []string{stored.Sku}); err != nil {
+			log.Printf("Error refreshing bundles containing %s: %s", stored.Sku, err)
+		}
+	}
+
+	now := time.Now()
+	before := effectivePrice(previous, nil, "", now)
+	after := effectivePrice(stored, nil, "", now)
+
+	return s.recordPriceChange(ctx, stored, before.Amount, after.Amount, "", priceChangeUpdate, now)
 }
 
 func (s *Service) DeleteProduct(ctx context.Context, id string) error {
@@ -205,70 +290,77 @@ func (s *Service) GetProductBySKU(ctx context.Context, sku string) (*model.Produ
 	return product, nil
 }
 
+// CheckAndReserveStock takes the stock of an order, reserving the components of any bundles
+// in it. Either all of the stock is reserved or none of it. What was taken is recorded with
+// the order, so releasing it later doesn't depend on how the bundles are made up by then.
 func (s *Service) CheckAndReserveStock(ctx context.Context, eventData OrderData) error {
-	skus := make([]string, len(eventData.Items))
-	for i, item := range eventData.Items {
-		skus[i] = item.Sku
+	reservation, err := s.reservationRepository.GetReservation(ctx, eventData.OrderID)
+	if err == nil {
+		// A redelivered event; the stock is already taken, or given back again.
+		if reservation.ReleasedAt != nil {
+			return nil
+		}
+		if err = s.sendStockReservedEvent(ctx, eventData); err != nil {
+			return ErrSendingEvent
+		}
+		return nil
 	}
-	products, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
-	if err != nil {
+	if !errors.Is(err, mongo.ErrNoDocuments) {
 		return err
 	}
 
-	productBySku := make(map[string]*model.Product, len(products))
-	for _, product := range products {
-		productBySku[product.Sku] = product
-	}
-
-	for _, item := range eventData.Items {
-		product, exists := productBySku[item.Sku]
-		if !exists {
+	requirements, productBySku, err := s.stockRequirements(ctx, eventData.Items)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
 			if err := s.sendStockFailedEvent(ctx, eventData); err != nil {
 				return ErrSendingEvent
 			}
-			return ErrNotFound
 		}
+		return err
+	}
 
-		fmt.Println(product.StockQuantity, "Product stock quantity")
-		fmt.Println(item.Quantity, "Item quantity")
-
-		if product.StockQuantity < item.Quantity {
+	for sku, quantity := range requirements {
+		if productBySku[sku].StockQuantity < quantity {
 			if err := s.sendStockFailedEvent(ctx, eventData); err != nil {
 				return ErrSendingEvent
 			}
 			return ErrInsufficientStock
 		}
+	}
 
-		updatedProduct := &model.Product{
-			ID:            product.ID,
-			Sku:           product.Sku,
-			Name:          product.Name,
-			Description:   product.Description,
-			Price:         product.Price,
-			Currency:      product.Currency,
-			StockQuantity: product.StockQuantity - item.Quantity,
-			Category:      product.Category,
-			ImageURL:      product.ImageURL,
-			Attributes:    product.Attributes,
-			IsActive:      product.StockQuantity-item.Quantity > 0,
-			CreatedAt:     product.CreatedAt,
+	reserved := make(map[string]int32, len(requirements))
+	for sku, quantity := range requirements {
+		result, err := s.mongoRepository.AdjustStock(ctx, productBySku[sku].ID, -quantity)
+		if err == nil && result.MatchedCount == 0 {
+			err = ErrInsufficientStock
 		}
 
-		result, err := s.mongoRepository.UpdateProduct(ctx, updatedProduct)
 		if err != nil {
+			s.releaseStock(ctx, reserved, productBySku)
+			if err := s.sendStockFailedEvent(ctx, eventData); err != nil {
+				return ErrSendingEvent
+			}
 			return err
 		}
 
-		if result.MatchedCount == 0 {
-			return ErrNotFound
+		reserved[sku] = quantity
+	}
+
+	err = s.reservationRepository.CreateReservation(ctx, &model.Reservation{
+		OrderID:    eventData.OrderID,
+		Quantities: reserved,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		s.releaseStock(ctx, reserved, productBySku)
+		if err := s.sendStockFailedEvent(ctx, eventData); err != nil {
+			return ErrSendingEvent
 		}
+		return err
+	}
 
-		go func(p *model.Product) {
-			err := s.elasticRepository.CreateOrUpdateProduct(context.Background(), p)
-			if err != nil {
-				log.Printf("Error indexing product in Elasticsearch: %s", err)
-			}
-		}(updatedProduct)
+	if err = s.stockChanged(ctx, skusOf(reserved)); err != nil {
+		log.Printf("Error propagating stock changes: %s", err)
 	}
 
 	if err = s.sendStockReservedEvent(ctx, eventData); err != nil {
@@ -278,77 +370,141 @@ func (s *Service) CheckAndReserveStock(ctx context.Context, eventData OrderData)
 	return nil
 }
 
+// CompensateStock returns the stock of an order that was reserved earlier, releasing the
+// components of any bundles in it.
 func (s *Service) CompensateStock(ctx context.Context, eventData OrderData) error {
-	skus := make([]string, len(eventData.Items))
-	for i, item := range eventData.Items {
-		skus[i] = item.Sku
+	if err := s.releaseReservation(ctx, eventData); err != nil {
+		return err
+	}
+
+	if err := s.sendStockFailedEvent(ctx, eventData); err != nil {
+		return ErrSendingEvent
 	}
-	products, err := s.mongoRepository.BulkGetBySKUs(ctx, skus)
+
+	return nil
+}
+
+// RestockCancelledOrder puts the stock of an order the customer cancelled back on sale.
+func (s *Service) RestockCancelledOrder(ctx context.Context, eventData OrderData) error {
+	return s.releaseReservation(ctx, eventData)
+}
+
+// releaseReservation gives back exactly the stock reserved for an order, once. Orders
+// reserved before reservations were recorded are released by their current bundle makeup.
+func (s *Service) releaseReservation(ctx context.Context, eventData OrderData) error {
+	reservation, err := s.reservationRepository.GetReservation(ctx, eventData.OrderID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return s.restoreStock(ctx, eventData.Items)
+	}
+	if err != nil || reservation.ReleasedAt != nil {
+		return err
+	}
+
+	products, err := s.mongoRepository.BulkGetBySKUs(ctx, skusOf(reservation.Quantities))
 	if err != nil {
 		return err
 	}
 
+	// Marking the reservation released is what entitles this call to release its stock.
+	released, err := s.reservationRepository.MarkReservationReleased(ctx, eventData.OrderID)
+	if err != nil || !released {
+		return err
+	}
+
 	productBySku := make(map[string]*model.Product, len(products))
 	for _, product := range products {
 		productBySku[product.Sku] = product
 	}
 
-	var successfullyCompensated = true
+	quantities := make(map[string]int32, len(reservation.Quantities))
 	var missingProducts []string
-
-	for _, item := range eventData.Items {
-		product, exists := productBySku[item.Sku]
-		if !exists {
-			missingProducts = append(missingProducts, item.Sku)
-			successfullyCompensated = false
+	for sku, quantity := range reservation.Quantities {
+		if _, ok := productBySku[sku]; !ok {
+			missingProducts = append(missingProducts, sku)
 			continue
 		}
+		quantities[sku] = quantity
+	}
+	if len(missingProducts) > 0 {
+		log.Printf("Warning: Could not compensate stock for products with SKUs: %v", missingProducts)
+	}
+
+	s.releaseStock(ctx, quantities, productBySku)
 
-		updatedProduct := &model.Product{
-			ID:            product.ID,
-			Sku:           product.Sku,
-			Name:          product.Name,
-			Description:   product.Description,
-			Price:         product.Price,
-			Currency:      product.Currency,
-			StockQuantity: product.StockQuantity + item.Quantity,
-			Category:      product.Category,
-			ImageURL:      product.ImageURL,
-			Attributes:    product.Attributes,
-			IsActive:      product.StockQuantity+item.Quantity > 0,
-			CreatedAt:     product.CreatedAt,
+	if err = s.stockChanged(ctx, skusOf(quantities)); err != nil {
+		log.Printf("Error propagating stock changes: %s", err)
+	}
+
+	return nil
+}
+
+func (s *Service) restoreStock(ctx context.Context, items []*OrderItemData) error {
+	requirements, productBySku, err := s.stockRequirements(ctx, items)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if errors.Is(err, ErrNotFound) {
+		// Release what can still be found rather than nothing at all.
+		requirements = make(map[string]int32)
+		var missingProducts []string
+		for _, item := range items {
+			product, ok := productBySku[item.Sku]
+			if !ok {
+				missingProducts = append(missingProducts, item.Sku)
+				continue
+			}
+			if !product.IsBundle() {
+				requirements[item.Sku] += item.Quantity
+				continue
+			}
+			for _, component := range product.Components {
+				if _, ok := productBySku[component.Sku]; ok {
+					requirements[component.Sku] += component.Quantity * item.Quantity
+				}
+			}
 		}
+		log.Printf("Warning: Could not compensate stock for products with SKUs: %v", missingProducts)
+	}
+
+	s.releaseStock(ctx, requirements, productBySku)
 
-		result, err := s.mongoRepository.UpdateProduct(ctx, updatedProduct)
+	if err = s.stockChanged(ctx, skusOf(requirements)); err != nil {
+		log.Printf("Error propagating stock changes: %s", err)
+	}
+
+	return nil
+}
+
+func (s *Service) releaseStock(ctx context.Context, quantities map[string]int32, productBySku map[string]*model.Product) {
+	for sku, quantity := range quantities {
+		result, err := s.mongoRepository.AdjustStock(ctx, productBySku[sku].ID, quantity)
 		if err != nil {
-			log.Printf("Failed to compensate stock for product %s: %v", product.Sku, err)
-			successfullyCompensated = false
+			log.Printf("Failed to release stock for product %s: %v", sku, err)
 			continue
 		}
 
 		if result.MatchedCount == 0 {
-			log.Printf("Product %s not found during compensation", product.Sku)
-			successfullyCompensated = false
-			continue
+			log.Printf("Product %s not found while releasing stock", sku)
 		}
-
-		go func(p *model.Product) {
-			err := s.elasticRepository.CreateOrUpdateProduct(context.Background(), p)
-			if err != nil {
-				log.Printf("Error indexing product in Elasticsearch: %s", err)
-			}
-		}(updatedProduct)
 	}
+}
 
-	if !successfullyCompensated {
-		log.Printf("Warning: Could not compensate stock for products with SKUs: %v", missingProducts)
+func skusOf(quantities map[string]int32) []string {
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
 	}
 
-	if err = s.sendStockFailedEvent(ctx, eventData); err != nil {
-		return ErrSendingEvent
+	return skus
+}
+
+// priceFromPB converts a request price, rejecting negative amounts and currencies the platform doesn't support.
+func priceFromPB(price *pb.Money) (money.Money, error) {
+	if price.GetAmount() < 0 || !money.IsSupported(price.GetCurrency()) {
+		return money.Money{}, ErrInvalidPrice
 	}
 
-	return nil
+	return money.New(price.GetAmount(), price.GetCurrency()), nil
 }
 
 func (s *Service) sendStockReservedEvent(ctx context.Context, eventData OrderData) error {
@@ -0,0 +1,22 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store persists binary objects under slash-separated keys.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// PublicStore is a Store that also exposes its objects through public URLs.
+type PublicStore interface {
+	Store
+	URL(key string) string
+}
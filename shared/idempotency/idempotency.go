@@ -0,0 +1,179 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"log"
+	"time"
+)
+
+const (
+	header       = "idempotency-key"
+	maxKeyLength = 255
+)
+
+// Record is what is stored under an idempotency key. Response is nil while the first
+// request with the key is still being handled.
+type Record struct {
+	Fingerprint string
+	Response    []byte
+}
+
+// Store keeps the idempotency keys, scoped to a user and a method.
+type Store interface {
+	// ClaimIdempotencyKey stores key for a new request. It returns nil if the key is unused
+	// or was last used before expiredBefore, and the existing record otherwise.
+	ClaimIdempotencyKey(ctx context.Context, userID int64, method, key, fingerprint string, now, expiredBefore time.Time) (*Record, error)
+	CompleteIdempotencyKey(ctx context.Context, userID int64, method, key string, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, userID int64, method, key string) error
+}
+
+// Interceptor makes the methods it guards safe to retry. A request carrying an
+// Idempotency-Key header gets the response of the first successful request with the same
+// key, and a request that reuses the key with a different body is rejected. Failed
+// requests release the key so the client can try again.
+type Interceptor struct {
+	store   Store
+	ttl     time.Duration
+	methods map[string]bool
+}
+
+func New(store Store, ttl time.Duration, methods ...string) *Interceptor {
+	guarded := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		guarded[method] = true
+	}
+
+	return &Interceptor{
+		store:   store,
+		ttl:     ttl,
+		methods: guarded,
+	}
+}
+
+func (i *Interceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !i.methods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(header)
+	if len(keys) == 0 || keys[0] == "" {
+		return handler(ctx, req)
+	}
+
+	key := keys[0]
+	if len(key) > maxKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxKeyLength)
+	}
+
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "idempotency keys require an authenticated user")
+	}
+
+	fingerprint, err := requestFingerprint(info.FullMethod, req)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "error reading request")
+	}
+
+	now := time.Now()
+	record, err := i.store.ClaimIdempotencyKey(ctx, int64(userID), info.FullMethod, key, fingerprint, now, now.Add(-i.ttl))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "error checking idempotency key")
+	}
+
+	if record != nil {
+		return replay(record, fingerprint)
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		if releaseErr := i.store.ReleaseIdempotencyKey(context.WithoutCancel(ctx), int64(userID), info.FullMethod, key); releaseErr != nil {
+			log.Println(releaseErr)
+		}
+		return nil, err
+	}
+
+	response, err := encodeResponse(resp)
+	if err == nil {
+		err = i.store.CompleteIdempotencyKey(context.WithoutCancel(ctx), int64(userID), info.FullMethod, key, response)
+	}
+	if err != nil {
+		// The request went through, so the client still gets its response. A retry is
+		// reported as in progress until the key expires.
+		log.Println(err)
+	}
+
+	return resp, nil
+}
+
+func replay(record *Record, fingerprint string) (any, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, status.Error(codes.FailedPrecondition, "idempotency key was already used with a different request")
+	}
+
+	if record.Response == nil {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	resp, err := decodeResponse(record.Response)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "error reading stored response")
+	}
+
+	return resp, nil
+}
+
+func requestFingerprint(method string, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", errors.New("request is not a protobuf message")
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func encodeResponse(resp any) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, errors.New("response is not a protobuf message")
+	}
+
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(wrapped)
+}
+
+func decodeResponse(data []byte) (proto.Message, error) {
+	var wrapped anypb.Any
+	if err := proto.Unmarshal(data, &wrapped); err != nil {
+		return nil, err
+	}
+
+	return wrapped.UnmarshalNew()
+}
@@ -0,0 +1,136 @@
+package money
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// minorUnits is the number of minor units in one major unit. Every currency the
+// platform supports (EUR, USD, UAH) has two decimal places.
+const minorUnits = 100
+
+var (
+	ErrInvalidAmount    = errors.New("invalid money amount")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+var supportedCurrencies = map[string]bool{
+	"EUR": true,
+	"USD": true,
+	"UAH": true,
+}
+
+// Money is an amount in minor currency units (cents) together with an ISO 4217 currency code.
+type Money struct {
+	Amount   int64  `json:"amount" bson:"amount"`
+	Currency string `json:"currency" bson:"currency"`
+}
+
+func New(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+func IsSupported(currency string) bool {
+	return supportedCurrencies[currency]
+}
+
+// Parse reads a decimal string such as "12.34" or "-0.005", the representation
+// Postgres uses for NUMERIC columns. Digits beyond the second decimal place are
+// rounded half away from zero.
+func Parse(s, currency string) (Money, error) {
+	s = strings.TrimSpace(s)
+
+	negative := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return Money{}, ErrInvalidAmount
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	for _, part := range []string{whole, fraction} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return Money{}, ErrInvalidAmount
+			}
+		}
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Money{}, ErrInvalidAmount
+	}
+
+	fraction += "000"
+	minor, _ := strconv.ParseInt(fraction[:2], 10, 64)
+	if fraction[2] >= '5' {
+		minor++
+	}
+
+	amount := major*minorUnits + minor
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Decimal formats the amount in major units with two decimal places, e.g. "12.34".
+func (m Money) Decimal() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return sign + strconv.FormatInt(amount/minorUnits, 10) + "." + leftPad(strconv.FormatInt(amount%minorUnits, 10))
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+
+	return m.Decimal() + " " + m.Currency
+}
+
+// Add sums two amounts of the same currency. A zero value without a currency can be
+// added to anything, which makes it usable as the start of a running total.
+func (m Money) Add(other Money) (Money, error) {
+	switch {
+	case m.Currency == "":
+		if m.Amount != 0 {
+			return Money{}, ErrCurrencyMismatch
+		}
+		return Money{Amount: other.Amount, Currency: other.Currency}, nil
+	case other.Currency == "" && other.Amount == 0:
+		return m, nil
+	case m.Currency != other.Currency:
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Mul(quantity int64) Money {
+	return Money{Amount: m.Amount * quantity, Currency: m.Currency}
+}
+
+func leftPad(minor string) string {
+	if len(minor) < 2 {
+		return "0" + minor
+	}
+
+	return minor
+}
@@ -0,0 +1,130 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr error
+	}{
+		{in: "12.34", want: 1234},
+		{in: "12", want: 1200},
+		{in: "12.3", want: 1230},
+		{in: "1.", want: 100},
+		{in: ".5", want: 50},
+		{in: "+7.10", want: 710},
+		{in: " 3.00 ", want: 300},
+		{in: "0.004", want: 0},
+		{in: "0.005", want: 1},
+		{in: "1.234", want: 123},
+		{in: "1.235", want: 124},
+		{in: "1.23999", want: 124},
+		{in: "0.995", want: 100},
+		{in: "-12.34", want: -1234},
+		{in: "-0.005", want: -1},
+		{in: "-0.004", want: 0},
+		{in: "-1.235", want: -124},
+		{in: "", wantErr: ErrInvalidAmount},
+		{in: ".", wantErr: ErrInvalidAmount},
+		{in: "-", wantErr: ErrInvalidAmount},
+		{in: "abc", wantErr: ErrInvalidAmount},
+		{in: "1.2.3", wantErr: ErrInvalidAmount},
+		{in: "1,50", wantErr: ErrInvalidAmount},
+		{in: "--1", wantErr: ErrInvalidAmount},
+		{in: "1e3", wantErr: ErrInvalidAmount},
+		{in: "99999999999999999999", wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in, "EUR")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got != New(tt.want, "EUR") {
+				t.Errorf("Parse(%q) = %+v, want %d EUR", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalRoundTrip(t *testing.T) {
+	tests := []struct {
+		amount  int64
+		decimal string
+		str     string
+	}{
+		{amount: 0, decimal: "0.00", str: "0.00 USD"},
+		{amount: 5, decimal: "0.05", str: "0.05 USD"},
+		{amount: 50, decimal: "0.50", str: "0.50 USD"},
+		{amount: 1234, decimal: "12.34", str: "12.34 USD"},
+		{amount: 100000, decimal: "1000.00", str: "1000.00 USD"},
+		{amount: -5, decimal: "-0.05", str: "-0.05 USD"},
+		{amount: -1234, decimal: "-12.34", str: "-12.34 USD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.decimal, func(t *testing.T) {
+			m := New(tt.amount, "USD")
+			if got := m.Decimal(); got != tt.decimal {
+				t.Errorf("Decimal() = %q, want %q", got, tt.decimal)
+			}
+			if got := m.String(); got != tt.str {
+				t.Errorf("String() = %q, want %q", got, tt.str)
+			}
+
+			parsed, err := Parse(m.Decimal(), m.Currency)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", m.Decimal(), err)
+			}
+			if parsed != m {
+				t.Errorf("Parse(Decimal()) = %+v, want %+v", parsed, m)
+			}
+		})
+	}
+
+	if got := New(1234, "").String(); got != "12.34" {
+		t.Errorf("String() without currency = %q, want %q", got, "12.34")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", a: New(150, "EUR"), b: New(275, "EUR"), want: New(425, "EUR")},
+		{name: "negative", a: New(150, "EUR"), b: New(-200, "EUR"), want: New(-50, "EUR")},
+		{name: "zero start", a: Money{}, b: New(275, "UAH"), want: New(275, "UAH")},
+		{name: "zero added", a: New(275, "UAH"), b: Money{}, want: New(275, "UAH")},
+		{name: "mismatched currencies", a: New(150, "EUR"), b: New(150, "USD"), wantErr: ErrCurrencyMismatch},
+		{name: "amount without currency", a: New(150, ""), b: New(150, "USD"), wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Add(tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got != tt.want {
+				t.Errorf("Add() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	if got := New(199, "EUR").Mul(3); got != New(597, "EUR") {
+		t.Errorf("Mul(3) = %+v, want 5.97 EUR", got)
+	}
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -16,6 +18,7 @@ import (
 	"os/signal"
 	"shopping-cart-service/internal/cart"
 	"shopping-cart-service/internal/config"
+	"shopping-cart-service/internal/currency"
 	"shopping-cart-service/internal/server"
 	pb "shopping-cart-service/protobuf"
 	"strings"
@@ -26,6 +29,21 @@ const (
 	secret = "my-secret-key"
 )
 
+// userOnlyMethods can't be called with a guest cart token alone; every other cart RPC
+// falls back to the guest cart when no bearer token is sent. Wishlists are only kept for
+// signed-in users.
+var userOnlyMethods = map[string]bool{
+	pb.ShoppingCartService_MergeCart_FullMethodName:              true,
+	pb.ShoppingCartService_SaveForLater_FullMethodName:           true,
+	pb.ShoppingCartService_CreateWishlist_FullMethodName:         true,
+	pb.ShoppingCartService_ListWishlists_FullMethodName:          true,
+	pb.ShoppingCartService_GetWishlist_FullMethodName:            true,
+	pb.ShoppingCartService_DeleteWishlist_FullMethodName:         true,
+	pb.ShoppingCartService_AddWishlistItem_FullMethodName:        true,
+	pb.ShoppingCartService_RemoveWishlistItem_FullMethodName:     true,
+	pb.ShoppingCartService_MoveWishlistItemToCart_FullMethodName: true,
+}
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatal(err)
@@ -63,8 +81,75 @@ func run() error {
 
 	client := pb.NewProductCatalogServiceClient(productClient)
 
+	// gRPC client to Order Service, which owns the coupons
+	orderAddr := fmt.Sprintf("%s:%s", cfg.OrderClient.Host, cfg.OrderClient.Port)
+	orderConn, err := grpc.NewClient(orderAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer orderConn.Close()
+
+	orderClient := pb.NewOrderServiceClient(orderConn)
+
+	// gRPC client to User Service, for the recipients of abandoned-cart reminders
+	userAddr := fmt.Sprintf("%s:%s", cfg.UserClient.Host, cfg.UserClient.Port)
+	userConn, err := grpc.NewClient(userAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer userConn.Close()
+
+	userClient := pb.NewUserServiceClient(userConn)
+
+	// Kafka writers
+	kafkaAddr := fmt.Sprintf("%s:%s", cfg.Kafka.Host, cfg.Kafka.Port)
+	itemAddedWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "cart.item_added",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer itemAddedWriter.Close()
+	itemRemovedWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "cart.item_removed",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer itemRemovedWriter.Close()
+	abandonedWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(kafkaAddr),
+		Topic:                  "cart.abandoned",
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+	defer abandonedWriter.Close()
+
+	// Currency conversion
+	rateProvider, err := currency.NewStaticProvider(cfg.Currency.RatesFile)
+	if err != nil {
+		return err
+	}
+
+	mergeStrategy, err := cart.ParseMergeStrategy(cfg.Cart.MergeStrategy)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Cart.TTL <= 0 || cfg.Cart.AbandonedAfter <= 0 || cfg.Cart.SweepInterval <= 0 {
+		return errors.New("CART_TTL, CART_ABANDONED_AFTER and CART_SWEEP_INTERVAL must be positive durations")
+	}
+
 	// Shopping cart instance
-	shoppingCart := cart.New(rdb, 3600, client)
+	shoppingCart := cart.New(rdb, int64(cfg.Cart.TTL.Seconds()), client, orderClient, userClient,
+		currency.NewConverter(rateProvider), cfg.Currency.Default, mergeStrategy,
+		itemAddedWriter, itemRemovedWriter, abandonedWriter,
+	)
+
+	// Abandoned cart sweeper
+	sweepCtx, stopSweeper := context.WithCancel(context.Background())
+	defer stopSweeper()
+	go shoppingCart.RunAbandonedSweeper(sweepCtx, cfg.Cart.SweepInterval, cfg.Cart.AbandonedAfter)
 
 	// gRPC server with authentication interceptor
 	s := grpc.NewServer(
@@ -91,6 +176,7 @@ func run() error {
 	log.Println("Received shutdown signal, stopping server...")
 
 	s.GracefulStop()
+	stopSweeper()
 
 	return nil
 }
@@ -110,15 +196,17 @@ func newRedisClient(addr, password string) (*redis.Client, error) {
 	return rdb, nil
 }
 
-func AuthInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	mt, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
 	}
 
 	bearedToken := mt["authorization"]
-	fmt.Println(bearedToken)
 	if len(bearedToken) == 0 {
+		if !userOnlyMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
 		return nil, status.Error(codes.Unauthenticated, "bearer token not found")
 	}
 
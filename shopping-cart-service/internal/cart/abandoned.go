@@ -0,0 +1,138 @@
+package cart
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"html/template"
+	"log"
+	pb "shopping-cart-service/protobuf"
+	"strconv"
+	"time"
+)
+
+const (
+	// activityKey is a sorted set of carts scored by the unix time of their last change.
+	activityKey = "carts:activity"
+	cartURL     = "http://my-ecom-project.dynv6.net/cart"
+	sweepBatch  = 100
+)
+
+// touch records activity on a cart, making it a candidate for a reminder once it goes idle.
+func (c *ShoppingCart) touch(ctx context.Context, cartID string) {
+	err := c.redisClient.ZAdd(ctx, activityKey, redis.Z{Score: float64(time.Now().Unix()), Member: cartID}).Err()
+	if err != nil {
+		log.Printf("Error recording activity on cart %s: %s", cartID, err)
+	}
+}
+
+// RunAbandonedSweeper reports carts idle for longer than idleFor every interval until ctx
+// is cancelled.
+func (c *ShoppingCart) RunAbandonedSweeper(ctx context.Context, interval, idleFor time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := c.SweepAbandoned(ctx, idleFor)
+			if err != nil {
+				log.Printf("Error sweeping abandoned carts: %s", err)
+			}
+			if n > 0 {
+				log.Printf("Reported %d abandoned carts", n)
+			}
+		}
+	}
+}
+
+// SweepAbandoned emits cart.abandoned for every non-empty user cart idle for longer than
+// idleFor. A cart is reported once per idle period: it is taken off the activity set when
+// reported and only comes back with new activity. Removing it is also what claims it, so
+// concurrent sweepers never report the same cart twice. Guest carts are dropped from the
+// set without a reminder as there is no one to send it to.
+func (c *ShoppingCart) SweepAbandoned(ctx context.Context, idleFor time.Duration) (int, error) {
+	cutoff := strconv.FormatInt(time.Now().Add(-idleFor).Unix(), 10)
+
+	var reported int
+	for {
+		idle, err := c.redisClient.ZRangeByScoreWithScores(ctx, activityKey, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   cutoff,
+			Count: sweepBatch,
+		}).Result()
+		if err != nil {
+			return reported, err
+		}
+
+		for _, z := range idle {
+			cartID := z.Member.(string)
+
+			claimed, err := c.redisClient.ZRem(ctx, activityKey, cartID).Result()
+			if err != nil {
+				return reported, err
+			}
+			if claimed == 0 || userIDOf(cartID) == 0 {
+				continue
+			}
+
+			err = c.reportAbandoned(ctx, cartID, time.Unix(int64(z.Score), 0))
+			if err != nil {
+				log.Printf("Error reporting abandoned cart %s: %s", cartID, err)
+				continue
+			}
+			reported++
+		}
+
+		if len(idle) < sweepBatch {
+			return reported, nil
+		}
+	}
+}
+
+func (c *ShoppingCart) reportAbandoned(ctx context.Context, cartID string, lastActivity time.Time) error {
+	items, total, _, err := c.getItems(ctx, cartID)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+
+	user, err := c.userClient.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: userIDOf(cartID)})
+	if err != nil {
+		return err
+	}
+
+	dataItems := make([]*CartItemData, 0, len(items))
+	for sku, item := range items {
+		dataItems = append(dataItems, &CartItemData{
+			Quantity:       item.Quantity,
+			Price:          item.Price,
+			Sku:            sku,
+			Name:           item.Name,
+			ImageURL:       template.URL(item.ImageURL),
+			ItemTotalPrice: item.ItemTotalPrice,
+		})
+	}
+
+	return c.publish(ctx, c.abandonedWriter, abandonedEvent, CartData{
+		CartID:            cartID,
+		UserID:            user.GetUserId(),
+		CustomerFirstName: user.GetFirstName(),
+		CustomerLastName:  user.GetLastName(),
+		CustomerEmail:     user.GetEmail(),
+		Items:             dataItems,
+		TotalPrice:        total,
+		CartURL:           cartURL,
+		LastActivity:      lastActivity,
+	})
+}
+
+// userIDOf returns the user a cart belongs to, or zero for guest carts.
+func userIDOf(cartID string) int64 {
+	userID, err := strconv.ParseInt(cartID, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return userID
+}
@@ -3,132 +3,285 @@ package cart
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+	"shared/money"
+	"shopping-cart-service/internal/currency"
 	pb "shopping-cart-service/protobuf"
 	"time"
 )
 
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrInvalidQuantity     = errors.New("quantity must be positive")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrItemNotFound        = errors.New("item not in cart")
+	ErrProductUnavailable  = errors.New("product is no longer available")
+)
+
 type ShoppingCart struct {
-	redisClient   *redis.Client
-	productClient pb.ProductCatalogServiceClient
-	ttl           int64
+	redisClient     *redis.Client
+	productClient   pb.ProductCatalogServiceClient
+	orderClient     pb.OrderServiceClient
+	userClient      pb.UserServiceClient
+	converter       *currency.Converter
+	defaultCurrency string
+	mergeStrategy   MergeStrategy
+	ttl             int64
+
+	itemAddedWriter   *kafka.Writer
+	itemRemovedWriter *kafka.Writer
+	abandonedWriter   *kafka.Writer
 }
 
 type Item struct {
 	Quantity       int32
-	Price          float64
+	Price          money.Money
+	ProductID      string
 	Name           string
 	ImageURL       string
-	ItemTotalPrice float64
+	ItemTotalPrice money.Money
 }
 
-func New(redisClient *redis.Client, ttl int64, productClient pb.ProductCatalogServiceClient) *ShoppingCart {
+func New(redisClient *redis.Client, ttl int64, productClient pb.ProductCatalogServiceClient, orderClient pb.OrderServiceClient, userClient pb.UserServiceClient, converter *currency.Converter, defaultCurrency string, mergeStrategy MergeStrategy, itemAddedWriter, itemRemovedWriter, abandonedWriter *kafka.Writer) *ShoppingCart {
 	return &ShoppingCart{
-		redisClient:   redisClient,
-		ttl:           ttl,
-		productClient: productClient,
+		redisClient:       redisClient,
+		ttl:               ttl,
+		productClient:     productClient,
+		orderClient:       orderClient,
+		userClient:        userClient,
+		converter:         converter,
+		defaultCurrency:   defaultCurrency,
+		mergeStrategy:     mergeStrategy,
+		itemAddedWriter:   itemAddedWriter,
+		itemRemovedWriter: itemRemovedWriter,
+		abandonedWriter:   abandonedWriter,
+	}
+}
+
+// Cart is a cart priced in its currency, with the applied coupon taken into account.
+type Cart struct {
+	Items        map[string]Item
+	Subtotal     money.Money
+	Discount     money.Money
+	Total        money.Money
+	TotalItems   int32
+	CouponCode   string
+	FreeShipping bool
+}
+
+// GetCart returns the cart items priced in the cart currency. Items keep the price they
+// were added with in the product's own currency and are converted on every read, so
+// switching the cart currency re-prices the whole cart. A coupon that no longer applies
+// gives no discount; ValidateCart reports it.
+func (c *ShoppingCart) GetCart(ctx context.Context, cartID string) (*Cart, error) {
+	items, subtotal, totalItems, err := c.getItems(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Cart{
+		Items:      items,
+		Subtotal:   subtotal,
+		Discount:   money.New(0, subtotal.Currency),
+		Total:      subtotal,
+		TotalItems: totalItems,
+	}
+
+	code, discount, err := c.couponDiscount(ctx, cartID, items, subtotal)
+	if err != nil && !isCouponRejection(err) {
+		return nil, err
+	}
+
+	if err == nil && code != "" {
+		result.CouponCode = code
+		result.Discount = discount.Amount
+		result.FreeShipping = discount.FreeShipping
+		result.Total = money.New(subtotal.Amount-discount.Amount.Amount, subtotal.Currency)
 	}
+
+	return result, nil
 }
 
-func (c *ShoppingCart) GetCart(ctx context.Context, userID int64) (map[string]string, float64, int32, error) {
-	key := fmt.Sprintf("cart:%d", userID)
+func (c *ShoppingCart) getItems(ctx context.Context, cartID string) (map[string]Item, money.Money, int32, error) {
+	key := fmt.Sprintf("cart:%s", cartID)
 	res, err := c.redisClient.HGetAll(ctx, key).Result()
 	if err != nil {
-		return nil, 0.0, 0, err
+		return nil, money.Money{}, 0, err
 	}
 
-	var totalPrice float64
+	cartCurrency, err := c.GetCurrency(ctx, cartID)
+	if err != nil {
+		return nil, money.Money{}, 0, err
+	}
+
+	items := make(map[string]Item, len(res))
+	totalPrice := money.New(0, cartCurrency)
 	var totalItems int32
-	for _, details := range res {
+	for sku, details := range res {
 		var CartItem Item
 		err = json.Unmarshal([]byte(details), &CartItem)
 		if err != nil {
-			return nil, 0.0, 0, err
+			return nil, money.Money{}, 0, err
+		}
+
+		CartItem.Price, err = c.converter.Convert(ctx, CartItem.Price, cartCurrency)
+		if err != nil {
+			return nil, money.Money{}, 0, err
 		}
+		CartItem.ItemTotalPrice = CartItem.Price.Mul(int64(CartItem.Quantity))
 
-		totalPrice += CartItem.Price * float64(CartItem.Quantity)
+		totalPrice, err = totalPrice.Add(CartItem.ItemTotalPrice)
+		if err != nil {
+			return nil, money.Money{}, 0, err
+		}
 		totalItems += CartItem.Quantity
+		items[sku] = CartItem
 	}
 
-	return res, totalPrice, totalItems, err
+	return items, totalPrice, totalItems, nil
 }
 
-func (c *ShoppingCart) AddItem(ctx context.Context, userID int64, quantity int32, sku string) error {
-	key := fmt.Sprintf("cart:%d", userID)
+func (c *ShoppingCart) GetCurrency(ctx context.Context, cartID string) (string, error) {
+	key := fmt.Sprintf("cart:%s:currency", cartID)
+	cartCurrency, err := c.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return c.defaultCurrency, nil
+		}
+		return "", err
+	}
+
+	return cartCurrency, nil
+}
 
-	getProductResp, err2 := c.productClient.GetProductBySKU(ctx, &pb.GetProductBySKURequest{Sku: sku})
-	if err2 != nil {
-		return err2
+// SetCurrency selects the currency the cart is priced and checked out in. The choice
+// outlives ClearCart and expires together with the cart.
+func (c *ShoppingCart) SetCurrency(ctx context.Context, cartID string, cartCurrency string) error {
+	if !money.IsSupported(cartCurrency) {
+		return ErrUnsupportedCurrency
 	}
 
+	key := fmt.Sprintf("cart:%s:currency", cartID)
+	_, err := c.redisClient.Set(ctx, key, cartCurrency, time.Duration(c.ttl)*time.Second).Result()
+
+	return err
+}
+
+// AddItem adds quantity units of a SKU to the cart, on top of any already in it, and
+// refreshes the line with the current catalog price and name. It returns the line as it
+// now is in the cart, priced in the cart currency.
+func (c *ShoppingCart) AddItem(ctx context.Context, cartID string, quantity int32, sku string) (*Item, error) {
+	key := fmt.Sprintf("cart:%s", cartID)
+
+	if quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	getProductResp, err := c.productClient.GetProductBySKU(forwardAuth(ctx), &pb.GetProductBySKURequest{Sku: sku})
+	if err != nil {
+		return nil, err
+	}
+
+	product := getProductResp.GetProduct()
+	if !product.GetIsActive() {
+		return nil, ErrProductUnavailable
+	}
+	if !inStock(product, quantity) {
+		return nil, ErrInsufficientStock
+	}
+
+	price := money.New(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency())
+
 	value := Item{
 		Quantity:       quantity,
-		Price:          getProductResp.Product.Price,
-		Name:           getProductResp.Product.Name,
-		ImageURL:       getProductResp.Product.ImageUrl,
-		ItemTotalPrice: float64(quantity) * getProductResp.Product.Price,
+		Price:          price,
+		ProductID:      product.GetId(),
+		Name:           product.GetName(),
+		ImageURL:       product.GetImageUrl(),
+		ItemTotalPrice: price.Mul(int64(quantity)),
 	}
 
 	encodedValue, err := json.Marshal(value)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = c.redisClient.HSet(ctx, key, sku, encodedValue).Result()
+	cartCurrency, err := c.GetCurrency(ctx, cartID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = c.redisClient.Expire(ctx, key, time.Duration(c.ttl)*time.Second).Result()
+	cartPrice, err := c.converter.Convert(ctx, price, cartCurrency)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	res := addItemScript.Run(ctx, c.redisClient, []string{key}, sku, encodedValue, quantity, product.GetStockQuantity(), c.ttl)
+	if err = scriptResult(res); err != nil {
+		return nil, err
+	}
 
-func (c *ShoppingCart) UpdateItemQuantity(ctx context.Context, userID int64, quantity int32, sku string) error {
-	key := fmt.Sprintf("cart:%d", userID)
-	item, err := c.redisClient.HGet(ctx, key, sku).Result()
+	// The script returns the quantity of the line after the addition.
+	total, err := res.Int64()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var value Item
+	c.touch(ctx, cartID)
+	c.sendItemEvent(ctx, c.itemAddedWriter, itemAddedEvent, cartID, sku, quantity)
 
-	err = json.Unmarshal([]byte(item), &value)
-	if err != nil {
-		return err
-	}
+	value.Quantity = int32(total)
+	value.Price = cartPrice
+	value.ItemTotalPrice = cartPrice.Mul(total)
 
-	value.Quantity = quantity
+	return &value, nil
+}
 
-	encodedValue, err := json.Marshal(value)
-	if err != nil {
-		return err
+func (c *ShoppingCart) UpdateItemQuantity(ctx context.Context, cartID string, quantity int32, sku string) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
 	}
 
-	_, err = c.redisClient.HSet(ctx, key, sku, encodedValue).Result()
+	getProductResp, err := c.productClient.GetProductBySKU(forwardAuth(ctx), &pb.GetProductBySKURequest{Sku: sku})
 	if err != nil {
 		return err
 	}
 
-	_, err = c.redisClient.Expire(ctx, key, time.Duration(c.ttl)*time.Second).Result()
+	product := getProductResp.GetProduct()
+	if !inStock(product, quantity) {
+		return ErrInsufficientStock
+	}
+
+	key := fmt.Sprintf("cart:%s", cartID)
+
+	err = scriptResult(setQuantityScript.Run(ctx, c.redisClient, []string{key}, sku, quantity, product.GetStockQuantity(), c.ttl))
 	if err != nil {
 		return err
 	}
 
-	return nil
+	c.touch(ctx, cartID)
 
+	return nil
 }
 
-func (c *ShoppingCart) RemoveItem(ctx context.Context, userID int64, sku string) {
-	key := fmt.Sprintf("cart:%d", userID)
-	c.redisClient.HDel(ctx, key, sku)
+func (c *ShoppingCart) RemoveItem(ctx context.Context, cartID string, sku string) {
+	key := fmt.Sprintf("cart:%s", cartID)
+	removed, err := removeItemScript.Run(ctx, c.redisClient, []string{key}, sku, c.ttl).Int64()
+	if err != nil || removed == 0 {
+		return
+	}
+
+	c.touch(ctx, cartID)
+	c.sendItemEvent(ctx, c.itemRemovedWriter, itemRemovedEvent, cartID, sku, int32(removed))
 }
 
-func (c *ShoppingCart) ClearCart(ctx context.Context, userID int64) {
-	key := fmt.Sprintf("cart:%d", userID)
-	c.redisClient.Del(ctx, key)
+// ClearCart empties the cart and drops its coupon; the cart currency is kept. A cleared
+// cart is no longer tracked for abandonment.
+func (c *ShoppingCart) ClearCart(ctx context.Context, cartID string) {
+	key := fmt.Sprintf("cart:%s", cartID)
+	c.redisClient.Del(ctx, key, fmt.Sprintf("cart:%s:coupon", cartID))
+	c.redisClient.ZRem(ctx, activityKey, cartID)
 }
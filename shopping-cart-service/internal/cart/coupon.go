@@ -0,0 +1,144 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"shared/money"
+	"shopping-cart-service/internal/promotion"
+	pb "shopping-cart-service/protobuf"
+	"strings"
+	"time"
+)
+
+var ErrCouponNotFound = errors.New("coupon not found")
+
+// ApplyCoupon attaches a coupon to the cart if it gives a discount on the cart as it is now.
+// A cart holds at most one coupon; applying another replaces it.
+func (c *ShoppingCart) ApplyCoupon(ctx context.Context, cartID, code string) (promotion.Discount, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	items, subtotal, _, err := c.getItems(ctx, cartID)
+	if err != nil {
+		return promotion.Discount{}, err
+	}
+
+	discount, err := c.evaluateCoupon(ctx, code, items, subtotal)
+	if err != nil {
+		return promotion.Discount{}, err
+	}
+
+	key := fmt.Sprintf("cart:%s:coupon", cartID)
+	if _, err = c.redisClient.Set(ctx, key, code, time.Duration(c.ttl)*time.Second).Result(); err != nil {
+		return promotion.Discount{}, err
+	}
+
+	return discount, nil
+}
+
+func (c *ShoppingCart) RemoveCoupon(ctx context.Context, cartID string) {
+	c.redisClient.Del(ctx, fmt.Sprintf("cart:%s:coupon", cartID))
+}
+
+// couponDiscount evaluates the coupon applied to the cart, if any. It returns an empty code
+// when the cart has no coupon.
+func (c *ShoppingCart) couponDiscount(ctx context.Context, cartID string, items map[string]Item, subtotal money.Money) (string, promotion.Discount, error) {
+	code, err := c.redisClient.Get(ctx, fmt.Sprintf("cart:%s:coupon", cartID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", promotion.Discount{}, nil
+		}
+		return "", promotion.Discount{}, err
+	}
+
+	discount, err := c.evaluateCoupon(ctx, code, items, subtotal)
+	if err != nil {
+		return code, promotion.Discount{}, err
+	}
+
+	return code, discount, nil
+}
+
+func (c *ShoppingCart) evaluateCoupon(ctx context.Context, code string, items map[string]Item, subtotal money.Money) (promotion.Discount, error) {
+	resp, err := c.orderClient.GetCoupon(forwardAuth(ctx), &pb.GetCouponRequest{Code: code})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return promotion.Discount{}, ErrCouponNotFound
+		}
+		return promotion.Discount{}, err
+	}
+
+	coupon, err := c.couponFromPB(ctx, resp, subtotal.Currency)
+	if err != nil {
+		return promotion.Discount{}, err
+	}
+
+	lines := make([]promotion.Line, 0, len(items))
+	for sku, item := range items {
+		lines = append(lines, promotion.Line{Sku: sku, Quantity: item.Quantity, Price: item.Price})
+	}
+
+	return promotion.Evaluate(coupon, lines, subtotal, time.Now())
+}
+
+// couponFromPB converts the coupon's amounts into the cart currency.
+func (c *ShoppingCart) couponFromPB(ctx context.Context, resp *pb.GetCouponResponse, cartCurrency string) (*promotion.Coupon, error) {
+	coupon := resp.GetCoupon()
+
+	amountOff, err := c.converter.Convert(ctx, money.New(coupon.GetAmountOff().GetAmount(), coupon.GetAmountOff().GetCurrency()), cartCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	minBasket, err := c.converter.Convert(ctx, money.New(coupon.GetMinBasket().GetAmount(), coupon.GetMinBasket().GetCurrency()), cartCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &promotion.Coupon{
+		Code:            coupon.GetCode(),
+		Type:            promotion.Type(coupon.GetType()),
+		PercentOff:      coupon.GetPercentOff(),
+		AmountOff:       amountOff,
+		MinBasket:       minBasket,
+		BuySku:          coupon.GetBuySku(),
+		BuyQuantity:     coupon.GetBuyQuantity(),
+		GetQuantity:     coupon.GetGetQuantity(),
+		UsageLimit:      coupon.GetUsageLimit(),
+		PerUserLimit:    coupon.GetPerUserLimit(),
+		TimesRedeemed:   coupon.GetTimesRedeemed(),
+		UserRedemptions: resp.GetUserRedemptions(),
+	}
+
+	if coupon.GetStartsAt() != nil {
+		startsAt := coupon.GetStartsAt().AsTime()
+		result.StartsAt = &startsAt
+	}
+	if coupon.GetEndsAt() != nil {
+		endsAt := coupon.GetEndsAt().AsTime()
+		result.EndsAt = &endsAt
+	}
+
+	return result, nil
+}
+
+func isCouponRejection(err error) bool {
+	return errors.Is(err, ErrCouponNotFound) || promotion.IsRejection(err)
+}
+
+// forwardAuth passes the caller's bearer token on, so per-user coupon limits are counted
+// for the signed-in user and the catalog prices their customer group. Guest requests go
+// out without one.
+func forwardAuth(ctx context.Context) context.Context {
+	mt, _ := metadata.FromIncomingContext(ctx)
+	tokens := mt.Get("authorization")
+	if len(tokens) == 0 {
+		return ctx
+	}
+
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("Authorization", tokens[0]))
+}
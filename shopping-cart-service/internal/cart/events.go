@@ -0,0 +1,90 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"html/template"
+	"log"
+	"shared/money"
+	"time"
+)
+
+const (
+	itemAddedEvent   = "cart.item_added"
+	itemRemovedEvent = "cart.item_removed"
+	abandonedEvent   = "cart.abandoned"
+)
+
+type CartEvent struct {
+	EventID   string    `json:"event_id"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	Version   string    `json:"version"`
+	Data      CartData  `json:"data"`
+}
+
+// CartData is the payload of all cart events. Item events carry the SKU and the quantity
+// added or removed; abandoned-cart events carry the customer and the cart contents. The
+// user ID is zero for guest carts.
+type CartData struct {
+	CartID            string          `json:"cart_id"`
+	UserID            int64           `json:"user_id"`
+	Sku               string          `json:"sku,omitempty"`
+	Quantity          int32           `json:"quantity,omitempty"`
+	CustomerFirstName string          `json:"customer_first_name,omitempty"`
+	CustomerLastName  string          `json:"customer_last_name,omitempty"`
+	CustomerEmail     string          `json:"customer_email,omitempty"`
+	Items             []*CartItemData `json:"items,omitempty"`
+	TotalPrice        money.Money     `json:"total_price"`
+	CartURL           string          `json:"cart_url,omitempty"`
+	LastActivity      time.Time       `json:"last_activity"`
+}
+
+type CartItemData struct {
+	Quantity       int32        `json:"quantity"`
+	Price          money.Money  `json:"price"`
+	Sku            string       `json:"sku"`
+	Name           string       `json:"name"`
+	ImageURL       template.URL `json:"image_url"`
+	ItemTotalPrice money.Money  `json:"item_total_price"`
+}
+
+func (c *ShoppingCart) publish(ctx context.Context, writer *kafka.Writer, eventType string, data CartData) error {
+	event := CartEvent{
+		EventID:   uuid.NewString(),
+		EventType: eventType,
+		Timestamp: time.Now(),
+		Version:   "1.0",
+		Data:      data,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Key:   []byte(data.CartID),
+		Value: eventBytes,
+	}
+
+	return writer.WriteMessages(ctx, msg)
+}
+
+// sendItemEvent reports a line change. Item events are informational, so a failure to send
+// one is logged rather than failing the cart mutation.
+func (c *ShoppingCart) sendItemEvent(ctx context.Context, writer *kafka.Writer, eventType, cartID, sku string, quantity int32) {
+	data := CartData{
+		CartID:       cartID,
+		UserID:       userIDOf(cartID),
+		Sku:          sku,
+		Quantity:     quantity,
+		LastActivity: time.Now(),
+	}
+
+	if err := c.publish(ctx, writer, eventType, data); err != nil {
+		log.Printf("Error sending %s event: %s", eventType, err)
+	}
+}
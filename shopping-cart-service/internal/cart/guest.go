@@ -0,0 +1,262 @@
+package cart
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	pb "shopping-cart-service/protobuf"
+	"strconv"
+	"time"
+)
+
+const (
+	guestTokenBytes = 32
+	mergeRetries    = 3
+)
+
+var (
+	ErrInvalidMergeStrategy = errors.New("invalid merge strategy")
+	ErrCartBusy             = errors.New("cart was modified concurrently")
+)
+
+// MergeStrategy decides which quantity wins when the guest and the user cart both hold a SKU.
+type MergeStrategy string
+
+const (
+	MergeSum       MergeStrategy = "sum"
+	MergeKeepUser  MergeStrategy = "keep_user"
+	MergeKeepGuest MergeStrategy = "keep_guest"
+	MergeMax       MergeStrategy = "max"
+)
+
+func ParseMergeStrategy(s string) (MergeStrategy, error) {
+	switch strategy := MergeStrategy(s); strategy {
+	case MergeSum, MergeKeepUser, MergeKeepGuest, MergeMax:
+		return strategy, nil
+	}
+
+	return "", ErrInvalidMergeStrategy
+}
+
+func UserCartID(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
+
+// GuestCartID names the cart of an anonymous visitor. Guest carts live under their own
+// prefix so that a token can never address a user's cart.
+func GuestCartID(token string) string {
+	return "guest:" + token
+}
+
+// NewGuestToken issues an opaque token identifying a guest cart. The cart itself is
+// created lazily by the first item added to it.
+func NewGuestToken() (string, error) {
+	b := make([]byte, guestTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func ValidGuestToken(token string) bool {
+	b, err := hex.DecodeString(token)
+	return err == nil && len(b) == guestTokenBytes
+}
+
+// MergeCart moves the items of a guest cart into a user cart and deletes the guest cart.
+// SKUs present in both are resolved by strategy, or by the configured strategy when it's
+// empty. Merged lines are capped at the available stock, and lines whose product is out of
+// stock are left out; both are reported as warnings. The guest cart currency and coupon are
+// kept only if the user hasn't chosen their own.
+func (c *ShoppingCart) MergeCart(ctx context.Context, guestCartID, userCartID string, strategy MergeStrategy) ([]Warning, error) {
+	if strategy == "" {
+		strategy = c.mergeStrategy
+	}
+
+	guestKey := fmt.Sprintf("cart:%s", guestCartID)
+	guestCurrencyKey := fmt.Sprintf("cart:%s:currency", guestCartID)
+	userKey := fmt.Sprintf("cart:%s", userCartID)
+	userCurrencyKey := fmt.Sprintf("cart:%s:currency", userCartID)
+	guestCouponKey := fmt.Sprintf("cart:%s:coupon", guestCartID)
+	userCouponKey := fmt.Sprintf("cart:%s:coupon", userCartID)
+	ttl := time.Duration(c.ttl) * time.Second
+
+	var (
+		looked   map[string]bool
+		products map[string]*pb.Product
+		warnings []Warning
+	)
+
+	merge := func(tx *redis.Tx) error {
+		guestItems, err := tx.HGetAll(ctx, guestKey).Result()
+		if err != nil {
+			return err
+		}
+
+		userItems, err := tx.HGetAll(ctx, userKey).Result()
+		if err != nil {
+			return err
+		}
+
+		guestCurrency, err := tx.Get(ctx, guestCurrencyKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		userHasCurrency, err := tx.Exists(ctx, userCurrencyKey).Result()
+		if err != nil {
+			return err
+		}
+
+		guestCoupon, err := tx.Get(ctx, guestCouponKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		userHasCoupon, err := tx.Exists(ctx, userCouponKey).Result()
+		if err != nil {
+			return err
+		}
+
+		warnings = nil
+		merged := make(map[string]interface{}, len(guestItems))
+		for sku, details := range guestItems {
+			if !looked[sku] {
+				// The guest cart gained a SKU after its stock was looked up.
+				return redis.TxFailedErr
+			}
+
+			item, err := mergeItem(userItems[sku], details, strategy)
+			if err != nil {
+				return err
+			}
+			if item == nil {
+				continue
+			}
+
+			warning, keep := capToStock(sku, item, products[sku])
+			if warning != nil {
+				warnings = append(warnings, *warning)
+			}
+			if !keep {
+				continue
+			}
+
+			encoded, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			merged[sku] = encoded
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if len(merged) > 0 {
+				pipe.HSet(ctx, userKey, merged)
+				pipe.Expire(ctx, userKey, ttl)
+			}
+			if guestCurrency != "" && userHasCurrency == 0 {
+				pipe.Set(ctx, userCurrencyKey, guestCurrency, ttl)
+			}
+			if guestCoupon != "" && userHasCoupon == 0 {
+				pipe.Set(ctx, userCouponKey, guestCoupon, ttl)
+			}
+			pipe.Del(ctx, guestKey, guestCurrencyKey, guestCouponKey)
+			return nil
+		})
+
+		return err
+	}
+
+	for i := 0; i < mergeRetries; i++ {
+		// Stock is looked up outside the transaction, so the catalog isn't called while
+		// the carts are watched.
+		skus, err := c.redisClient.HKeys(ctx, guestKey).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		if products, err = c.getProducts(ctx, skus); err != nil {
+			return nil, err
+		}
+
+		looked = make(map[string]bool, len(skus))
+		for _, sku := range skus {
+			looked[sku] = true
+		}
+
+		err = c.redisClient.Watch(ctx, merge, guestKey, userKey, userCurrencyKey, userCouponKey)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		c.redisClient.ZRem(ctx, activityKey, guestCartID)
+		c.touch(ctx, userCartID)
+
+		return warnings, nil
+	}
+
+	return nil, ErrCartBusy
+}
+
+// mergeItem returns the line to store in the user cart, or nil if the user's line stays as
+// it is.
+func mergeItem(userDetails, guestDetails string, strategy MergeStrategy) (*Item, error) {
+	var guestItem Item
+	if err := json.Unmarshal([]byte(guestDetails), &guestItem); err != nil {
+		return nil, err
+	}
+
+	if userDetails == "" {
+		return &guestItem, nil
+	}
+
+	var userItem Item
+	if err := json.Unmarshal([]byte(userDetails), &userItem); err != nil {
+		return nil, err
+	}
+
+	switch strategy {
+	case MergeKeepUser:
+		return nil, nil
+	case MergeKeepGuest:
+		return &guestItem, nil
+	case MergeMax:
+		if guestItem.Quantity <= userItem.Quantity {
+			return nil, nil
+		}
+		userItem.Quantity = guestItem.Quantity
+	case MergeSum:
+		userItem.Quantity += guestItem.Quantity
+	default:
+		return nil, ErrInvalidMergeStrategy
+	}
+
+	userItem.ItemTotalPrice = userItem.Price.Mul(int64(userItem.Quantity))
+
+	return &userItem, nil
+}
+
+// capToStock limits a merged line to the stock of its product and reports whether the line
+// should be stored at all. Products the catalog no longer knows are left to ValidateCart.
+func capToStock(sku string, item *Item, product *pb.Product) (*Warning, bool) {
+	switch {
+	case product == nil:
+		return nil, true
+	case !inStock(product, 1):
+		return &Warning{Sku: sku, Kind: OutOfStock}, false
+	case !inStock(product, item.Quantity):
+		item.Quantity = product.GetStockQuantity()
+		item.ItemTotalPrice = item.Price.Mul(int64(item.Quantity))
+		return &Warning{Sku: sku, Kind: InsufficientStock, AvailableQuantity: item.Quantity}, true
+	}
+
+	return nil, true
+}
@@ -0,0 +1,205 @@
+package cart
+
+import (
+	"encoding/json"
+	"errors"
+	"shared/money"
+	pb "shopping-cart-service/protobuf"
+	"strings"
+	"testing"
+)
+
+func encodeItem(t *testing.T, quantity int32) string {
+	t.Helper()
+
+	price := money.New(250, "EUR")
+	encoded, err := json.Marshal(Item{Quantity: quantity, Price: price, Name: "Mug", ItemTotalPrice: price.Mul(int64(quantity))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(encoded)
+}
+
+func TestMergeItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     int32
+		guest    int32
+		strategy MergeStrategy
+		want     int32
+		wantErr  error
+	}{
+		{name: "only in guest cart", guest: 2, strategy: MergeKeepUser, want: 2},
+		{name: "sum", user: 3, guest: 2, strategy: MergeSum, want: 5},
+		{name: "max keeps larger guest line", user: 1, guest: 4, strategy: MergeMax, want: 4},
+		{name: "max keeps larger user line", user: 4, guest: 1, strategy: MergeMax},
+		{name: "max with equal lines", user: 2, guest: 2, strategy: MergeMax},
+		{name: "keep user", user: 3, guest: 5, strategy: MergeKeepUser},
+		{name: "keep guest", user: 3, guest: 5, strategy: MergeKeepGuest, want: 5},
+		{name: "keep guest when smaller", user: 5, guest: 1, strategy: MergeKeepGuest, want: 1},
+		{name: "unknown strategy", user: 1, guest: 1, strategy: "min", wantErr: ErrInvalidMergeStrategy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user string
+			if tt.user > 0 {
+				user = encodeItem(t, tt.user)
+			}
+
+			got, err := mergeItem(user, encodeItem(t, tt.guest), tt.strategy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mergeItem() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if tt.want == 0 {
+				if got != nil {
+					t.Errorf("mergeItem() = %+v, want the user line left as it is", got)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("mergeItem() = nil, want quantity %d", tt.want)
+			}
+			if got.Quantity != tt.want || got.ItemTotalPrice != money.New(250*int64(tt.want), "EUR") {
+				t.Errorf("mergeItem() = %d for %+v, want %d", got.Quantity, got.ItemTotalPrice, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeItemInvalid(t *testing.T) {
+	if _, err := mergeItem("", "{", MergeSum); err == nil {
+		t.Error("mergeItem() with a corrupt guest line error = nil, want an error")
+	}
+	if _, err := mergeItem("{", encodeItem(t, 1), MergeSum); err == nil {
+		t.Error("mergeItem() with a corrupt user line error = nil, want an error")
+	}
+}
+
+func TestCapToStock(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int32
+		product  *pb.Product
+		want     int32
+		keep     bool
+		warning  *Warning
+	}{
+		{
+			name:     "in stock",
+			quantity: 3,
+			product:  &pb.Product{IsActive: true, StockQuantity: 5},
+			want:     3,
+			keep:     true,
+		},
+		{
+			name:     "exactly in stock",
+			quantity: 5,
+			product:  &pb.Product{IsActive: true, StockQuantity: 5},
+			want:     5,
+			keep:     true,
+		},
+		{
+			name:     "capped at stock",
+			quantity: 8,
+			product:  &pb.Product{IsActive: true, StockQuantity: 5},
+			want:     5,
+			keep:     true,
+			warning:  &Warning{Sku: "MUG", Kind: InsufficientStock, AvailableQuantity: 5},
+		},
+		{
+			name:     "out of stock",
+			quantity: 2,
+			product:  &pb.Product{IsActive: false, StockQuantity: 0},
+			want:     2,
+			warning:  &Warning{Sku: "MUG", Kind: OutOfStock},
+		},
+		{
+			name:     "inactive with stock left",
+			quantity: 2,
+			product:  &pb.Product{IsActive: false, StockQuantity: 9},
+			want:     2,
+			warning:  &Warning{Sku: "MUG", Kind: OutOfStock},
+		},
+		{
+			name:     "unknown to the catalog",
+			quantity: 2,
+			want:     2,
+			keep:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price := money.New(250, "EUR")
+			item := &Item{Quantity: tt.quantity, Price: price, ItemTotalPrice: price.Mul(int64(tt.quantity))}
+
+			warning, keep := capToStock("MUG", item, tt.product)
+			if keep != tt.keep {
+				t.Errorf("capToStock() keep = %v, want %v", keep, tt.keep)
+			}
+			if (warning == nil) != (tt.warning == nil) || (warning != nil && *warning != *tt.warning) {
+				t.Errorf("capToStock() warning = %+v, want %+v", warning, tt.warning)
+			}
+			if item.Quantity != tt.want || item.ItemTotalPrice != price.Mul(int64(tt.want)) {
+				t.Errorf("capToStock() line = %d for %+v, want %d", item.Quantity, item.ItemTotalPrice, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMergeStrategy(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    MergeStrategy
+		wantErr error
+	}{
+		{in: "sum", want: MergeSum},
+		{in: "keep_user", want: MergeKeepUser},
+		{in: "keep_guest", want: MergeKeepGuest},
+		{in: "max", want: MergeMax},
+		{in: "", wantErr: ErrInvalidMergeStrategy},
+		{in: "SUM", wantErr: ErrInvalidMergeStrategy},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMergeStrategy(tt.in)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("ParseMergeStrategy(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestValidGuestToken(t *testing.T) {
+	token, err := NewGuestToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{name: "issued", token: token, want: true},
+		{name: "empty", token: "", want: false},
+		{name: "short", token: token[:len(token)-2], want: false},
+		{name: "long", token: token + "00", want: false},
+		{name: "not hex", token: strings.Repeat("z", len(token)), want: false},
+		{name: "user cart", token: "42", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidGuestToken(tt.token); got != tt.want {
+				t.Errorf("ValidGuestToken(%q) = %v, want %v", tt.token, got, tt.want)
+			}
+		})
+	}
+}
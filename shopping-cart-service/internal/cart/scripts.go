@@ -0,0 +1,146 @@
+package cart
+
+import (
+	"github.com/redis/go-redis/v9"
+)
+
+// Cart lines are JSON-encoded Items. The scripts below read, change and write a line, and
+// refresh the cart TTL, in one atomic step so concurrent mutations can't lose updates and
+// a cart is never left without an expiry. Item totals are always Price * Quantity.
+
+const (
+	scriptInsufficientStock = -1
+	scriptItemNotFound      = -2
+	scriptWishlistNotFound  = -3
+)
+
+// addItemScript stores the line in ARGV[2] with its quantity increased by whatever the cart
+// already holds of the SKU, unless that exceeds the available stock.
+//
+// KEYS[1] cart key; ARGV[1] sku, ARGV[2] encoded item, ARGV[3] quantity to add,
+// ARGV[4] available stock, ARGV[5] ttl in seconds.
+var addItemScript = redis.NewScript(`
+local item = cjson.decode(ARGV[2])
+local quantity = tonumber(ARGV[3])
+
+local existing = redis.call('HGET', KEYS[1], ARGV[1])
+if existing then
+	quantity = quantity + cjson.decode(existing).Quantity
+end
+
+if quantity > tonumber(ARGV[4]) then
+	return -1
+end
+
+item.Quantity = quantity
+item.ItemTotalPrice.amount = item.Price.amount * quantity
+
+redis.call('HSET', KEYS[1], ARGV[1], cjson.encode(item))
+redis.call('EXPIRE', KEYS[1], ARGV[5])
+
+return quantity
+`)
+
+// setQuantityScript sets the quantity of a line already in the cart.
+//
+// KEYS[1] cart key; ARGV[1] sku, ARGV[2] quantity, ARGV[3] available stock, ARGV[4] ttl in seconds.
+var setQuantityScript = redis.NewScript(`
+local existing = redis.call('HGET', KEYS[1], ARGV[1])
+if not existing then
+	return -2
+end
+
+local quantity = tonumber(ARGV[2])
+if quantity > tonumber(ARGV[3]) then
+	return -1
+end
+
+local item = cjson.decode(existing)
+item.Quantity = quantity
+item.ItemTotalPrice.amount = item.Price.amount * quantity
+
+redis.call('HSET', KEYS[1], ARGV[1], cjson.encode(item))
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+
+return quantity
+`)
+
+// repriceScript replaces the catalog data of lines still in the cart while keeping their
+// current quantity.
+//
+// KEYS[1] cart key; ARGV[1] ttl in seconds, then pairs of sku and encoded item.
+var repriceScript = redis.NewScript(`
+for i = 2, #ARGV, 2 do
+	local existing = redis.call('HGET', KEYS[1], ARGV[i])
+	if existing then
+		local item = cjson.decode(ARGV[i + 1])
+		item.Quantity = cjson.decode(existing).Quantity
+		item.ItemTotalPrice.amount = item.Price.amount * item.Quantity
+		redis.call('HSET', KEYS[1], ARGV[i], cjson.encode(item))
+	end
+end
+
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+
+return 0
+`)
+
+// removeItemScript deletes a line, refreshes the TTL of what is left of the cart and
+// returns the quantity removed.
+//
+// KEYS[1] cart key; ARGV[1] sku, ARGV[2] ttl in seconds.
+var removeItemScript = redis.NewScript(`
+local existing = redis.call('HGET', KEYS[1], ARGV[1])
+if not existing then
+	return 0
+end
+
+redis.call('HDEL', KEYS[1], ARGV[1])
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('EXPIRE', KEYS[1], ARGV[2])
+end
+
+return cjson.decode(existing).Quantity
+`)
+
+// addWishlistItemScript adds quantity units of a SKU to a wishlist, keeping the time the
+// SKU was first saved. Wishlists don't expire, so unlike the cart scripts there is no TTL.
+//
+// KEYS[1] user's wishlist index, KEYS[2] wishlist items; ARGV[1] wishlist id, ARGV[2] sku,
+// ARGV[3] encoded entry.
+var addWishlistItemScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+	return -3
+end
+
+local entry = cjson.decode(ARGV[3])
+
+local existing = redis.call('HGET', KEYS[2], ARGV[2])
+if existing then
+	local saved = cjson.decode(existing)
+	entry.Quantity = entry.Quantity + saved.Quantity
+	entry.AddedAt = saved.AddedAt
+end
+
+redis.call('HSET', KEYS[2], ARGV[2], cjson.encode(entry))
+
+return entry.Quantity
+`)
+
+func scriptResult(cmd *redis.Cmd) error {
+	result, err := cmd.Int64()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case scriptInsufficientStock:
+		return ErrInsufficientStock
+	case scriptItemNotFound:
+		return ErrItemNotFound
+	case scriptWishlistNotFound:
+		return ErrWishlistNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"shared/money"
+	pb "shopping-cart-service/protobuf"
+	"sync"
+)
+
+// maxConcurrentLookups bounds the catalog calls a single validation makes at once.
+const maxConcurrentLookups = 8
+
+type WarningKind int
+
+const (
+	PriceChanged WarningKind = iota
+	OutOfStock
+	InsufficientStock
+	ProductRemoved
+	CouponInvalid
+)
+
+// Warning describes a cart line that no longer matches the catalog. Prices are in the
+// cart currency.
+type Warning struct {
+	Sku               string
+	Kind              WarningKind
+	PreviousPrice     money.Money
+	CurrentPrice      money.Money
+	AvailableQuantity int32
+	Message           string
+}
+
+// ValidateCart checks every line against the current catalog. Lines whose price changed
+// are re-priced, so a price change is reported once; stock problems, removed products and
+// a coupon that no longer applies are reported until the customer fixes them.
+func (c *ShoppingCart) ValidateCart(ctx context.Context, cartID string) ([]Warning, error) {
+	key := fmt.Sprintf("cart:%s", cartID)
+	res, err := c.redisClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	cartCurrency, err := c.GetCurrency(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := make([]string, 0, len(res))
+	for sku := range res {
+		skus = append(skus, sku)
+	}
+
+	products, err := c.getProducts(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []Warning
+	var repriced []interface{}
+	for sku, details := range res {
+		var item Item
+		if err = json.Unmarshal([]byte(details), &item); err != nil {
+			return nil, err
+		}
+
+		product, ok := products[sku]
+		if !ok {
+			warnings = append(warnings, Warning{Sku: sku, Kind: ProductRemoved})
+			continue
+		}
+
+		switch {
+		case !inStock(product, 1):
+			warnings = append(warnings, Warning{Sku: sku, Kind: OutOfStock})
+		case !inStock(product, item.Quantity):
+			warnings = append(warnings, Warning{Sku: sku, Kind: InsufficientStock, AvailableQuantity: product.GetStockQuantity()})
+		}
+
+		price := money.New(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency())
+		if price == item.Price {
+			continue
+		}
+
+		warning := Warning{Sku: sku, Kind: PriceChanged}
+		if warning.PreviousPrice, err = c.converter.Convert(ctx, item.Price, cartCurrency); err != nil {
+			return nil, err
+		}
+		if warning.CurrentPrice, err = c.converter.Convert(ctx, price, cartCurrency); err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, warning)
+
+		item.Price = price
+		item.ItemTotalPrice = price.Mul(int64(item.Quantity))
+		item.ProductID = product.GetId()
+		item.Name = product.GetName()
+		item.ImageURL = product.GetImageUrl()
+
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		repriced = append(repriced, sku, encoded)
+	}
+
+	if len(repriced) > 0 {
+		if err = repriceScript.Run(ctx, c.redisClient, []string{key}, append([]interface{}{c.ttl}, repriced...)...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	items, subtotal, _, err := c.getItems(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	code, _, err := c.couponDiscount(ctx, cartID, items, subtotal)
+	if err != nil {
+		if !isCouponRejection(err) {
+			return nil, err
+		}
+		warnings = append(warnings, Warning{Kind: CouponInvalid, Message: fmt.Sprintf("%s: %s", code, err)})
+	}
+
+	return warnings, nil
+}
+
+// getProducts looks up the current catalog entries of the given SKUs concurrently. SKUs
+// the catalog no longer knows are left out of the result.
+func (c *ShoppingCart) getProducts(ctx context.Context, skus []string) (map[string]*pb.Product, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+
+	// The catalog prices the products for the signed-in customer's group.
+	lookupCtx := forwardAuth(ctx)
+
+	products := make(map[string]*pb.Product, len(skus))
+	sem := make(chan struct{}, maxConcurrentLookups)
+	for _, sku := range skus {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(sku string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := c.productClient.GetProductBySKU(lookupCtx, &pb.GetProductBySKURequest{Sku: sku})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case status.Code(err) == codes.NotFound:
+			case err != nil:
+				if firstErr == nil {
+					firstErr = err
+				}
+			default:
+				products[sku] = resp.GetProduct()
+			}
+		}(sku)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return products, nil
+}
+
+func inStock(product *pb.Product, quantity int32) bool {
+	return product.GetIsActive() && product.GetStockQuantity() >= quantity
+}
@@ -0,0 +1,339 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"shared/money"
+	pb "shopping-cart-service/protobuf"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Wishlists belong to signed-in users and, unlike carts, are stored without a TTL. Each
+// user has an index hash of wishlist ID to wishlist, and every wishlist a hash of SKU to
+// entry. Entries keep only the quantity and when the SKU was saved; name, price and stock
+// are read from the catalog whenever the wishlist is shown.
+
+const maxWishlistNameLength = 100
+
+var (
+	ErrWishlistNotFound    = errors.New("wishlist not found")
+	ErrInvalidWishlistName = errors.New("wishlist name must be between 1 and 100 characters")
+)
+
+type Wishlist struct {
+	ID         string
+	Name       string
+	TotalItems int32
+	CreatedAt  time.Time
+}
+
+// WishlistItem is a saved SKU with its current catalog data. Price is in the user's cart
+// currency. Unavailable items are no longer in the catalog and carry no product data.
+type WishlistItem struct {
+	Sku           string
+	Quantity      int32
+	Name          string
+	Price         money.Money
+	ImageURL      string
+	StockQuantity int32
+	InStock       bool
+	Unavailable   bool
+	AddedAt       time.Time
+}
+
+type wishlistInfo struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+type wishlistEntry struct {
+	Quantity int32
+	AddedAt  time.Time
+}
+
+func wishlistsKey(userID int64) string {
+	return fmt.Sprintf("wishlists:%d", userID)
+}
+
+func wishlistItemsKey(userID int64, wishlistID string) string {
+	return fmt.Sprintf("wishlist:%d:%s", userID, wishlistID)
+}
+
+func (c *ShoppingCart) CreateWishlist(ctx context.Context, userID int64, name string) (*Wishlist, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxWishlistNameLength {
+		return nil, ErrInvalidWishlistName
+	}
+
+	wishlist := &Wishlist{
+		ID:        uuid.NewString(),
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	encoded, err := json.Marshal(wishlistInfo{Name: wishlist.Name, CreatedAt: wishlist.CreatedAt})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.redisClient.HSet(ctx, wishlistsKey(userID), wishlist.ID, encoded).Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+// ListWishlists returns the user's wishlists, oldest first.
+func (c *ShoppingCart) ListWishlists(ctx context.Context, userID int64) ([]*Wishlist, error) {
+	res, err := c.redisClient.HGetAll(ctx, wishlistsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	wishlists := make([]*Wishlist, 0, len(res))
+	counts := make([]*redis.IntCmd, 0, len(res))
+	_, err = c.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for id, details := range res {
+			wishlist, err := decodeWishlist(id, details)
+			if err != nil {
+				return err
+			}
+			wishlists = append(wishlists, wishlist)
+			counts = append(counts, pipe.HLen(ctx, wishlistItemsKey(userID, id)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, count := range counts {
+		wishlists[i].TotalItems = int32(count.Val())
+	}
+
+	sort.Slice(wishlists, func(i, j int) bool {
+		return wishlists[i].CreatedAt.Before(wishlists[j].CreatedAt)
+	})
+
+	return wishlists, nil
+}
+
+// GetWishlist returns a wishlist with its items, most recently saved first, priced and
+// stocked as the catalog has them now.
+func (c *ShoppingCart) GetWishlist(ctx context.Context, userID int64, wishlistID string) (*Wishlist, []WishlistItem, error) {
+	wishlist, err := c.getWishlist(ctx, userID, wishlistID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := c.redisClient.HGetAll(ctx, wishlistItemsKey(userID, wishlistID)).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+	wishlist.TotalItems = int32(len(res))
+
+	entries := make(map[string]wishlistEntry, len(res))
+	skus := make([]string, 0, len(res))
+	for sku, details := range res {
+		var entry wishlistEntry
+		if err = json.Unmarshal([]byte(details), &entry); err != nil {
+			return nil, nil, err
+		}
+		entries[sku] = entry
+		skus = append(skus, sku)
+	}
+
+	products, err := c.getProducts(ctx, skus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cartCurrency, err := c.GetCurrency(ctx, UserCartID(userID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := make([]WishlistItem, 0, len(entries))
+	for sku, entry := range entries {
+		item := WishlistItem{
+			Sku:      sku,
+			Quantity: entry.Quantity,
+			AddedAt:  entry.AddedAt,
+		}
+
+		product, ok := products[sku]
+		if !ok {
+			item.Unavailable = true
+			items = append(items, item)
+			continue
+		}
+
+		price := money.New(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency())
+		item.Price, err = c.converter.Convert(ctx, price, cartCurrency)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.Name = product.GetName()
+		item.ImageURL = product.GetImageUrl()
+		item.StockQuantity = product.GetStockQuantity()
+		item.InStock = inStock(product, entry.Quantity)
+
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].AddedAt.After(items[j].AddedAt)
+	})
+
+	return wishlist, items, nil
+}
+
+func (c *ShoppingCart) DeleteWishlist(ctx context.Context, userID int64, wishlistID string) error {
+	var removed *redis.IntCmd
+	_, err := c.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		removed = pipe.HDel(ctx, wishlistsKey(userID), wishlistID)
+		pipe.Del(ctx, wishlistItemsKey(userID, wishlistID))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if removed.Val() == 0 {
+		return ErrWishlistNotFound
+	}
+
+	return nil
+}
+
+// AddWishlistItem saves quantity units of a SKU, on top of any already saved. Products
+// can be saved regardless of their stock; only their existence is checked.
+func (c *ShoppingCart) AddWishlistItem(ctx context.Context, userID int64, wishlistID, sku string, quantity int32) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	_, err := c.productClient.GetProductBySKU(ctx, &pb.GetProductBySKURequest{Sku: sku})
+	if err != nil {
+		return err
+	}
+
+	return c.addWishlistEntry(ctx, userID, wishlistID, sku, quantity)
+}
+
+func (c *ShoppingCart) RemoveWishlistItem(ctx context.Context, userID int64, wishlistID, sku string) error {
+	if _, err := c.getWishlist(ctx, userID, wishlistID); err != nil {
+		return err
+	}
+
+	removed, err := c.redisClient.HDel(ctx, wishlistItemsKey(userID, wishlistID), sku).Result()
+	if err != nil {
+		return err
+	}
+
+	if removed == 0 {
+		return ErrItemNotFound
+	}
+
+	return nil
+}
+
+// MoveWishlistItemToCart adds the saved quantity of a SKU to the user's cart and takes it
+// off the wishlist. If the cart can't take it, for example because it's out of stock, the
+// item stays on the wishlist.
+func (c *ShoppingCart) MoveWishlistItemToCart(ctx context.Context, userID int64, wishlistID, sku string) error {
+	if _, err := c.getWishlist(ctx, userID, wishlistID); err != nil {
+		return err
+	}
+
+	key := wishlistItemsKey(userID, wishlistID)
+	details, err := c.redisClient.HGet(ctx, key, sku).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrItemNotFound
+		}
+		return err
+	}
+
+	var entry wishlistEntry
+	if err = json.Unmarshal([]byte(details), &entry); err != nil {
+		return err
+	}
+
+	_, err = c.AddItem(ctx, UserCartID(userID), entry.Quantity, sku)
+	if err != nil {
+		return err
+	}
+
+	return c.redisClient.HDel(ctx, key, sku).Err()
+}
+
+// SaveForLater moves a line of the user's cart to one of their wishlists.
+func (c *ShoppingCart) SaveForLater(ctx context.Context, userID int64, wishlistID, sku string) error {
+	cartID := UserCartID(userID)
+
+	details, err := c.redisClient.HGet(ctx, fmt.Sprintf("cart:%s", cartID), sku).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrItemNotFound
+		}
+		return err
+	}
+
+	var item Item
+	if err = json.Unmarshal([]byte(details), &item); err != nil {
+		return err
+	}
+
+	err = c.addWishlistEntry(ctx, userID, wishlistID, sku, item.Quantity)
+	if err != nil {
+		return err
+	}
+
+	c.RemoveItem(ctx, cartID, sku)
+
+	return nil
+}
+
+func (c *ShoppingCart) addWishlistEntry(ctx context.Context, userID int64, wishlistID, sku string, quantity int32) error {
+	encoded, err := json.Marshal(wishlistEntry{Quantity: quantity, AddedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	keys := []string{wishlistsKey(userID), wishlistItemsKey(userID, wishlistID)}
+
+	return scriptResult(addWishlistItemScript.Run(ctx, c.redisClient, keys, wishlistID, sku, encoded))
+}
+
+func (c *ShoppingCart) getWishlist(ctx context.Context, userID int64, wishlistID string) (*Wishlist, error) {
+	details, err := c.redisClient.HGet(ctx, wishlistsKey(userID), wishlistID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrWishlistNotFound
+		}
+		return nil, err
+	}
+
+	return decodeWishlist(wishlistID, details)
+}
+
+func decodeWishlist(id, details string) (*Wishlist, error) {
+	var info wishlistInfo
+	if err := json.Unmarshal([]byte(details), &info); err != nil {
+		return nil, err
+	}
+
+	return &Wishlist{
+		ID:        id,
+		Name:      info.Name,
+		CreatedAt: info.CreatedAt,
+	}, nil
+}
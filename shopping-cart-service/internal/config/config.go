@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type Config struct {
@@ -14,9 +15,31 @@ type Config struct {
 		Host string `env:"PRODUCT_CATALOG_HOST" envDefault:"product-catalog-service"`
 		Port string `env:"PRODUCT_CATALOG_PORT" envDefault:"8080"`
 	}
+	OrderClient struct {
+		Host string `env:"ORDER_HOST" envDefault:"order-service"`
+		Port string `env:"ORDER_PORT" envDefault:"8080"`
+	}
 	Server struct {
 		Port string `env:"SERVER_PORT" envDefault:":8080"`
 	}
+	Currency struct {
+		Default   string `env:"DEFAULT_CURRENCY" envDefault:"USD"`
+		RatesFile string `env:"CURRENCY_RATES_FILE" envDefault:"./rates.json"`
+	}
+	Cart struct {
+		MergeStrategy  string        `env:"CART_MERGE_STRATEGY" envDefault:"sum"`
+		TTL            time.Duration `env:"CART_TTL" envDefault:"168h"`
+		AbandonedAfter time.Duration `env:"CART_ABANDONED_AFTER" envDefault:"24h"`
+		SweepInterval  time.Duration `env:"CART_SWEEP_INTERVAL" envDefault:"10m"`
+	}
+	UserClient struct {
+		Host string `env:"USER_HOST" envDefault:"user-service"`
+		Port string `env:"USER_PORT" envDefault:"8080"`
+	}
+	Kafka struct {
+		Host string `env:"KAFKA_HOST" envDefault:"kafka"`
+		Port string `env:"KAFKA_PORT" envDefault:"9092"`
+	}
 }
 
 func New() (*Config, error) {
@@ -0,0 +1,85 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"shared/money"
+	"testing"
+)
+
+func newTestConverter(t *testing.T) *Converter {
+	t.Helper()
+
+	provider, err := NewStaticProvider("../../rates.json")
+	if err != nil {
+		t.Fatalf("NewStaticProvider() error = %v", err)
+	}
+
+	return NewConverter(provider)
+}
+
+func TestConvert(t *testing.T) {
+	converter := newTestConverter(t)
+
+	tests := []struct {
+		name string
+		in   money.Money
+		to   string
+		want int64
+	}{
+		{name: "base to currency", in: money.New(1000, "USD"), to: "EUR", want: 920},
+		{name: "rounds up", in: money.New(3, "USD"), to: "EUR", want: 3},
+		{name: "half rounds up", in: money.New(1, "USD"), to: "UAH", want: 42},
+		{name: "half rounds up again", in: money.New(3, "USD"), to: "UAH", want: 125},
+		{name: "negative half rounds away from zero", in: money.New(-1, "USD"), to: "UAH", want: -42},
+		{name: "rounds down", in: money.New(1, "UAH"), to: "USD", want: 0},
+		{name: "currency to base", in: money.New(100, "EUR"), to: "USD", want: 109},
+		{name: "cross rate", in: money.New(100, "EUR"), to: "UAH", want: 4511},
+		{name: "zero", in: money.New(0, "EUR"), to: "UAH", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.Convert(context.Background(), tt.in, tt.to)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if got != money.New(tt.want, tt.to) {
+				t.Errorf("Convert(%v, %s) = %v, want %d %s", tt.in, tt.to, got, tt.want, tt.to)
+			}
+		})
+	}
+}
+
+func TestConvertSameCurrency(t *testing.T) {
+	converter := newTestConverter(t)
+
+	for _, in := range []money.Money{money.New(1234, "EUR"), money.New(-5, "UAH"), money.New(99, "GBP")} {
+		got, err := converter.Convert(context.Background(), in, in.Currency)
+		if err != nil {
+			t.Fatalf("Convert(%v) error = %v", in, err)
+		}
+		if got != in {
+			t.Errorf("Convert(%v) = %v, want it unchanged", in, got)
+		}
+	}
+}
+
+func TestConvertUnknownCurrency(t *testing.T) {
+	converter := newTestConverter(t)
+
+	tests := []struct {
+		from, to string
+	}{
+		{from: "USD", to: "GBP"},
+		{from: "GBP", to: "EUR"},
+		{from: "", to: "EUR"},
+	}
+
+	for _, tt := range tests {
+		_, err := converter.Convert(context.Background(), money.New(100, tt.from), tt.to)
+		if !errors.Is(err, ErrUnknownRate) {
+			t.Errorf("Convert(%q to %q) error = %v, want %v", tt.from, tt.to, err, ErrUnknownRate)
+		}
+	}
+}
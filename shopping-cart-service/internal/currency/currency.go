@@ -0,0 +1,58 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"shared/money"
+)
+
+var ErrUnknownRate = errors.New("exchange rate not available")
+
+// RateProvider supplies the exchange rate for converting an amount in one currency into another.
+type RateProvider interface {
+	Rate(ctx context.Context, from, to string) (*big.Rat, error)
+}
+
+type Converter struct {
+	provider RateProvider
+}
+
+func NewConverter(provider RateProvider) *Converter {
+	return &Converter{
+		provider: provider,
+	}
+}
+
+// Convert expresses m in the target currency. The converted amount is rounded to the
+// nearest minor unit, halves away from zero.
+func (c *Converter) Convert(ctx context.Context, m money.Money, to string) (money.Money, error) {
+	if m.Currency == to {
+		return m, nil
+	}
+
+	rate, err := c.provider.Rate(ctx, m.Currency, to)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	amount := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate)
+
+	return money.New(round(amount), to), nil
+}
+
+func round(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	den := r.Denom()
+
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(den) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+
+	if r.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+
+	return quotient.Int64()
+}
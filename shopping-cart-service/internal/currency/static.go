@@ -0,0 +1,68 @@
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// StaticProvider serves rates from a fixed table relative to a base currency, read from a JSON
+// file such as {"base": "USD", "rates": {"EUR": "0.92", "UAH": "41.50"}}. Cross rates are
+// derived through the base.
+type StaticProvider struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+type rateTable struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+func NewStaticProvider(path string) (*StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var table rateTable
+	if err = json.Unmarshal(data, &table); err != nil {
+		return nil, err
+	}
+
+	if table.Base == "" {
+		return nil, fmt.Errorf("rate table %s has no base currency", path)
+	}
+
+	rates := map[string]*big.Rat{
+		table.Base: big.NewRat(1, 1),
+	}
+	for currency, value := range table.Rates {
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s", value, currency)
+		}
+		rates[currency] = rate
+	}
+
+	return &StaticProvider{
+		base:  table.Base,
+		rates: rates,
+	}, nil
+}
+
+func (p *StaticProvider) Rate(_ context.Context, from, to string) (*big.Rat, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRate, from)
+	}
+
+	toRate, ok := p.rates[to]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRate, to)
+	}
+
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
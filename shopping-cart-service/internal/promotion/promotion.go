@@ -0,0 +1,119 @@
+package promotion
+
+import (
+	"errors"
+	"shared/money"
+	"time"
+)
+
+var (
+	ErrCouponInactive    = errors.New("coupon is not valid at this time")
+	ErrUsageLimitReached = errors.New("coupon usage limit reached")
+	ErrMinBasketNotMet   = errors.New("basket is below the coupon minimum")
+	ErrNotApplicable     = errors.New("coupon does not apply to this cart")
+)
+
+type Type int
+
+const (
+	Percentage Type = iota
+	FixedAmount
+	BuyXGetY
+	FreeShipping
+)
+
+// Coupon is a promotion as the discount engine sees it. AmountOff and MinBasket must be in
+// the currency of the cart being evaluated. Limits of zero mean unlimited.
+type Coupon struct {
+	Code            string
+	Type            Type
+	PercentOff      int32
+	AmountOff       money.Money
+	MinBasket       money.Money
+	BuySku          string
+	BuyQuantity     int32
+	GetQuantity     int32
+	UsageLimit      int32
+	PerUserLimit    int32
+	TimesRedeemed   int32
+	UserRedemptions int32
+	StartsAt        *time.Time
+	EndsAt          *time.Time
+}
+
+type Line struct {
+	Sku      string
+	Quantity int32
+	Price    money.Money
+}
+
+type Discount struct {
+	Amount       money.Money
+	FreeShipping bool
+}
+
+// IsRejection reports whether err means the coupon can't be used on the cart, as opposed
+// to a failure to evaluate it.
+func IsRejection(err error) bool {
+	return errors.Is(err, ErrCouponInactive) ||
+		errors.Is(err, ErrUsageLimitReached) ||
+		errors.Is(err, ErrMinBasketNotMet) ||
+		errors.Is(err, ErrNotApplicable)
+}
+
+// Evaluate computes the discount a coupon gives on a cart. Lines and subtotal share the
+// cart currency, and the discount never exceeds the subtotal.
+func Evaluate(coupon *Coupon, lines []Line, subtotal money.Money, now time.Time) (Discount, error) {
+	discount := Discount{Amount: money.New(0, subtotal.Currency)}
+
+	if (coupon.StartsAt != nil && now.Before(*coupon.StartsAt)) || (coupon.EndsAt != nil && !now.Before(*coupon.EndsAt)) {
+		return Discount{}, ErrCouponInactive
+	}
+
+	if (coupon.UsageLimit > 0 && coupon.TimesRedeemed >= coupon.UsageLimit) ||
+		(coupon.PerUserLimit > 0 && coupon.UserRedemptions >= coupon.PerUserLimit) {
+		return Discount{}, ErrUsageLimitReached
+	}
+
+	if len(lines) == 0 {
+		return Discount{}, ErrNotApplicable
+	}
+
+	if subtotal.Amount < coupon.MinBasket.Amount {
+		return Discount{}, ErrMinBasketNotMet
+	}
+
+	switch coupon.Type {
+	case Percentage:
+		discount.Amount.Amount = (subtotal.Amount*int64(coupon.PercentOff) + 50) / 100
+	case FixedAmount:
+		discount.Amount.Amount = min(coupon.AmountOff.Amount, subtotal.Amount)
+	case BuyXGetY:
+		free := freeUnits(coupon, lines)
+		if free.Amount == 0 {
+			return Discount{}, ErrNotApplicable
+		}
+		discount.Amount = free
+	case FreeShipping:
+		discount.FreeShipping = true
+	default:
+		return Discount{}, ErrNotApplicable
+	}
+
+	return discount, nil
+}
+
+// freeUnits prices the units of the coupon SKU that come free: every group of
+// BuyQuantity+GetQuantity units pays for BuyQuantity of them.
+func freeUnits(coupon *Coupon, lines []Line) money.Money {
+	group := coupon.BuyQuantity + coupon.GetQuantity
+	for _, line := range lines {
+		if line.Sku != coupon.BuySku || group <= 0 {
+			continue
+		}
+
+		return line.Price.Mul(int64(line.Quantity / group * coupon.GetQuantity))
+	}
+
+	return money.Money{}
+}
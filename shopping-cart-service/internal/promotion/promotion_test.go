@@ -0,0 +1,182 @@
+package promotion
+
+import (
+	"errors"
+	"fmt"
+	"shared/money"
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+
+	lines := []Line{
+		{Sku: "A", Quantity: 7, Price: money.New(300, "EUR")},
+		{Sku: "B", Quantity: 1, Price: money.New(499, "EUR")},
+	}
+
+	tests := []struct {
+		name     string
+		coupon   Coupon
+		lines    []Line
+		subtotal int64
+		want     Discount
+		wantErr  error
+	}{
+		{
+			name:     "percentage",
+			coupon:   Coupon{Type: Percentage, PercentOff: 10},
+			subtotal: 1999,
+			want:     Discount{Amount: money.New(200, "EUR")},
+		},
+		{
+			name:     "percentage rounds half up",
+			coupon:   Coupon{Type: Percentage, PercentOff: 15},
+			subtotal: 333,
+			want:     Discount{Amount: money.New(50, "EUR")},
+		},
+		{
+			name:     "full percentage",
+			coupon:   Coupon{Type: Percentage, PercentOff: 100},
+			subtotal: 2599,
+			want:     Discount{Amount: money.New(2599, "EUR")},
+		},
+		{
+			name:     "fixed amount",
+			coupon:   Coupon{Type: FixedAmount, AmountOff: money.New(500, "EUR")},
+			subtotal: 2000,
+			want:     Discount{Amount: money.New(500, "EUR")},
+		},
+		{
+			name:     "fixed amount capped at subtotal",
+			coupon:   Coupon{Type: FixedAmount, AmountOff: money.New(5000, "EUR")},
+			subtotal: 2000,
+			want:     Discount{Amount: money.New(2000, "EUR")},
+		},
+		{
+			name:     "buy two get one",
+			coupon:   Coupon{Type: BuyXGetY, BuySku: "A", BuyQuantity: 2, GetQuantity: 1},
+			subtotal: 2599,
+			want:     Discount{Amount: money.New(600, "EUR")},
+		},
+		{
+			name:     "buy x get y short of a group",
+			coupon:   Coupon{Type: BuyXGetY, BuySku: "A", BuyQuantity: 7, GetQuantity: 1},
+			subtotal: 2599,
+			wantErr:  ErrNotApplicable,
+		},
+		{
+			name:     "buy x get y without the sku",
+			coupon:   Coupon{Type: BuyXGetY, BuySku: "C", BuyQuantity: 1, GetQuantity: 1},
+			subtotal: 2599,
+			wantErr:  ErrNotApplicable,
+		},
+		{
+			name:     "free shipping",
+			coupon:   Coupon{Type: FreeShipping},
+			subtotal: 2599,
+			want:     Discount{Amount: money.New(0, "EUR"), FreeShipping: true},
+		},
+		{
+			name:     "unknown type",
+			coupon:   Coupon{Type: Type(99)},
+			subtotal: 2599,
+			wantErr:  ErrNotApplicable,
+		},
+		{
+			name:     "empty cart",
+			coupon:   Coupon{Type: FreeShipping},
+			lines:    []Line{},
+			subtotal: 0,
+			wantErr:  ErrNotApplicable,
+		},
+		{
+			name:     "starts now",
+			coupon:   Coupon{Type: FreeShipping, StartsAt: &now, EndsAt: &later},
+			subtotal: 2599,
+			want:     Discount{Amount: money.New(0, "EUR"), FreeShipping: true},
+		},
+		{
+			name:     "not started",
+			coupon:   Coupon{Type: FreeShipping, StartsAt: &later},
+			subtotal: 2599,
+			wantErr:  ErrCouponInactive,
+		},
+		{
+			name:     "ends now",
+			coupon:   Coupon{Type: FreeShipping, StartsAt: &earlier, EndsAt: &now},
+			subtotal: 2599,
+			wantErr:  ErrCouponInactive,
+		},
+		{
+			name:     "usage limit reached",
+			coupon:   Coupon{Type: FreeShipping, UsageLimit: 5, TimesRedeemed: 5},
+			subtotal: 2599,
+			wantErr:  ErrUsageLimitReached,
+		},
+		{
+			name:     "usage limit left",
+			coupon:   Coupon{Type: FreeShipping, UsageLimit: 5, TimesRedeemed: 4},
+			subtotal: 2599,
+			want:     Discount{Amount: money.New(0, "EUR"), FreeShipping: true},
+		},
+		{
+			name:     "per user limit reached",
+			coupon:   Coupon{Type: FreeShipping, PerUserLimit: 1, UserRedemptions: 1},
+			subtotal: 2599,
+			wantErr:  ErrUsageLimitReached,
+		},
+		{
+			name:     "below minimum basket",
+			coupon:   Coupon{Type: Percentage, PercentOff: 10, MinBasket: money.New(2600, "EUR")},
+			subtotal: 2599,
+			wantErr:  ErrMinBasketNotMet,
+		},
+		{
+			name:     "at minimum basket",
+			coupon:   Coupon{Type: Percentage, PercentOff: 10, MinBasket: money.New(2599, "EUR")},
+			subtotal: 2599,
+			want:     Discount{Amount: money.New(260, "EUR")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cartLines := tt.lines
+			if cartLines == nil {
+				cartLines = lines
+			}
+
+			got, err := Evaluate(&tt.coupon, cartLines, money.New(tt.subtotal, "EUR"), now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Evaluate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got != tt.want {
+				t.Errorf("Evaluate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRejection(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: ErrCouponInactive, want: true},
+		{err: ErrUsageLimitReached, want: true},
+		{err: ErrMinBasketNotMet, want: true},
+		{err: fmt.Errorf("coupon SAVE10: %w", ErrNotApplicable), want: true},
+		{err: errors.New("connection refused"), want: false},
+		{err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRejection(tt.err); got != tt.want {
+			t.Errorf("IsRejection(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -2,85 +2,103 @@ package server
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"errors"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"log"
+	"shared/money"
 	"shopping-cart-service/internal/cart"
+	"shopping-cart-service/internal/currency"
+	"shopping-cart-service/internal/promotion"
 	pb "shopping-cart-service/protobuf"
 )
 
+const cartTokenHeader = "x-cart-token"
+
 type Server struct {
 	pb.UnimplementedShoppingCartServiceServer
 	Cart *cart.ShoppingCart
 }
 
 func (s *Server) GetCart(ctx context.Context, _ *pb.GetCartRequest) (*pb.GetCartResponse, error) {
-	userID, ok := ctx.Value("user-id").(int)
-	if !ok {
-		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	userIDInt := int64(userID)
-
-	resItems, totalPrice, totalItems, err := s.Cart.GetCart(ctx, userIDInt)
+	shoppingCart, err := s.Cart.GetCart(ctx, cartID)
 	if err != nil {
+		if errors.Is(err, currency.ErrUnknownRate) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	items := make([]*pb.CartItem, 0, len(resItems))
-	for sku, details := range resItems {
-		var jsonMap cart.Item
-		err = json.Unmarshal([]byte(details), &jsonMap)
-		if err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal cart item: %v", err))
-		}
-
+	items := make([]*pb.CartItem, 0, len(shoppingCart.Items))
+	for sku, item := range shoppingCart.Items {
 		items = append(items, &pb.CartItem{
-			Quantity:       jsonMap.Quantity,
-			Price:          jsonMap.Price,
+			Quantity:       item.Quantity,
+			Price:          moneyToPB(item.Price),
 			Sku:            sku,
-			Name:           jsonMap.Name,
-			ImageUrl:       jsonMap.ImageURL,
-			ItemTotalPrice: jsonMap.ItemTotalPrice,
+			ProductId:      item.ProductID,
+			Name:           item.Name,
+			ImageUrl:       item.ImageURL,
+			ItemTotalPrice: moneyToPB(item.ItemTotalPrice),
 		})
 	}
 
 	return &pb.GetCartResponse{
-		Items:      items,
-		TotalPrice: totalPrice,
-		TotalItems: totalItems,
+		Items:        items,
+		TotalPrice:   moneyToPB(shoppingCart.Total),
+		TotalItems:   shoppingCart.TotalItems,
+		Currency:     shoppingCart.Total.Currency,
+		Subtotal:     moneyToPB(shoppingCart.Subtotal),
+		Discount:     moneyToPB(shoppingCart.Discount),
+		CouponCode:   shoppingCart.CouponCode,
+		FreeShipping: shoppingCart.FreeShipping,
 	}, nil
 }
 
 func (s *Server) AddItem(ctx context.Context, r *pb.AddItemRequest) (*pb.AddItemResponse, error) {
-	userID, ok := ctx.Value("user-id").(int)
-	if !ok {
-		return nil, status.Error(codes.FailedPrecondition, "user id missing") // return FAILED_PRECONDITION status here as the system should never get into this state
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	userIDInt := int64(userID)
-
-	err := s.Cart.AddItem(ctx, userIDInt, r.GetQuantity(), r.GetSku())
+	item, err := s.Cart.AddItem(ctx, cartID, r.GetQuantity(), r.GetSku())
 	if err != nil {
+		if st := cartError(err); st != nil {
+			return nil, st
+		}
 		log.Println(err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.AddItemResponse{}, nil
+	return &pb.AddItemResponse{
+		Item: &pb.CartItem{
+			Quantity:       item.Quantity,
+			Price:          moneyToPB(item.Price),
+			Sku:            r.GetSku(),
+			ProductId:      item.ProductID,
+			Name:           item.Name,
+			ImageUrl:       item.ImageURL,
+			ItemTotalPrice: moneyToPB(item.ItemTotalPrice),
+		},
+	}, nil
 }
 
 func (s *Server) UpdateItem(ctx context.Context, r *pb.UpdateItemRequest) (*pb.UpdateItemResponse, error) {
-	userID, ok := ctx.Value("user-id").(int)
-	if !ok {
-		return nil, status.Error(codes.FailedPrecondition, "user id missing") // return FAILED_PRECONDITION status here as the system should never get into this state
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	userIDInt := int64(userID)
-
-	err := s.Cart.UpdateItemQuantity(ctx, userIDInt, r.GetQuantity(), r.GetSku())
+	err = s.Cart.UpdateItemQuantity(ctx, cartID, r.GetQuantity(), r.GetSku())
 	if err != nil {
+		if st := cartError(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -88,27 +106,209 @@ func (s *Server) UpdateItem(ctx context.Context, r *pb.UpdateItemRequest) (*pb.U
 }
 
 func (s *Server) RemoveItem(ctx context.Context, r *pb.RemoveItemRequest) (*pb.RemoveItemResponse, error) {
-	userID, ok := ctx.Value("user-id").(int)
-	if !ok {
-		return nil, status.Error(codes.FailedPrecondition, "user id missing") // return FAILED_PRECONDITION status here as the system should never get into this state
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	userIDInt := int64(userID)
-
-	s.Cart.RemoveItem(ctx, userIDInt, r.GetSku())
+	s.Cart.RemoveItem(ctx, cartID, r.GetSku())
 
 	return &pb.RemoveItemResponse{}, nil
 }
 
+func (s *Server) SetCartCurrency(ctx context.Context, r *pb.SetCartCurrencyRequest) (*pb.SetCartCurrencyResponse, error) {
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Cart.SetCurrency(ctx, cartID, r.GetCurrency())
+	if err != nil {
+		if errors.Is(err, cart.ErrUnsupportedCurrency) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetCartCurrencyResponse{}, nil
+}
+
 func (s *Server) ClearCart(ctx context.Context, _ *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Cart.ClearCart(ctx, cartID)
+
+	return &pb.ClearCartResponse{}, nil
+}
+
+func (s *Server) ApplyCoupon(ctx context.Context, r *pb.ApplyCouponRequest) (*pb.ApplyCouponResponse, error) {
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := s.Cart.ApplyCoupon(ctx, cartID, r.GetCode())
+	if err != nil {
+		switch {
+		case errors.Is(err, cart.ErrCouponNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case promotion.IsRejection(err), errors.Is(err, currency.ErrUnknownRate):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ApplyCouponResponse{
+		Discount:     moneyToPB(discount.Amount),
+		FreeShipping: discount.FreeShipping,
+	}, nil
+}
+
+func (s *Server) RemoveCoupon(ctx context.Context, _ *pb.RemoveCouponRequest) (*pb.RemoveCouponResponse, error) {
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Cart.RemoveCoupon(ctx, cartID)
+
+	return &pb.RemoveCouponResponse{}, nil
+}
+
+func (s *Server) ValidateCart(ctx context.Context, _ *pb.ValidateCartRequest) (*pb.ValidateCartResponse, error) {
+	cartID, err := cartIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := s.Cart.ValidateCart(ctx, cartID)
+	if err != nil {
+		if errors.Is(err, currency.ErrUnknownRate) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ValidateCartResponse{
+		Valid:    len(warnings) == 0,
+		Warnings: warningsToPB(warnings),
+	}, nil
+}
+
+func warningsToPB(warnings []cart.Warning) []*pb.CartWarning {
+	result := make([]*pb.CartWarning, len(warnings))
+	for i, warning := range warnings {
+		result[i] = &pb.CartWarning{
+			Sku:               warning.Sku,
+			Type:              pb.CartWarningType(warning.Kind),
+			AvailableQuantity: warning.AvailableQuantity,
+			Message:           warning.Message,
+		}
+		if warning.Kind == cart.PriceChanged {
+			result[i].PreviousPrice = moneyToPB(warning.PreviousPrice)
+			result[i].CurrentPrice = moneyToPB(warning.CurrentPrice)
+		}
+	}
+
+	return result
+}
+
+func (s *Server) CreateGuestCart(_ context.Context, _ *pb.CreateGuestCartRequest) (*pb.CreateGuestCartResponse, error) {
+	token, err := cart.NewGuestToken()
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CreateGuestCartResponse{CartToken: token}, nil
+}
+
+func (s *Server) MergeCart(ctx context.Context, r *pb.MergeCartRequest) (*pb.MergeCartResponse, error) {
 	userID, ok := ctx.Value("user-id").(int)
 	if !ok {
 		return nil, status.Error(codes.FailedPrecondition, "user id missing")
 	}
 
-	userIDInt := int64(userID)
+	if !cart.ValidGuestToken(r.GetCartToken()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid cart token")
+	}
+
+	strategy, err := mergeStrategyFromPB(r.GetStrategy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	warnings, err := s.Cart.MergeCart(ctx, cart.GuestCartID(r.GetCartToken()), cart.UserCartID(int64(userID)), strategy)
+	if err != nil {
+		if errors.Is(err, cart.ErrCartBusy) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MergeCartResponse{Warnings: warningsToPB(warnings)}, nil
+}
 
-	s.Cart.ClearCart(ctx, userIDInt)
+// cartIDFromContext resolves the cart a request addresses: the signed-in user's cart, or
+// for anonymous requests the guest cart named by the x-cart-token metadata.
+func cartIDFromContext(ctx context.Context) (string, error) {
+	if userID, ok := ctx.Value("user-id").(int); ok {
+		return cart.UserCartID(int64(userID)), nil
+	}
 
-	return &pb.ClearCartResponse{}, nil
+	mt, _ := metadata.FromIncomingContext(ctx)
+	tokens := mt.Get(cartTokenHeader)
+	if len(tokens) == 0 {
+		return "", status.Error(codes.Unauthenticated, "bearer token or cart token required")
+	}
+
+	if !cart.ValidGuestToken(tokens[0]) {
+		return "", status.Error(codes.InvalidArgument, "invalid cart token")
+	}
+
+	return cart.GuestCartID(tokens[0]), nil
+}
+
+// cartError maps the errors of item mutations that the caller can act on.
+func cartError(err error) error {
+	switch {
+	case errors.Is(err, cart.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, cart.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, cart.ErrItemNotFound), errors.Is(err, cart.ErrProductUnavailable), status.Code(err) == codes.NotFound:
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return nil
+}
+
+func mergeStrategyFromPB(strategy pb.MergeStrategy) (cart.MergeStrategy, error) {
+	switch strategy {
+	case pb.MergeStrategy_MERGE_DEFAULT:
+		return "", nil
+	case pb.MergeStrategy_MERGE_SUM:
+		return cart.MergeSum, nil
+	case pb.MergeStrategy_MERGE_KEEP_USER:
+		return cart.MergeKeepUser, nil
+	case pb.MergeStrategy_MERGE_KEEP_GUEST:
+		return cart.MergeKeepGuest, nil
+	case pb.MergeStrategy_MERGE_MAX:
+		return cart.MergeMax, nil
+	}
+
+	return "", cart.ErrInvalidMergeStrategy
+}
+
+func moneyToPB(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
 }
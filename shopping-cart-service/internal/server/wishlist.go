@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"shopping-cart-service/internal/cart"
+	"shopping-cart-service/internal/currency"
+	pb "shopping-cart-service/protobuf"
+)
+
+func (s *Server) CreateWishlist(ctx context.Context, r *pb.CreateWishlistRequest) (*pb.CreateWishlistResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	wishlist, err := s.Cart.CreateWishlist(ctx, int64(userID), r.GetName())
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CreateWishlistResponse{Wishlist: wishlistToPB(wishlist)}, nil
+}
+
+func (s *Server) ListWishlists(ctx context.Context, _ *pb.ListWishlistsRequest) (*pb.ListWishlistsResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	wishlists, err := s.Cart.ListWishlists(ctx, int64(userID))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*pb.Wishlist, len(wishlists))
+	for i, wishlist := range wishlists {
+		result[i] = wishlistToPB(wishlist)
+	}
+
+	return &pb.ListWishlistsResponse{Wishlists: result}, nil
+}
+
+func (s *Server) GetWishlist(ctx context.Context, r *pb.GetWishlistRequest) (*pb.GetWishlistResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	wishlist, items, err := s.Cart.GetWishlist(ctx, int64(userID), r.GetWishlistId())
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*pb.WishlistItem, len(items))
+	for i, item := range items {
+		result[i] = &pb.WishlistItem{
+			Sku:           item.Sku,
+			Quantity:      item.Quantity,
+			Name:          item.Name,
+			ImageUrl:      item.ImageURL,
+			StockQuantity: item.StockQuantity,
+			InStock:       item.InStock,
+			Unavailable:   item.Unavailable,
+			AddedAt:       timestamppb.New(item.AddedAt),
+		}
+		if !item.Unavailable {
+			result[i].Price = moneyToPB(item.Price)
+		}
+	}
+
+	return &pb.GetWishlistResponse{
+		Wishlist: wishlistToPB(wishlist),
+		Items:    result,
+	}, nil
+}
+
+func (s *Server) DeleteWishlist(ctx context.Context, r *pb.DeleteWishlistRequest) (*pb.DeleteWishlistResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	err := s.Cart.DeleteWishlist(ctx, int64(userID), r.GetWishlistId())
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DeleteWishlistResponse{}, nil
+}
+
+// AddWishlistItem saves a single unit when no quantity is given.
+func (s *Server) AddWishlistItem(ctx context.Context, r *pb.AddWishlistItemRequest) (*pb.AddWishlistItemResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	quantity := r.GetQuantity()
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	err := s.Cart.AddWishlistItem(ctx, int64(userID), r.GetWishlistId(), r.GetSku(), quantity)
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AddWishlistItemResponse{}, nil
+}
+
+func (s *Server) RemoveWishlistItem(ctx context.Context, r *pb.RemoveWishlistItemRequest) (*pb.RemoveWishlistItemResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	err := s.Cart.RemoveWishlistItem(ctx, int64(userID), r.GetWishlistId(), r.GetSku())
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RemoveWishlistItemResponse{}, nil
+}
+
+func (s *Server) MoveWishlistItemToCart(ctx context.Context, r *pb.MoveWishlistItemToCartRequest) (*pb.MoveWishlistItemToCartResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	err := s.Cart.MoveWishlistItemToCart(ctx, int64(userID), r.GetWishlistId(), r.GetSku())
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MoveWishlistItemToCartResponse{}, nil
+}
+
+func (s *Server) SaveForLater(ctx context.Context, r *pb.SaveForLaterRequest) (*pb.SaveForLaterResponse, error) {
+	userID, ok := ctx.Value("user-id").(int)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "user id missing")
+	}
+
+	err := s.Cart.SaveForLater(ctx, int64(userID), r.GetWishlistId(), r.GetSku())
+	if err != nil {
+		if st := wishlistError(err); st != nil {
+			return nil, st
+		}
+		log.Println(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SaveForLaterResponse{}, nil
+}
+
+func wishlistError(err error) error {
+	switch {
+	case errors.Is(err, cart.ErrWishlistNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, cart.ErrInvalidWishlistName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, currency.ErrUnknownRate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return cartError(err)
+}
+
+func wishlistToPB(wishlist *cart.Wishlist) *pb.Wishlist {
+	return &pb.Wishlist{
+		Id:         wishlist.ID,
+		Name:       wishlist.Name,
+		TotalItems: wishlist.TotalItems,
+		CreatedAt:  timestamppb.New(wishlist.CreatedAt),
+	}
+}
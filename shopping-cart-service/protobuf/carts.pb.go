@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,14 +23,125 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CartWarningType int32
+
+const (
+	CartWarningType_PRICE_CHANGED      CartWarningType = 0
+	CartWarningType_OUT_OF_STOCK       CartWarningType = 1
+	CartWarningType_INSUFFICIENT_STOCK CartWarningType = 2
+	CartWarningType_PRODUCT_REMOVED    CartWarningType = 3
+	CartWarningType_COUPON_INVALID     CartWarningType = 4
+)
+
+// Enum value maps for CartWarningType.
+var (
+	CartWarningType_name = map[int32]string{
+		0: "PRICE_CHANGED",
+		1: "OUT_OF_STOCK",
+		2: "INSUFFICIENT_STOCK",
+		3: "PRODUCT_REMOVED",
+		4: "COUPON_INVALID",
+	}
+	CartWarningType_value = map[string]int32{
+		"PRICE_CHANGED":      0,
+		"OUT_OF_STOCK":       1,
+		"INSUFFICIENT_STOCK": 2,
+		"PRODUCT_REMOVED":    3,
+		"COUPON_INVALID":     4,
+	}
+)
+
+func (x CartWarningType) Enum() *CartWarningType {
+	p := new(CartWarningType)
+	*p = x
+	return p
+}
+
+func (x CartWarningType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CartWarningType) Descriptor() protoreflect.EnumDescriptor {
+	return file_carts_proto_enumTypes[0].Descriptor()
+}
+
+func (CartWarningType) Type() protoreflect.EnumType {
+	return &file_carts_proto_enumTypes[0]
+}
+
+func (x CartWarningType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CartWarningType.Descriptor instead.
+func (CartWarningType) EnumDescriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{0}
+}
+
+type MergeStrategy int32
+
+const (
+	MergeStrategy_MERGE_DEFAULT    MergeStrategy = 0
+	MergeStrategy_MERGE_SUM        MergeStrategy = 1
+	MergeStrategy_MERGE_KEEP_USER  MergeStrategy = 2
+	MergeStrategy_MERGE_KEEP_GUEST MergeStrategy = 3
+	MergeStrategy_MERGE_MAX        MergeStrategy = 4
+)
+
+// Enum value maps for MergeStrategy.
+var (
+	MergeStrategy_name = map[int32]string{
+		0: "MERGE_DEFAULT",
+		1: "MERGE_SUM",
+		2: "MERGE_KEEP_USER",
+		3: "MERGE_KEEP_GUEST",
+		4: "MERGE_MAX",
+	}
+	MergeStrategy_value = map[string]int32{
+		"MERGE_DEFAULT":    0,
+		"MERGE_SUM":        1,
+		"MERGE_KEEP_USER":  2,
+		"MERGE_KEEP_GUEST": 3,
+		"MERGE_MAX":        4,
+	}
+)
+
+func (x MergeStrategy) Enum() *MergeStrategy {
+	p := new(MergeStrategy)
+	*p = x
+	return p
+}
+
+func (x MergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_carts_proto_enumTypes[1].Descriptor()
+}
+
+func (MergeStrategy) Type() protoreflect.EnumType {
+	return &file_carts_proto_enumTypes[1]
+}
+
+func (x MergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeStrategy.Descriptor instead.
+func (MergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{1}
+}
+
 type CartItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Sku            string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
 	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price          *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 	ImageUrl       string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	ItemTotalPrice float64                `protobuf:"fixed64,6,opt,name=item_total_price,json=itemTotalPrice,proto3" json:"item_total_price,omitempty"`
+	ItemTotalPrice *Money                 `protobuf:"bytes,6,opt,name=item_total_price,json=itemTotalPrice,proto3" json:"item_total_price,omitempty"`
+	ProductId      string                 `protobuf:"bytes,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -85,11 +197,11 @@ func (x *CartItem) GetName() string {
 	return ""
 }
 
-func (x *CartItem) GetPrice() float64 {
+func (x *CartItem) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *CartItem) GetImageUrl() string {
@@ -99,11 +211,18 @@ func (x *CartItem) GetImageUrl() string {
 	return ""
 }
 
-func (x *CartItem) GetItemTotalPrice() float64 {
+func (x *CartItem) GetItemTotalPrice() *Money {
 	if x != nil {
 		return x.ItemTotalPrice
 	}
-	return 0
+	return nil
+}
+
+func (x *CartItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
 }
 
 type GetCartRequest struct {
@@ -143,10 +262,16 @@ func (*GetCartRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Amount due: the subtotal less the coupon discount.
+	TotalPrice    *Money `protobuf:"bytes,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	TotalItems    int32  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	Currency      string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Subtotal      *Money `protobuf:"bytes,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount      *Money `protobuf:"bytes,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	CouponCode    string `protobuf:"bytes,7,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	FreeShipping  bool   `protobuf:"varint,8,opt,name=free_shipping,json=freeShipping,proto3" json:"free_shipping,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,11 +313,11 @@ func (x *GetCartResponse) GetItems() []*CartItem {
 	return nil
 }
 
-func (x *GetCartResponse) GetTotalPrice() float64 {
+func (x *GetCartResponse) GetTotalPrice() *Money {
 	if x != nil {
 		return x.TotalPrice
 	}
-	return 0
+	return nil
 }
 
 func (x *GetCartResponse) GetTotalItems() int32 {
@@ -202,6 +327,41 @@ func (x *GetCartResponse) GetTotalItems() int32 {
 	return 0
 }
 
+func (x *GetCartResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetCartResponse) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *GetCartResponse) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *GetCartResponse) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *GetCartResponse) GetFreeShipping() bool {
+	if x != nil {
+		return x.FreeShipping
+	}
+	return false
+}
+
 type AddItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
@@ -255,7 +415,9 @@ func (x *AddItemRequest) GetQuantity() int32 {
 }
 
 type AddItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The line as it now is in the cart, with the added quantity and in the cart currency.
+	Item          *CartItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -290,6 +452,13 @@ func (*AddItemResponse) Descriptor() ([]byte, []int) {
 	return file_carts_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *AddItemResponse) GetItem() *CartItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
 type UpdateItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
@@ -458,6 +627,86 @@ func (*RemoveItemResponse) Descriptor() ([]byte, []int) {
 	return file_carts_proto_rawDescGZIP(), []int{8}
 }
 
+type SetCartCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCartCurrencyRequest) Reset() {
+	*x = SetCartCurrencyRequest{}
+	mi := &file_carts_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCartCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCartCurrencyRequest) ProtoMessage() {}
+
+func (x *SetCartCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCartCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*SetCartCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetCartCurrencyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SetCartCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCartCurrencyResponse) Reset() {
+	*x = SetCartCurrencyResponse{}
+	mi := &file_carts_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCartCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCartCurrencyResponse) ProtoMessage() {}
+
+func (x *SetCartCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCartCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*SetCartCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{10}
+}
+
 type ClearCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -466,7 +715,7 @@ type ClearCartRequest struct {
 
 func (x *ClearCartRequest) Reset() {
 	*x = ClearCartRequest{}
-	mi := &file_carts_proto_msgTypes[9]
+	mi := &file_carts_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -478,7 +727,7 @@ func (x *ClearCartRequest) String() string {
 func (*ClearCartRequest) ProtoMessage() {}
 
 func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_carts_proto_msgTypes[9]
+	mi := &file_carts_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +740,7 @@ func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
 func (*ClearCartRequest) Descriptor() ([]byte, []int) {
-	return file_carts_proto_rawDescGZIP(), []int{9}
+	return file_carts_proto_rawDescGZIP(), []int{11}
 }
 
 type ClearCartResponse struct {
@@ -502,7 +751,7 @@ type ClearCartResponse struct {
 
 func (x *ClearCartResponse) Reset() {
 	*x = ClearCartResponse{}
-	mi := &file_carts_proto_msgTypes[10]
+	mi := &file_carts_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +763,7 @@ func (x *ClearCartResponse) String() string {
 func (*ClearCartResponse) ProtoMessage() {}
 
 func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_carts_proto_msgTypes[10]
+	mi := &file_carts_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,49 +776,1571 @@ func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
 func (*ClearCartResponse) Descriptor() ([]byte, []int) {
-	return file_carts_proto_rawDescGZIP(), []int{10}
+	return file_carts_proto_rawDescGZIP(), []int{12}
 }
 
-var File_carts_proto protoreflect.FileDescriptor
+type ApplyCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_carts_proto_rawDesc = "" +
-	"\n" +
-	"\vcarts.proto\x12\x04cart\x1a\x1cgoogle/api/annotations.proto\"\xa9\x01\n" +
-	"\bCartItem\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12(\n" +
-	"\x10item_total_price\x18\x06 \x01(\x01R\x0eitemTotalPrice\"\x10\n" +
-	"\x0eGetCartRequest\"y\n" +
-	"\x0fGetCartResponse\x12$\n" +
-	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x02 \x01(\x01R\n" +
-	"totalPrice\x12\x1f\n" +
-	"\vtotal_items\x18\x03 \x01(\x05R\n" +
-	"totalItems\">\n" +
-	"\x0eAddItemRequest\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x11\n" +
-	"\x0fAddItemResponse\"A\n" +
-	"\x11UpdateItemRequest\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x14\n" +
-	"\x12UpdateItemResponse\"%\n" +
-	"\x11RemoveItemRequest\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\"\x14\n" +
-	"\x12RemoveItemResponse\"\x12\n" +
-	"\x10ClearCartRequest\"\x13\n" +
-	"\x11ClearCartResponse2\xe5\x03\n" +
+func (x *ApplyCouponRequest) Reset() {
+	*x = ApplyCouponRequest{}
+	mi := &file_carts_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponRequest) ProtoMessage() {}
+
+func (x *ApplyCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCouponRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ApplyCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discount      *Money                 `protobuf:"bytes,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	FreeShipping  bool                   `protobuf:"varint,2,opt,name=free_shipping,json=freeShipping,proto3" json:"free_shipping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCouponResponse) Reset() {
+	*x = ApplyCouponResponse{}
+	mi := &file_carts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponResponse) ProtoMessage() {}
+
+func (x *ApplyCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCouponResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApplyCouponResponse) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ApplyCouponResponse) GetFreeShipping() bool {
+	if x != nil {
+		return x.FreeShipping
+	}
+	return false
+}
+
+type RemoveCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCouponRequest) Reset() {
+	*x = RemoveCouponRequest{}
+	mi := &file_carts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponRequest) ProtoMessage() {}
+
+func (x *RemoveCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCouponRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{15}
+}
+
+type RemoveCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCouponResponse) Reset() {
+	*x = RemoveCouponResponse{}
+	mi := &file_carts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponResponse) ProtoMessage() {}
+
+func (x *RemoveCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCouponResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{16}
+}
+
+type CartWarning struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Type              CartWarningType        `protobuf:"varint,2,opt,name=type,proto3,enum=cart.CartWarningType" json:"type,omitempty"`
+	PreviousPrice     *Money                 `protobuf:"bytes,3,opt,name=previous_price,json=previousPrice,proto3" json:"previous_price,omitempty"`
+	CurrentPrice      *Money                 `protobuf:"bytes,4,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,5,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	Message           string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CartWarning) Reset() {
+	*x = CartWarning{}
+	mi := &file_carts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartWarning) ProtoMessage() {}
+
+func (x *CartWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartWarning.ProtoReflect.Descriptor instead.
+func (*CartWarning) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CartWarning) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CartWarning) GetType() CartWarningType {
+	if x != nil {
+		return x.Type
+	}
+	return CartWarningType_PRICE_CHANGED
+}
+
+func (x *CartWarning) GetPreviousPrice() *Money {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return nil
+}
+
+func (x *CartWarning) GetCurrentPrice() *Money {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return nil
+}
+
+func (x *CartWarning) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *CartWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCartRequest) Reset() {
+	*x = ValidateCartRequest{}
+	mi := &file_carts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCartRequest) ProtoMessage() {}
+
+func (x *ValidateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCartRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCartRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{18}
+}
+
+type ValidateCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Warnings      []*CartWarning         `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCartResponse) Reset() {
+	*x = ValidateCartResponse{}
+	mi := &file_carts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCartResponse) ProtoMessage() {}
+
+func (x *ValidateCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCartResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCartResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ValidateCartResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateCartResponse) GetWarnings() []*CartWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type CreateGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartRequest) Reset() {
+	*x = CreateGuestCartRequest{}
+	mi := &file_carts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartRequest) ProtoMessage() {}
+
+func (x *CreateGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{20}
+}
+
+type CreateGuestCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartResponse) Reset() {
+	*x = CreateGuestCartResponse{}
+	mi := &file_carts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartResponse) ProtoMessage() {}
+
+func (x *CreateGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateGuestCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+type MergeCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	Strategy      MergeStrategy          `protobuf:"varint,2,opt,name=strategy,proto3,enum=cart.MergeStrategy" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartRequest) Reset() {
+	*x = MergeCartRequest{}
+	mi := &file_carts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartRequest) ProtoMessage() {}
+
+func (x *MergeCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MergeCartRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *MergeCartRequest) GetStrategy() MergeStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return MergeStrategy_MERGE_DEFAULT
+}
+
+type MergeCartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Merged lines that were capped at the available stock, or left out because the
+	// product is out of stock.
+	Warnings      []*CartWarning `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartResponse) Reset() {
+	*x = MergeCartResponse{}
+	mi := &file_carts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartResponse) ProtoMessage() {}
+
+func (x *MergeCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartResponse.ProtoReflect.Descriptor instead.
+func (*MergeCartResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MergeCartResponse) GetWarnings() []*CartWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type SaveForLaterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	WishlistId    string                 `protobuf:"bytes,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveForLaterRequest) Reset() {
+	*x = SaveForLaterRequest{}
+	mi := &file_carts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveForLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveForLaterRequest) ProtoMessage() {}
+
+func (x *SaveForLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveForLaterRequest.ProtoReflect.Descriptor instead.
+func (*SaveForLaterRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SaveForLaterRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SaveForLaterRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+type SaveForLaterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveForLaterResponse) Reset() {
+	*x = SaveForLaterResponse{}
+	mi := &file_carts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveForLaterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveForLaterResponse) ProtoMessage() {}
+
+func (x *SaveForLaterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveForLaterResponse.ProtoReflect.Descriptor instead.
+func (*SaveForLaterResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{25}
+}
+
+type Wishlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wishlist) Reset() {
+	*x = Wishlist{}
+	mi := &file_carts_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wishlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wishlist) ProtoMessage() {}
+
+func (x *Wishlist) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wishlist.ProtoReflect.Descriptor instead.
+func (*Wishlist) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Wishlist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Wishlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Wishlist) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *Wishlist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// WishlistItem shows a saved product with its current catalog price, in the cart currency,
+// and stock.
+type WishlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	StockQuantity int32                  `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	InStock       bool                   `protobuf:"varint,7,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	// The product is no longer in the catalog; name and price are left empty.
+	Unavailable   bool                   `protobuf:"varint,8,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_carts_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WishlistItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *WishlistItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *WishlistItem) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *WishlistItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type CreateWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWishlistRequest) Reset() {
+	*x = CreateWishlistRequest{}
+	mi := &file_carts_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWishlistRequest) ProtoMessage() {}
+
+func (x *CreateWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWishlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateWishlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlist      *Wishlist              `protobuf:"bytes,1,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWishlistResponse) Reset() {
+	*x = CreateWishlistResponse{}
+	mi := &file_carts_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWishlistResponse) ProtoMessage() {}
+
+func (x *CreateWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWishlistResponse.ProtoReflect.Descriptor instead.
+func (*CreateWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+type ListWishlistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistsRequest) Reset() {
+	*x = ListWishlistsRequest{}
+	mi := &file_carts_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistsRequest) ProtoMessage() {}
+
+func (x *ListWishlistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistsRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistsRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{30}
+}
+
+type ListWishlistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlists     []*Wishlist            `protobuf:"bytes,1,rep,name=wishlists,proto3" json:"wishlists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistsResponse) Reset() {
+	*x = ListWishlistsResponse{}
+	mi := &file_carts_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistsResponse) ProtoMessage() {}
+
+func (x *ListWishlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistsResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistsResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListWishlistsResponse) GetWishlists() []*Wishlist {
+	if x != nil {
+		return x.Wishlists
+	}
+	return nil
+}
+
+type GetWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId    string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistRequest) Reset() {
+	*x = GetWishlistRequest{}
+	mi := &file_carts_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistRequest) ProtoMessage() {}
+
+func (x *GetWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetWishlistRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+type GetWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlist      *Wishlist              `protobuf:"bytes,1,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	Items         []*WishlistItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistResponse) Reset() {
+	*x = GetWishlistResponse{}
+	mi := &file_carts_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistResponse) ProtoMessage() {}
+
+func (x *GetWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+func (x *GetWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId    string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistRequest) Reset() {
+	*x = DeleteWishlistRequest{}
+	mi := &file_carts_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistRequest) ProtoMessage() {}
+
+func (x *DeleteWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteWishlistRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+type DeleteWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistResponse) Reset() {
+	*x = DeleteWishlistResponse{}
+	mi := &file_carts_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistResponse) ProtoMessage() {}
+
+func (x *DeleteWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{35}
+}
+
+type AddWishlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId    string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWishlistItemRequest) Reset() {
+	*x = AddWishlistItemRequest{}
+	mi := &file_carts_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWishlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWishlistItemRequest) ProtoMessage() {}
+
+func (x *AddWishlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWishlistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddWishlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddWishlistItemRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+func (x *AddWishlistItemRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AddWishlistItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AddWishlistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWishlistItemResponse) Reset() {
+	*x = AddWishlistItemResponse{}
+	mi := &file_carts_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWishlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWishlistItemResponse) ProtoMessage() {}
+
+func (x *AddWishlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWishlistItemResponse.ProtoReflect.Descriptor instead.
+func (*AddWishlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{37}
+}
+
+type RemoveWishlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId    string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWishlistItemRequest) Reset() {
+	*x = RemoveWishlistItemRequest{}
+	mi := &file_carts_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWishlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWishlistItemRequest) ProtoMessage() {}
+
+func (x *RemoveWishlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWishlistItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWishlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RemoveWishlistItemRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+func (x *RemoveWishlistItemRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type RemoveWishlistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWishlistItemResponse) Reset() {
+	*x = RemoveWishlistItemResponse{}
+	mi := &file_carts_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWishlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWishlistItemResponse) ProtoMessage() {}
+
+func (x *RemoveWishlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWishlistItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWishlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{39}
+}
+
+type MoveWishlistItemToCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId    string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistItemToCartRequest) Reset() {
+	*x = MoveWishlistItemToCartRequest{}
+	mi := &file_carts_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistItemToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistItemToCartRequest) ProtoMessage() {}
+
+func (x *MoveWishlistItemToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistItemToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveWishlistItemToCartRequest) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MoveWishlistItemToCartRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+func (x *MoveWishlistItemToCartRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type MoveWishlistItemToCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistItemToCartResponse) Reset() {
+	*x = MoveWishlistItemToCartResponse{}
+	mi := &file_carts_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistItemToCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistItemToCartResponse) ProtoMessage() {}
+
+func (x *MoveWishlistItemToCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistItemToCartResponse.ProtoReflect.Descriptor instead.
+func (*MoveWishlistItemToCartResponse) Descriptor() ([]byte, []int) {
+	return file_carts_proto_rawDescGZIP(), []int{41}
+}
+
+var File_carts_proto protoreflect.FileDescriptor
+
+const file_carts_proto_rawDesc = "" +
+	"\n" +
+	"\vcarts.proto\x12\x04cart\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vmoney.proto\"\xe4\x01\n" +
+	"\bCartItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x126\n" +
+	"\x10item_total_price\x18\x06 \x01(\v2\f.money.MoneyR\x0eitemTotalPrice\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\a \x01(\tR\tproductId\"\x10\n" +
+	"\x0eGetCartRequest\"\xbd\x02\n" +
+	"\x0fGetCartResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12-\n" +
+	"\vtotal_price\x18\x02 \x01(\v2\f.money.MoneyR\n" +
+	"totalPrice\x12\x1f\n" +
+	"\vtotal_items\x18\x03 \x01(\x05R\n" +
+	"totalItems\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12(\n" +
+	"\bsubtotal\x18\x05 \x01(\v2\f.money.MoneyR\bsubtotal\x12(\n" +
+	"\bdiscount\x18\x06 \x01(\v2\f.money.MoneyR\bdiscount\x12\x1f\n" +
+	"\vcoupon_code\x18\a \x01(\tR\n" +
+	"couponCode\x12#\n" +
+	"\rfree_shipping\x18\b \x01(\bR\ffreeShipping\">\n" +
+	"\x0eAddItemRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"5\n" +
+	"\x0fAddItemResponse\x12\"\n" +
+	"\x04item\x18\x01 \x01(\v2\x0e.cart.CartItemR\x04item\"A\n" +
+	"\x11UpdateItemRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x14\n" +
+	"\x12UpdateItemResponse\"%\n" +
+	"\x11RemoveItemRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"\x14\n" +
+	"\x12RemoveItemResponse\"4\n" +
+	"\x16SetCartCurrencyRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"\x19\n" +
+	"\x17SetCartCurrencyResponse\"\x12\n" +
+	"\x10ClearCartRequest\"\x13\n" +
+	"\x11ClearCartResponse\"(\n" +
+	"\x12ApplyCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"d\n" +
+	"\x13ApplyCouponResponse\x12(\n" +
+	"\bdiscount\x18\x01 \x01(\v2\f.money.MoneyR\bdiscount\x12#\n" +
+	"\rfree_shipping\x18\x02 \x01(\bR\ffreeShipping\"\x15\n" +
+	"\x13RemoveCouponRequest\"\x16\n" +
+	"\x14RemoveCouponResponse\"\xfb\x01\n" +
+	"\vCartWarning\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.cart.CartWarningTypeR\x04type\x123\n" +
+	"\x0eprevious_price\x18\x03 \x01(\v2\f.money.MoneyR\rpreviousPrice\x121\n" +
+	"\rcurrent_price\x18\x04 \x01(\v2\f.money.MoneyR\fcurrentPrice\x12-\n" +
+	"\x12available_quantity\x18\x05 \x01(\x05R\x11availableQuantity\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\x15\n" +
+	"\x13ValidateCartRequest\"[\n" +
+	"\x14ValidateCartResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12-\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x11.cart.CartWarningR\bwarnings\"\x18\n" +
+	"\x16CreateGuestCartRequest\"8\n" +
+	"\x17CreateGuestCartResponse\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\"b\n" +
+	"\x10MergeCartRequest\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\x12/\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x13.cart.MergeStrategyR\bstrategy\"B\n" +
+	"\x11MergeCartResponse\x12-\n" +
+	"\bwarnings\x18\x01 \x03(\v2\x11.cart.CartWarningR\bwarnings\"H\n" +
+	"\x13SaveForLaterRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\tR\n" +
+	"wishlistId\"\x16\n" +
+	"\x14SaveForLaterResponse\"\x8a\x01\n" +
+	"\bWishlist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vtotal_items\x18\x03 \x01(\x05R\n" +
+	"totalItems\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xac\x02\n" +
+	"\fWishlistItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12%\n" +
+	"\x0estock_quantity\x18\x06 \x01(\x05R\rstockQuantity\x12\x19\n" +
+	"\bin_stock\x18\a \x01(\bR\ainStock\x12 \n" +
+	"\vunavailable\x18\b \x01(\bR\vunavailable\x125\n" +
+	"\badded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"+\n" +
+	"\x15CreateWishlistRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x16CreateWishlistResponse\x12*\n" +
+	"\bwishlist\x18\x01 \x01(\v2\x0e.cart.WishlistR\bwishlist\"\x16\n" +
+	"\x14ListWishlistsRequest\"E\n" +
+	"\x15ListWishlistsResponse\x12,\n" +
+	"\twishlists\x18\x01 \x03(\v2\x0e.cart.WishlistR\twishlists\"5\n" +
+	"\x12GetWishlistRequest\x12\x1f\n" +
+	"\vwishlist_id\x18\x01 \x01(\tR\n" +
+	"wishlistId\"k\n" +
+	"\x13GetWishlistResponse\x12*\n" +
+	"\bwishlist\x18\x01 \x01(\v2\x0e.cart.WishlistR\bwishlist\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.cart.WishlistItemR\x05items\"8\n" +
+	"\x15DeleteWishlistRequest\x12\x1f\n" +
+	"\vwishlist_id\x18\x01 \x01(\tR\n" +
+	"wishlistId\"\x18\n" +
+	"\x16DeleteWishlistResponse\"g\n" +
+	"\x16AddWishlistItemRequest\x12\x1f\n" +
+	"\vwishlist_id\x18\x01 \x01(\tR\n" +
+	"wishlistId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x19\n" +
+	"\x17AddWishlistItemResponse\"N\n" +
+	"\x19RemoveWishlistItemRequest\x12\x1f\n" +
+	"\vwishlist_id\x18\x01 \x01(\tR\n" +
+	"wishlistId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\"\x1c\n" +
+	"\x1aRemoveWishlistItemResponse\"R\n" +
+	"\x1dMoveWishlistItemToCartRequest\x12\x1f\n" +
+	"\vwishlist_id\x18\x01 \x01(\tR\n" +
+	"wishlistId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\" \n" +
+	"\x1eMoveWishlistItemToCartResponse*w\n" +
+	"\x0fCartWarningType\x12\x11\n" +
+	"\rPRICE_CHANGED\x10\x00\x12\x10\n" +
+	"\fOUT_OF_STOCK\x10\x01\x12\x16\n" +
+	"\x12INSUFFICIENT_STOCK\x10\x02\x12\x13\n" +
+	"\x0fPRODUCT_REMOVED\x10\x03\x12\x12\n" +
+	"\x0eCOUPON_INVALID\x10\x04*k\n" +
+	"\rMergeStrategy\x12\x11\n" +
+	"\rMERGE_DEFAULT\x10\x00\x12\r\n" +
+	"\tMERGE_SUM\x10\x01\x12\x13\n" +
+	"\x0fMERGE_KEEP_USER\x10\x02\x12\x14\n" +
+	"\x10MERGE_KEEP_GUEST\x10\x03\x12\r\n" +
+	"\tMERGE_MAX\x10\x042\xbf\x10\n" +
 	"\x13ShoppingCartService\x12L\n" +
 	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x15.cart.GetCartResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/cart\x12U\n" +
 	"\aAddItem\x12\x14.cart.AddItemRequest\x1a\x15.cart.AddItemResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cart/items\x12k\n" +
 	"\n" +
 	"UpdateItem\x12\x17.cart.UpdateItemRequest\x1a\x18.cart.UpdateItemResponse\"*\x82\xd3\xe4\x93\x02$:\x01*2\x1f/api/v1/cart/items/{product_id}\x12h\n" +
 	"\n" +
-	"RemoveItem\x12\x17.cart.RemoveItemRequest\x1a\x18.cart.RemoveItemResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/v1/cart/items/{product_id}\x12R\n" +
-	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/api/v1/cartB\vZ\t/protobufb\x06proto3"
+	"RemoveItem\x12\x17.cart.RemoveItemRequest\x1a\x18.cart.RemoveItemResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/v1/cart/items/{product_id}\x12p\n" +
+	"\x0fSetCartCurrency\x12\x1c.cart.SetCartCurrencyRequest\x1a\x1d.cart.SetCartCurrencyResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/v1/cart/currency\x12R\n" +
+	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/api/v1/cart\x12b\n" +
+	"\vApplyCoupon\x12\x18.cart.ApplyCouponRequest\x1a\x19.cart.ApplyCouponResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/cart/coupon\x12b\n" +
+	"\fRemoveCoupon\x12\x19.cart.RemoveCouponRequest\x1a\x1a.cart.RemoveCouponResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/cart/coupon\x12g\n" +
+	"\fValidateCart\x12\x19.cart.ValidateCartRequest\x1a\x1a.cart.ValidateCartResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/cart/validate\x12m\n" +
+	"\x0fCreateGuestCart\x12\x1c.cart.CreateGuestCartRequest\x1a\x1d.cart.CreateGuestCartResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cart/guest\x12[\n" +
+	"\tMergeCart\x12\x16.cart.MergeCartRequest\x1a\x17.cart.MergeCartResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cart/merge\x12y\n" +
+	"\fSaveForLater\x12\x19.cart.SaveForLaterRequest\x1a\x1a.cart.SaveForLaterResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/cart/items/{sku}/save-for-later\x12i\n" +
+	"\x0eCreateWishlist\x12\x1b.cart.CreateWishlistRequest\x1a\x1c.cart.CreateWishlistResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/wishlists\x12c\n" +
+	"\rListWishlists\x12\x1a.cart.ListWishlistsRequest\x1a\x1b.cart.ListWishlistsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/wishlists\x12k\n" +
+	"\vGetWishlist\x12\x18.cart.GetWishlistRequest\x1a\x19.cart.GetWishlistResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/wishlists/{wishlist_id}\x12t\n" +
+	"\x0eDeleteWishlist\x12\x1b.cart.DeleteWishlistRequest\x1a\x1c.cart.DeleteWishlistResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/v1/wishlists/{wishlist_id}\x12\x80\x01\n" +
+	"\x0fAddWishlistItem\x12\x1c.cart.AddWishlistItemRequest\x1a\x1d.cart.AddWishlistItemResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/wishlists/{wishlist_id}/items\x12\x8c\x01\n" +
+	"\x12RemoveWishlistItem\x12\x1f.cart.RemoveWishlistItemRequest\x1a .cart.RemoveWishlistItemResponse\"3\x82\xd3\xe4\x93\x02-*+/api/v1/wishlists/{wishlist_id}/items/{sku}\x12\xa8\x01\n" +
+	"\x16MoveWishlistItemToCart\x12#.cart.MoveWishlistItemToCartRequest\x1a$.cart.MoveWishlistItemToCartResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/wishlists/{wishlist_id}/items/{sku}/move-to-cartB\vZ\t/protobufb\x06proto3"
 
 var (
 	file_carts_proto_rawDescOnce sync.Once
@@ -583,37 +2354,121 @@ func file_carts_proto_rawDescGZIP() []byte {
 	return file_carts_proto_rawDescData
 }
 
-var file_carts_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_carts_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_carts_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_carts_proto_goTypes = []any{
-	(*CartItem)(nil),           // 0: cart.CartItem
-	(*GetCartRequest)(nil),     // 1: cart.GetCartRequest
-	(*GetCartResponse)(nil),    // 2: cart.GetCartResponse
-	(*AddItemRequest)(nil),     // 3: cart.AddItemRequest
-	(*AddItemResponse)(nil),    // 4: cart.AddItemResponse
-	(*UpdateItemRequest)(nil),  // 5: cart.UpdateItemRequest
-	(*UpdateItemResponse)(nil), // 6: cart.UpdateItemResponse
-	(*RemoveItemRequest)(nil),  // 7: cart.RemoveItemRequest
-	(*RemoveItemResponse)(nil), // 8: cart.RemoveItemResponse
-	(*ClearCartRequest)(nil),   // 9: cart.ClearCartRequest
-	(*ClearCartResponse)(nil),  // 10: cart.ClearCartResponse
+	(CartWarningType)(0),                   // 0: cart.CartWarningType
+	(MergeStrategy)(0),                     // 1: cart.MergeStrategy
+	(*CartItem)(nil),                       // 2: cart.CartItem
+	(*GetCartRequest)(nil),                 // 3: cart.GetCartRequest
+	(*GetCartResponse)(nil),                // 4: cart.GetCartResponse
+	(*AddItemRequest)(nil),                 // 5: cart.AddItemRequest
+	(*AddItemResponse)(nil),                // 6: cart.AddItemResponse
+	(*UpdateItemRequest)(nil),              // 7: cart.UpdateItemRequest
+	(*UpdateItemResponse)(nil),             // 8: cart.UpdateItemResponse
+	(*RemoveItemRequest)(nil),              // 9: cart.RemoveItemRequest
+	(*RemoveItemResponse)(nil),             // 10: cart.RemoveItemResponse
+	(*SetCartCurrencyRequest)(nil),         // 11: cart.SetCartCurrencyRequest
+	(*SetCartCurrencyResponse)(nil),        // 12: cart.SetCartCurrencyResponse
+	(*ClearCartRequest)(nil),               // 13: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),              // 14: cart.ClearCartResponse
+	(*ApplyCouponRequest)(nil),             // 15: cart.ApplyCouponRequest
+	(*ApplyCouponResponse)(nil),            // 16: cart.ApplyCouponResponse
+	(*RemoveCouponRequest)(nil),            // 17: cart.RemoveCouponRequest
+	(*RemoveCouponResponse)(nil),           // 18: cart.RemoveCouponResponse
+	(*CartWarning)(nil),                    // 19: cart.CartWarning
+	(*ValidateCartRequest)(nil),            // 20: cart.ValidateCartRequest
+	(*ValidateCartResponse)(nil),           // 21: cart.ValidateCartResponse
+	(*CreateGuestCartRequest)(nil),         // 22: cart.CreateGuestCartRequest
+	(*CreateGuestCartResponse)(nil),        // 23: cart.CreateGuestCartResponse
+	(*MergeCartRequest)(nil),               // 24: cart.MergeCartRequest
+	(*MergeCartResponse)(nil),              // 25: cart.MergeCartResponse
+	(*SaveForLaterRequest)(nil),            // 26: cart.SaveForLaterRequest
+	(*SaveForLaterResponse)(nil),           // 27: cart.SaveForLaterResponse
+	(*Wishlist)(nil),                       // 28: cart.Wishlist
+	(*WishlistItem)(nil),                   // 29: cart.WishlistItem
+	(*CreateWishlistRequest)(nil),          // 30: cart.CreateWishlistRequest
+	(*CreateWishlistResponse)(nil),         // 31: cart.CreateWishlistResponse
+	(*ListWishlistsRequest)(nil),           // 32: cart.ListWishlistsRequest
+	(*ListWishlistsResponse)(nil),          // 33: cart.ListWishlistsResponse
+	(*GetWishlistRequest)(nil),             // 34: cart.GetWishlistRequest
+	(*GetWishlistResponse)(nil),            // 35: cart.GetWishlistResponse
+	(*DeleteWishlistRequest)(nil),          // 36: cart.DeleteWishlistRequest
+	(*DeleteWishlistResponse)(nil),         // 37: cart.DeleteWishlistResponse
+	(*AddWishlistItemRequest)(nil),         // 38: cart.AddWishlistItemRequest
+	(*AddWishlistItemResponse)(nil),        // 39: cart.AddWishlistItemResponse
+	(*RemoveWishlistItemRequest)(nil),      // 40: cart.RemoveWishlistItemRequest
+	(*RemoveWishlistItemResponse)(nil),     // 41: cart.RemoveWishlistItemResponse
+	(*MoveWishlistItemToCartRequest)(nil),  // 42: cart.MoveWishlistItemToCartRequest
+	(*MoveWishlistItemToCartResponse)(nil), // 43: cart.MoveWishlistItemToCartResponse
+	(*Money)(nil),                          // 44: money.Money
+	(*timestamppb.Timestamp)(nil),          // 45: google.protobuf.Timestamp
 }
 var file_carts_proto_depIdxs = []int32{
-	0,  // 0: cart.GetCartResponse.items:type_name -> cart.CartItem
-	1,  // 1: cart.ShoppingCartService.GetCart:input_type -> cart.GetCartRequest
-	3,  // 2: cart.ShoppingCartService.AddItem:input_type -> cart.AddItemRequest
-	5,  // 3: cart.ShoppingCartService.UpdateItem:input_type -> cart.UpdateItemRequest
-	7,  // 4: cart.ShoppingCartService.RemoveItem:input_type -> cart.RemoveItemRequest
-	9,  // 5: cart.ShoppingCartService.ClearCart:input_type -> cart.ClearCartRequest
-	2,  // 6: cart.ShoppingCartService.GetCart:output_type -> cart.GetCartResponse
-	4,  // 7: cart.ShoppingCartService.AddItem:output_type -> cart.AddItemResponse
-	6,  // 8: cart.ShoppingCartService.UpdateItem:output_type -> cart.UpdateItemResponse
-	8,  // 9: cart.ShoppingCartService.RemoveItem:output_type -> cart.RemoveItemResponse
-	10, // 10: cart.ShoppingCartService.ClearCart:output_type -> cart.ClearCartResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	44, // 0: cart.CartItem.price:type_name -> money.Money
+	44, // 1: cart.CartItem.item_total_price:type_name -> money.Money
+	2,  // 2: cart.GetCartResponse.items:type_name -> cart.CartItem
+	44, // 3: cart.GetCartResponse.total_price:type_name -> money.Money
+	44, // 4: cart.GetCartResponse.subtotal:type_name -> money.Money
+	44, // 5: cart.GetCartResponse.discount:type_name -> money.Money
+	2,  // 6: cart.AddItemResponse.item:type_name -> cart.CartItem
+	44, // 7: cart.ApplyCouponResponse.discount:type_name -> money.Money
+	0,  // 8: cart.CartWarning.type:type_name -> cart.CartWarningType
+	44, // 9: cart.CartWarning.previous_price:type_name -> money.Money
+	44, // 10: cart.CartWarning.current_price:type_name -> money.Money
+	19, // 11: cart.ValidateCartResponse.warnings:type_name -> cart.CartWarning
+	1,  // 12: cart.MergeCartRequest.strategy:type_name -> cart.MergeStrategy
+	19, // 13: cart.MergeCartResponse.warnings:type_name -> cart.CartWarning
+	45, // 14: cart.Wishlist.created_at:type_name -> google.protobuf.Timestamp
+	44, // 15: cart.WishlistItem.price:type_name -> money.Money
+	45, // 16: cart.WishlistItem.added_at:type_name -> google.protobuf.Timestamp
+	28, // 17: cart.CreateWishlistResponse.wishlist:type_name -> cart.Wishlist
+	28, // 18: cart.ListWishlistsResponse.wishlists:type_name -> cart.Wishlist
+	28, // 19: cart.GetWishlistResponse.wishlist:type_name -> cart.Wishlist
+	29, // 20: cart.GetWishlistResponse.items:type_name -> cart.WishlistItem
+	3,  // 21: cart.ShoppingCartService.GetCart:input_type -> cart.GetCartRequest
+	5,  // 22: cart.ShoppingCartService.AddItem:input_type -> cart.AddItemRequest
+	7,  // 23: cart.ShoppingCartService.UpdateItem:input_type -> cart.UpdateItemRequest
+	9,  // 24: cart.ShoppingCartService.RemoveItem:input_type -> cart.RemoveItemRequest
+	11, // 25: cart.ShoppingCartService.SetCartCurrency:input_type -> cart.SetCartCurrencyRequest
+	13, // 26: cart.ShoppingCartService.ClearCart:input_type -> cart.ClearCartRequest
+	15, // 27: cart.ShoppingCartService.ApplyCoupon:input_type -> cart.ApplyCouponRequest
+	17, // 28: cart.ShoppingCartService.RemoveCoupon:input_type -> cart.RemoveCouponRequest
+	20, // 29: cart.ShoppingCartService.ValidateCart:input_type -> cart.ValidateCartRequest
+	22, // 30: cart.ShoppingCartService.CreateGuestCart:input_type -> cart.CreateGuestCartRequest
+	24, // 31: cart.ShoppingCartService.MergeCart:input_type -> cart.MergeCartRequest
+	26, // 32: cart.ShoppingCartService.SaveForLater:input_type -> cart.SaveForLaterRequest
+	30, // 33: cart.ShoppingCartService.CreateWishlist:input_type -> cart.CreateWishlistRequest
+	32, // 34: cart.ShoppingCartService.ListWishlists:input_type -> cart.ListWishlistsRequest
+	34, // 35: cart.ShoppingCartService.GetWishlist:input_type -> cart.GetWishlistRequest
+	36, // 36: cart.ShoppingCartService.DeleteWishlist:input_type -> cart.DeleteWishlistRequest
+	38, // 37: cart.ShoppingCartService.AddWishlistItem:input_type -> cart.AddWishlistItemRequest
+	40, // 38: cart.ShoppingCartService.RemoveWishlistItem:input_type -> cart.RemoveWishlistItemRequest
+	42, // 39: cart.ShoppingCartService.MoveWishlistItemToCart:input_type -> cart.MoveWishlistItemToCartRequest
+	4,  // 40: cart.ShoppingCartService.GetCart:output_type -> cart.GetCartResponse
+	6,  // 41: cart.ShoppingCartService.AddItem:output_type -> cart.AddItemResponse
+	8,  // 42: cart.ShoppingCartService.UpdateItem:output_type -> cart.UpdateItemResponse
+	10, // 43: cart.ShoppingCartService.RemoveItem:output_type -> cart.RemoveItemResponse
+	12, // 44: cart.ShoppingCartService.SetCartCurrency:output_type -> cart.SetCartCurrencyResponse
+	14, // 45: cart.ShoppingCartService.ClearCart:output_type -> cart.ClearCartResponse
+	16, // 46: cart.ShoppingCartService.ApplyCoupon:output_type -> cart.ApplyCouponResponse
+	18, // 47: cart.ShoppingCartService.RemoveCoupon:output_type -> cart.RemoveCouponResponse
+	21, // 48: cart.ShoppingCartService.ValidateCart:output_type -> cart.ValidateCartResponse
+	23, // 49: cart.ShoppingCartService.CreateGuestCart:output_type -> cart.CreateGuestCartResponse
+	25, // 50: cart.ShoppingCartService.MergeCart:output_type -> cart.MergeCartResponse
+	27, // 51: cart.ShoppingCartService.SaveForLater:output_type -> cart.SaveForLaterResponse
+	31, // 52: cart.ShoppingCartService.CreateWishlist:output_type -> cart.CreateWishlistResponse
+	33, // 53: cart.ShoppingCartService.ListWishlists:output_type -> cart.ListWishlistsResponse
+	35, // 54: cart.ShoppingCartService.GetWishlist:output_type -> cart.GetWishlistResponse
+	37, // 55: cart.ShoppingCartService.DeleteWishlist:output_type -> cart.DeleteWishlistResponse
+	39, // 56: cart.ShoppingCartService.AddWishlistItem:output_type -> cart.AddWishlistItemResponse
+	41, // 57: cart.ShoppingCartService.RemoveWishlistItem:output_type -> cart.RemoveWishlistItemResponse
+	43, // 58: cart.ShoppingCartService.MoveWishlistItemToCart:output_type -> cart.MoveWishlistItemToCartResponse
+	40, // [40:59] is the sub-list for method output_type
+	21, // [21:40] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_carts_proto_init() }
@@ -621,18 +2476,20 @@ func file_carts_proto_init() {
 	if File_carts_proto != nil {
 		return
 	}
+	file_money_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_carts_proto_rawDesc), len(file_carts_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_carts_proto_goTypes,
 		DependencyIndexes: file_carts_proto_depIdxs,
+		EnumInfos:         file_carts_proto_enumTypes,
 		MessageInfos:      file_carts_proto_msgTypes,
 	}.Build()
 	File_carts_proto = out.File
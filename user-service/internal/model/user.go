@@ -2,11 +2,20 @@ package model
 
 import "time"
 
+type Role string
+
+const (
+	Customer Role = "customer"
+	Staff    Role = "staff"
+)
+
 type User struct {
-	ID           int64     `json:"id"`
-	Email        string    `json:"email"`
-	PasswordHash string    `json:"password_hash"`
-	FirstName    string    `json:"first_name"`
-	LastName     string    `json:"last_name"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID            int64     `json:"id"`
+	Email         string    `json:"email"`
+	PasswordHash  string    `json:"password_hash"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Role          Role      `json:"role"`
+	CustomerGroup string    `json:"customer_group"`
+	CreatedAt     time.Time `json:"created_at"`
 }
@@ -15,7 +15,7 @@ func New(conn *sql.DB) *Repository {
 }
 
 func (u *Repository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
-	query := `SELECT * FROM users WHERE email = $1`
+	query := `SELECT id, first_name, last_name, email, password_hash, created_at, role, customer_group FROM users WHERE email = $1`
 
 	user := new(model.User)
 
@@ -26,6 +26,8 @@ func (u *Repository) GetUserByEmail(ctx context.Context, email string) (*model.U
 		&user.Email,
 		&user.PasswordHash,
 		&user.CreatedAt,
+		&user.Role,
+		&user.CustomerGroup,
 	)
 	if err != nil {
 		return nil, err
@@ -35,7 +37,7 @@ func (u *Repository) GetUserByEmail(ctx context.Context, email string) (*model.U
 }
 
 func (u *Repository) GetUserByID(ctx context.Context, id int64) (*model.User, error) {
-	query := `SELECT * FROM users WHERE id = $1`
+	query := `SELECT id, first_name, last_name, email, password_hash, created_at, role, customer_group FROM users WHERE id = $1`
 
 	user := new(model.User)
 
@@ -46,6 +48,8 @@ func (u *Repository) GetUserByID(ctx context.Context, id int64) (*model.User, er
 		&user.Email,
 		&user.PasswordHash,
 		&user.CreatedAt,
+		&user.Role,
+		&user.CustomerGroup,
 	)
 	if err != nil {
 		return nil, err
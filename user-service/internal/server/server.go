@@ -79,6 +79,41 @@ func (s *Server) GetProfile(ctx context.Context, _ *pb.GetUserRequest) (*pb.GetU
 		LastName:  user.LastName,
 	}, nil
 }
+
+func (s *Server) GetUserByID(ctx context.Context, r *pb.GetUserByIDRequest) (*pb.GetUserResponse, error) {
+	user, err := s.svc.GetProfile(ctx, r.GetUserId())
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetUserResponse{
+		UserId:    user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}, nil
+}
+
+func (s *Server) GetUserByEmail(ctx context.Context, r *pb.GetUserByEmailRequest) (*pb.GetUserResponse, error) {
+	user, err := s.svc.GetUserByEmail(ctx, r.GetEmail())
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetUserResponse{
+		UserId:    user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}, nil
+}
+
 func (s *Server) UpdateProfile(ctx context.Context, r *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	userID, ok := ctx.Value("user-id").(int)
 	if !ok {
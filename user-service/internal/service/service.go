@@ -72,9 +72,11 @@ func (s *Service) Authenticate(ctx context.Context, email, password string) (str
 	}
 
 	claims := jwt.MapClaims{
-		"user-id": user.ID,
-		"iss":     issuer,
-		"exp":     time.Now().Add(expiryHours * time.Hour).Unix(),
+		"user-id":        user.ID,
+		"role":           user.Role,
+		"customer-group": user.CustomerGroup,
+		"iss":            issuer,
+		"exp":            time.Now().Add(expiryHours * time.Hour).Unix(),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -142,6 +144,18 @@ func (s *Service) GetProfile(ctx context.Context, userID int64) (*model.User, er
 	return user, nil
 }
 
+func (s *Service) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (s *Service) UpdateProfile(ctx context.Context, userID int64, email, firstName, lastName string) error {
 	user, err := s.repo.GetUserByID(ctx, userID)
 	if err != nil {
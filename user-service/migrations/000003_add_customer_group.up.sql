@@ -0,0 +1,2 @@
+-- The price list the user buys from in the catalog; empty for the default prices.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS customer_group VARCHAR(64) NOT NULL DEFAULT '';